@@ -0,0 +1,399 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
+)
+
+// errorRateMinRequests is the number of requests since the last probe below which the error rate is not judged
+const errorRateMinRequests = 10
+
+type BackendOpts struct {
+	Rpcs          []string        // rpc endpoints in priority order
+	MaxRetries    int             // retries of idempotent reads, each one on the next endpoint, default is 3
+	RetryInterval time.Duration   // first retry interval, doubled on every retry, default is 200ms
+	ProbeInterval time.Duration   // interval of the background health probe, default is 15s, negative disables it
+	MaxBlockLag   uint64          // endpoints more blocks behind the highest head are unhealthy, default is 5
+	MaxErrorRate  float64         // endpoints with a higher error rate since the last probe are unhealthy, default is 0.5
+	Limiter       *LimiterOpts    // client side rate limit and concurrency cap of every endpoint, nil disables it
+	Metrics       metrics.Metrics // rpc latency and errors per endpoint and method, default is no-op
+}
+
+// RateLimitError is returned when the endpoints still throttle the request after the retries
+type RateLimitError struct {
+	Err error
+}
+
+func (e *RateLimitError) Error() string {
+	return "rate limited: " + e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+type endpoint struct {
+	url     string
+	label   string // metrics label of the endpoint, the url may hold api keys
+	rpc     *rpc.Client
+	client  *ethclient.Client
+	limiter *limiter
+
+	mu       sync.Mutex
+	healthy  bool   // selected before the unhealthy endpoints
+	head     uint64 // block height of the last probe
+	requests uint64 // requests since the last probe
+	failures uint64 // retryable failures since the last probe
+}
+
+// record counts the request for the error rate, the health is only decided by Probe
+func (e *endpoint) record(err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.requests++
+	if IsRetryable(err) {
+		e.failures++
+	}
+}
+
+func (e *endpoint) isHealthy() bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.healthy
+}
+
+// Backend spreads the requests of the contract wrappers over several rpc endpoints. Reads fail over
+// to the next endpoint with exponential backoff, sends of an account stick to one endpoint so that
+// its nonces are queried and used on the same node.
+type Backend struct {
+	opts      BackendOpts
+	endpoints []*endpoint
+	weights   map[string]float64 // tokens of the json rpc methods
+	metrics   metrics.Metrics
+
+	mu     sync.Mutex
+	sticky map[common.Address]*endpoint // endpoint of the sends of every account
+
+	closeCh   chan struct{}
+	closeOnce sync.Once
+	wg        sync.WaitGroup
+}
+
+func NewBackend(ops *BackendOpts) (*Backend, error) {
+	var err error
+
+	if ops == nil || len(ops.Rpcs) == 0 {
+		return nil, errors.New("at least one rpc endpoint is required")
+	}
+
+	b := &Backend{
+		opts:    *ops,
+		metrics: metrics.OrNop(ops.Metrics),
+		sticky:  make(map[common.Address]*endpoint),
+		closeCh: make(chan struct{}),
+	}
+	if b.opts.MaxRetries <= 0 {
+		b.opts.MaxRetries = 3
+	}
+	if b.opts.RetryInterval <= 0 {
+		b.opts.RetryInterval = 200 * time.Millisecond
+	}
+	if b.opts.ProbeInterval == 0 {
+		b.opts.ProbeInterval = 15 * time.Second
+	}
+	if b.opts.MaxBlockLag == 0 {
+		b.opts.MaxBlockLag = 5
+	}
+	if b.opts.MaxErrorRate <= 0 {
+		b.opts.MaxErrorRate = 0.5
+	}
+	b.weights = make(map[string]float64)
+	for method, weight := range DefaultMethodWeights {
+		b.weights[method] = weight
+	}
+	if ops.Limiter != nil {
+		for method, weight := range ops.Limiter.Weights {
+			b.weights[method] = weight
+		}
+	}
+
+	// 连接失败的节点直接跳过, 全部失败时返回错误
+	for i, rpcUrl := range ops.Rpcs {
+		var client *rpc.Client
+		client, err = rpc.Dial(rpcUrl)
+		if err != nil {
+			continue
+		}
+		b.endpoints = append(b.endpoints, &endpoint{
+			url:     rpcUrl,
+			label:   endpointLabel(rpcUrl, i),
+			rpc:     client,
+			client:  ethclient.NewClient(client),
+			limiter: newLimiter(ops.Limiter),
+			healthy: true,
+		})
+	}
+	if len(b.endpoints) == 0 {
+		return nil, err
+	}
+
+	if b.opts.ProbeInterval > 0 {
+		b.wg.Add(1)
+		go b.probeLoop()
+	}
+
+	return b, nil
+}
+
+// Probe queries the block height of every endpoint and updates their health, an endpoint is healthy when it
+// answers, is at most MaxBlockLag blocks behind the highest head and its error rate is below MaxErrorRate.
+func (b *Backend) Probe(ctx context.Context) {
+	heads := make([]uint64, len(b.endpoints))
+	errs := make([]error, len(b.endpoints))
+
+	var wg sync.WaitGroup
+	for i, e := range b.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpoint) {
+			defer wg.Done()
+			heads[i], errs[i] = e.client.BlockNumber(ctx)
+		}(i, e)
+	}
+	wg.Wait()
+
+	var maxHead uint64
+	for i := range b.endpoints {
+		if errs[i] == nil && heads[i] > maxHead {
+			maxHead = heads[i]
+		}
+	}
+
+	for i, e := range b.endpoints {
+		e.mu.Lock()
+		healthy := errs[i] == nil && maxHead-heads[i] <= b.opts.MaxBlockLag
+		if healthy && e.requests >= errorRateMinRequests {
+			healthy = float64(e.failures)/float64(e.requests) <= b.opts.MaxErrorRate
+		}
+		if errs[i] == nil {
+			e.head = heads[i]
+		}
+		e.healthy = healthy
+		e.requests, e.failures = 0, 0
+		e.mu.Unlock()
+	}
+}
+
+// Urls returns the endpoints in the order they are tried, the healthy ones first
+func (b *Backend) Urls() []string {
+	candidates := b.candidates()
+	urls := make([]string, len(candidates))
+	for i, e := range candidates {
+		urls[i] = e.url
+	}
+	return urls
+}
+
+// Client returns the client of the preferred endpoint, its requests do not fail over
+func (b *Backend) Client() *ethclient.Client {
+	return b.candidates()[0].client
+}
+
+func (b *Backend) Close() {
+	b.closeOnce.Do(func() {
+		close(b.closeCh)
+		b.wg.Wait()
+		for _, e := range b.endpoints {
+			e.client.Close()
+		}
+	})
+}
+
+func (b *Backend) probeLoop() {
+	defer b.wg.Done()
+
+	ticker := time.NewTicker(b.opts.ProbeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.closeCh:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), b.opts.ProbeInterval)
+			b.Probe(ctx)
+			cancel()
+		}
+	}
+}
+
+// candidates returns the healthy endpoints in priority order followed by the unhealthy ones
+func (b *Backend) candidates() []*endpoint {
+	healthy := make([]*endpoint, 0, len(b.endpoints))
+	var unhealthy []*endpoint
+	for _, e := range b.endpoints {
+		if e.isHealthy() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+// request is a json rpc request, or a batch of them, as seen by the limiter and the metrics
+type request struct {
+	method string  // json rpc method, "batch" for batch requests
+	weight float64 // tokens taken from the limiter
+}
+
+func (b *Backend) request(method string) request {
+	return request{method: method, weight: b.weight(method)}
+}
+
+// do runs a request on the endpoint once the limiter allows it, and records its result
+func (b *Backend) do(ctx context.Context, e *endpoint, req request, fn func(e *endpoint) error) error {
+	release, err := e.limiter.acquire(ctx, req.weight)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	start := time.Now()
+	err = fn(e)
+	b.metrics.ObserveRPC(e.label, req.method, time.Since(start), err)
+	e.record(err)
+	return err
+}
+
+// read runs an idempotent request, retryable failures are retried on the next endpoint after a growing interval
+func (b *Backend) read(ctx context.Context, req request, fn func(e *endpoint) error) error {
+	var err error
+
+	candidates := b.candidates()
+	interval := b.opts.RetryInterval
+
+	for attempt := 0; attempt <= b.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			interval *= 2
+		}
+
+		e := candidates[attempt%len(candidates)]
+		err = b.do(ctx, e, req, fn)
+		if !IsRetryable(err) {
+			return err
+		}
+	}
+
+	if IsRateLimited(err) {
+		return &RateLimitError{Err: err}
+	}
+	return err
+}
+
+// endpointLabel returns the scheme and host of the rpc url followed by its index in BackendOpts.Rpcs, the user
+// info, path and query which often carry api keys are left out. The index tells apart endpoints on the same host.
+func endpointLabel(rawUrl string, index int) string {
+	u, err := url.Parse(rawUrl)
+	if err != nil || u.Host == "" {
+		return "endpoint-" + strconv.Itoa(index)
+	}
+	return u.Scheme + "://" + u.Host + "#" + strconv.Itoa(index)
+}
+
+// stickyEndpoint returns the endpoint of the account, a new one is picked when it became unhealthy
+func (b *Backend) stickyEndpoint(account common.Address) *endpoint {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if e, ok := b.sticky[account]; ok && e.isHealthy() {
+		return e
+	}
+	e := b.candidates()[0]
+	b.sticky[account] = e
+	return e
+}
+
+// replaceSticky moves the account away from the endpoint which failed its request, the endpoint is still
+// tried by the other requests until Probe judges it
+func (b *Backend) replaceSticky(account common.Address, failed *endpoint) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.sticky[account] != failed {
+		return
+	}
+	for _, e := range b.candidates() {
+		if e != failed {
+			b.sticky[account] = e
+			return
+		}
+	}
+}
+
+// IsRetryable reports whether a request may succeed on another endpoint or later, which is the case for
+// transport failures and server errors but not for the answers of a node such as a reverted call.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	if IsRateLimited(err) {
+		return true
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return true
+}
+
+// IsRateLimited reports whether an endpoint throttled the request, by a 429 status, the -32005 limit
+// exceeded code or a rate limit message of the providers answering with a plain json rpc error.
+func IsRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var limitErr *RateLimitError
+	if errors.As(err, &limitErr) {
+		return true
+	}
+
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32005 {
+		return true
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "rate limit") || strings.Contains(message, "too many requests")
+}
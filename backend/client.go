@@ -0,0 +1,212 @@
+package backend
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	var chainId *big.Int
+	err := b.read(ctx, b.request("eth_chainId"), func(e *endpoint) (err error) {
+		chainId, err = e.client.ChainID(ctx)
+		return err
+	})
+	return chainId, err
+}
+
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	var number uint64
+	err := b.read(ctx, b.request("eth_blockNumber"), func(e *endpoint) (err error) {
+		number, err = e.client.BlockNumber(ctx)
+		return err
+	})
+	return number, err
+}
+
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	var header *types.Header
+	err := b.read(ctx, b.request("eth_getBlockByNumber"), func(e *endpoint) (err error) {
+		header, err = e.client.HeaderByNumber(ctx, number)
+		return err
+	})
+	return header, err
+}
+
+func (b *Backend) BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error) {
+	var balance *big.Int
+	err := b.read(ctx, b.request("eth_getBalance"), func(e *endpoint) (err error) {
+		balance, err = e.client.BalanceAt(ctx, account, blockNumber)
+		return err
+	})
+	return balance, err
+}
+
+func (b *Backend) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	var code []byte
+	err := b.read(ctx, b.request("eth_getCode"), func(e *endpoint) (err error) {
+		code, err = e.client.CodeAt(ctx, account, blockNumber)
+		return err
+	})
+	return code, err
+}
+
+func (b *Backend) StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error) {
+	var value []byte
+	err := b.read(ctx, b.request("eth_getStorageAt"), func(e *endpoint) (err error) {
+		value, err = e.client.StorageAt(ctx, account, key, blockNumber)
+		return err
+	})
+	return value, err
+}
+
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var result []byte
+	err := b.read(ctx, b.request("eth_call"), func(e *endpoint) (err error) {
+		result, err = e.client.CallContract(ctx, call, blockNumber)
+		return err
+	})
+	return result, err
+}
+
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	var code []byte
+	err := b.read(ctx, b.request("eth_getCode"), func(e *endpoint) (err error) {
+		code, err = e.client.PendingCodeAt(ctx, account)
+		return err
+	})
+	return code, err
+}
+
+func (b *Backend) PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error) {
+	var value []byte
+	err := b.read(ctx, b.request("eth_getStorageAt"), func(e *endpoint) (err error) {
+		value, err = e.client.PendingStorageAt(ctx, account, key)
+		return err
+	})
+	return value, err
+}
+
+func (b *Backend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	var result []byte
+	err := b.read(ctx, b.request("eth_call"), func(e *endpoint) (err error) {
+		result, err = e.client.PendingCallContract(ctx, call)
+		return err
+	})
+	return result, err
+}
+
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	var gasPrice *big.Int
+	err := b.read(ctx, b.request("eth_gasPrice"), func(e *endpoint) (err error) {
+		gasPrice, err = e.client.SuggestGasPrice(ctx)
+		return err
+	})
+	return gasPrice, err
+}
+
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	var gasTipCap *big.Int
+	err := b.read(ctx, b.request("eth_maxPriorityFeePerGas"), func(e *endpoint) (err error) {
+		gasTipCap, err = e.client.SuggestGasTipCap(ctx)
+		return err
+	})
+	return gasTipCap, err
+}
+
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	var gas uint64
+	err := b.read(ctx, b.request("eth_estimateGas"), func(e *endpoint) (err error) {
+		gas, err = e.client.EstimateGas(ctx, call)
+		return err
+	})
+	return gas, err
+}
+
+func (b *Backend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	var receipt *types.Receipt
+	err := b.read(ctx, b.request("eth_getTransactionReceipt"), func(e *endpoint) (err error) {
+		receipt, err = e.client.TransactionReceipt(ctx, txHash)
+		return err
+	})
+	return receipt, err
+}
+
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	err := b.read(ctx, b.request("eth_getLogs"), func(e *endpoint) (err error) {
+		logs, err = e.client.FilterLogs(ctx, query)
+		return err
+	})
+	return logs, err
+}
+
+// SubscribeFilterLogs subscribes on the preferred endpoint, a broken subscription is not moved to another one
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	var sub ethereum.Subscription
+	err := b.do(ctx, b.candidates()[0], b.request("eth_subscribe"), func(e *endpoint) (err error) {
+		sub, err = e.client.SubscribeFilterLogs(ctx, query, ch)
+		return err
+	})
+	return sub, err
+}
+
+// CallContext performs a raw json rpc request, it must be idempotent as it is retried like the other reads
+func (b *Backend) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	return b.read(ctx, b.request(method), func(e *endpoint) error {
+		return e.rpc.CallContext(ctx, result, method, args...)
+	})
+}
+
+// BatchCallContext performs a raw json rpc batch request, it must be idempotent as it is retried like the other reads
+func (b *Backend) BatchCallContext(ctx context.Context, batch []rpc.BatchElem) error {
+	methods := make([]string, len(batch))
+	for i, elem := range batch {
+		methods[i] = elem.Method
+	}
+	return b.read(ctx, request{method: "batch", weight: b.weight(methods...)}, func(e *endpoint) error {
+		return e.rpc.BatchCallContext(ctx, batch)
+	})
+}
+
+// PendingNonceAt queries the nonce on the endpoint the sends of the account stick to
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	var nonce uint64
+	var err error
+
+	// 失败时切换到新的sticky节点重试
+	for attempt := 0; attempt <= b.opts.MaxRetries; attempt++ {
+		e := b.stickyEndpoint(account)
+		err = b.do(ctx, e, b.request("eth_getTransactionCount"), func(e *endpoint) (err error) {
+			nonce, err = e.client.PendingNonceAt(ctx, account)
+			return err
+		})
+		if !IsRetryable(err) {
+			return nonce, err
+		}
+		b.replaceSticky(account, e)
+	}
+	return nonce, err
+}
+
+// SendTransaction sends on the endpoint the sends of the sender stick to. It is never retried since the
+// transaction may have been accepted, a failing endpoint is replaced for the next nonce query and send.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	e := b.candidates()[0]
+	sender, senderErr := types.Sender(types.LatestSignerForChainID(tx.ChainId()), tx)
+	if senderErr == nil {
+		e = b.stickyEndpoint(sender)
+	}
+
+	err := b.do(ctx, e, b.request("eth_sendRawTransaction"), func(e *endpoint) error {
+		return e.client.SendTransaction(ctx, tx)
+	})
+	if senderErr == nil && IsRetryable(err) {
+		b.replaceSticky(sender, e)
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultMethodWeights are the tokens taken by a json rpc method unless LimiterOpts.Weights overrides them,
+// the other methods take one token. eth_getLogs scans many blocks and is billed accordingly by most providers.
+var DefaultMethodWeights = map[string]float64{
+	"eth_getLogs":            10,
+	"eth_estimateGas":        2,
+	"eth_sendRawTransaction": 2,
+}
+
+type LimiterOpts struct {
+	Rate          float64            // tokens added per second, 0 disables the rate limit
+	Burst         float64            // capacity of the bucket, default is Rate
+	MaxConcurrent int                // max in-flight requests, 0 is unlimited
+	Weights       map[string]float64 // tokens of a json rpc method, merged over DefaultMethodWeights
+}
+
+// limiter is the token bucket and concurrency cap of one endpoint
+type limiter struct {
+	rate  float64
+	burst float64
+	sem   chan struct{}
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+func newLimiter(ops *LimiterOpts) *limiter {
+	if ops == nil {
+		return nil
+	}
+
+	l := &limiter{
+		rate:  ops.Rate,
+		burst: ops.Burst,
+		last:  time.Now(),
+	}
+	if l.burst <= 0 {
+		l.burst = l.rate
+	}
+	l.tokens = l.burst
+	if ops.MaxConcurrent > 0 {
+		l.sem = make(chan struct{}, ops.MaxConcurrent)
+	}
+
+	return l
+}
+
+// acquire waits for a concurrency slot and the tokens of the request, the release function frees the slot
+func (l *limiter) acquire(ctx context.Context, weight float64) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = func() { <-l.sem }
+	}
+
+	if l.rate <= 0 {
+		return release, nil
+	}
+
+	// 请求权重超过桶容量时, 等待桶满即可
+	if weight > l.burst {
+		weight = l.burst
+	}
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		if l.tokens >= weight {
+			l.tokens -= weight
+			l.mu.Unlock()
+			return release, nil
+		}
+		wait := time.Duration((weight - l.tokens) / l.rate * float64(time.Second))
+		l.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// weight returns the tokens of the json rpc methods of a request
+func (b *Backend) weight(methods ...string) float64 {
+	var weight float64
+	for _, method := range methods {
+		if w, ok := b.weights[method]; ok {
+			weight += w
+		} else {
+			weight++
+		}
+	}
+	return weight
+}
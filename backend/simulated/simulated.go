@@ -0,0 +1,196 @@
+// Package simulated serves a go-ethereum simulated chain over json rpc, so that a backend.Backend and the
+// contract wrappers built on it run against a local chain in tests. Every sent transaction is mined at once.
+package simulated
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
+)
+
+// ChainID is the chain id of the simulated chain
+const ChainID = 1337
+
+type Chain struct {
+	Sim     *backends.SimulatedBackend // the simulated chain, Commit mines the pending transactions
+	Backend *backend.Backend           // backend connected to the chain over http json rpc
+	server  *rpc.Server
+	http    *httptest.Server
+}
+
+// NewChain starts a simulated chain with the given genesis accounts
+func NewChain(alloc core.GenesisAlloc) (*Chain, error) {
+	chain := &Chain{
+		Sim:    backends.NewSimulatedBackend(alloc, 30000000),
+		server: rpc.NewServer(),
+	}
+	if err := chain.server.RegisterName("eth", &ethService{sim: chain.Sim}); err != nil {
+		chain.Sim.Close()
+		return nil, err
+	}
+	chain.http = httptest.NewServer(chain.server)
+
+	var err error
+	chain.Backend, err = backend.NewBackend(&backend.BackendOpts{Rpcs: []string{chain.http.URL}, ProbeInterval: -1})
+	if err != nil {
+		chain.Close()
+		return nil, err
+	}
+	return chain, nil
+}
+
+func (c *Chain) Close() {
+	if c.Backend != nil {
+		c.Backend.Close()
+	}
+	c.http.Close()
+	c.server.Stop()
+	_ = c.Sim.Close()
+}
+
+type callArgs struct {
+	From     common.Address  `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      hexutil.Uint64  `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+}
+
+func (a *callArgs) message() ethereum.CallMsg {
+	return ethereum.CallMsg{
+		From:     a.From,
+		To:       a.To,
+		Gas:      uint64(a.Gas),
+		GasPrice: (*big.Int)(a.GasPrice),
+		Value:    (*big.Int)(a.Value),
+		Data:     a.Data,
+	}
+}
+
+type filterArgs struct {
+	FromBlock *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock   *rpc.BlockNumber `json:"toBlock"`
+	Address   []common.Address `json:"address"`
+	Topics    [][]common.Hash  `json:"topics"`
+}
+
+// ethService answers the eth_ methods used by backend.Backend with the simulated chain
+type ethService struct {
+	sim *backends.SimulatedBackend
+}
+
+// number converts a block tag, the simulated chain only serves the latest state
+func (s *ethService) number(n rpc.BlockNumber) *big.Int {
+	if n < 0 || uint64(n) == s.sim.Blockchain().CurrentBlock().NumberU64() {
+		return nil
+	}
+	return big.NewInt(int64(n))
+}
+
+func (s *ethService) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(big.NewInt(ChainID))
+}
+
+func (s *ethService) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.sim.Blockchain().CurrentBlock().NumberU64())
+}
+
+func (s *ethService) GetBlockByNumber(ctx context.Context, n rpc.BlockNumber, full bool) (*types.Header, error) {
+	return s.sim.HeaderByNumber(ctx, s.number(n))
+}
+
+func (s *ethService) GetBalance(ctx context.Context, account common.Address, n rpc.BlockNumber) (*hexutil.Big, error) {
+	balance, err := s.sim.BalanceAt(ctx, account, s.number(n))
+	return (*hexutil.Big)(balance), err
+}
+
+func (s *ethService) GetCode(ctx context.Context, account common.Address, n rpc.BlockNumber) (hexutil.Bytes, error) {
+	if n == rpc.PendingBlockNumber {
+		return s.sim.PendingCodeAt(ctx, account)
+	}
+	return s.sim.CodeAt(ctx, account, s.number(n))
+}
+
+func (s *ethService) GetStorageAt(ctx context.Context, account common.Address, key common.Hash, n rpc.BlockNumber) (hexutil.Bytes, error) {
+	return s.sim.StorageAt(ctx, account, key, s.number(n))
+}
+
+func (s *ethService) GetTransactionCount(ctx context.Context, account common.Address, n rpc.BlockNumber) (hexutil.Uint64, error) {
+	var nonce uint64
+	var err error
+	if n == rpc.PendingBlockNumber {
+		nonce, err = s.sim.PendingNonceAt(ctx, account)
+	} else {
+		nonce, err = s.sim.NonceAt(ctx, account, s.number(n))
+	}
+	return hexutil.Uint64(nonce), err
+}
+
+func (s *ethService) GasPrice(ctx context.Context) (*hexutil.Big, error) {
+	price, err := s.sim.SuggestGasPrice(ctx)
+	return (*hexutil.Big)(price), err
+}
+
+func (s *ethService) MaxPriorityFeePerGas(ctx context.Context) (*hexutil.Big, error) {
+	tip, err := s.sim.SuggestGasTipCap(ctx)
+	return (*hexutil.Big)(tip), err
+}
+
+func (s *ethService) EstimateGas(ctx context.Context, args callArgs) (hexutil.Uint64, error) {
+	gas, err := s.sim.EstimateGas(ctx, args.message())
+	return hexutil.Uint64(gas), err
+}
+
+func (s *ethService) Call(ctx context.Context, args callArgs, n rpc.BlockNumber) (hexutil.Bytes, error) {
+	if n == rpc.PendingBlockNumber {
+		return s.sim.PendingCallContract(ctx, args.message())
+	}
+	return s.sim.CallContract(ctx, args.message(), s.number(n))
+}
+
+// SendRawTransaction mines the transaction in a new block
+func (s *ethService) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(data); err != nil {
+		return common.Hash{}, err
+	}
+	if err := s.sim.SendTransaction(ctx, tx); err != nil {
+		return common.Hash{}, err
+	}
+	s.sim.Commit()
+	return tx.Hash(), nil
+}
+
+func (s *ethService) GetTransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return s.sim.TransactionReceipt(ctx, hash)
+}
+
+func (s *ethService) GetLogs(ctx context.Context, args filterArgs) ([]types.Log, error) {
+	query := ethereum.FilterQuery{Addresses: args.Address, Topics: args.Topics}
+	if args.FromBlock != nil && *args.FromBlock >= 0 {
+		query.FromBlock = big.NewInt(args.FromBlock.Int64())
+	}
+	if args.ToBlock != nil && *args.ToBlock >= 0 {
+		query.ToBlock = big.NewInt(args.ToBlock.Int64())
+	}
+	// 与新版本geth一致, 拒绝from > to的查询
+	if query.FromBlock != nil && query.ToBlock != nil && query.FromBlock.Cmp(query.ToBlock) > 0 {
+		return nil, errors.New("invalid block range params")
+	}
+	logs, err := s.sim.FilterLogs(ctx, query)
+	if logs == nil {
+		logs = []types.Log{}
+	}
+	return logs, err
+}
@@ -0,0 +1,124 @@
+// Package clone launches EIP-1167 minimal proxies of an initializable implementation, a clone costs a
+// fraction of the gas of the full bytecode and delegates every call to the implementation.
+//
+// The clones are created by Factory, a minimal factory contract deployed with the deterministic deployment
+// proxy of the create2 package, it creates the clone with CREATE2 and calls its initializer in the same
+// transaction, so nobody can initialize a clone before its creator.
+package clone
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	"github.com/jason-bateman/go-erc-standard-contract/create2"
+)
+
+const (
+	// FactoryInitCode is the init code of the clone factory, the runtime is hand assembled:
+	//
+	//	// creation code of the EIP-1167 clone of calldata[12:32]
+	//	mstore(0, 0x3d602d80600a3d3981f3363d3d373d3d3d363d73 << 96)
+	//	mstore(20, shl(96, calldataload(0)))
+	//	mstore(40, 0x5af43d82803e903d91602b57fd5bf3 << 136)
+	//	mstore(96, caller) mstore(128, calldataload(32))      // salt = keccak256(caller, calldata[32:64])
+	//	instance := create2(callvalue, 0, 55, keccak256(96, 64))
+	//	if iszero(instance) { revert(0, 0) }
+	//	if calldata[64:] is not empty {                       // the initializer call, its revert is bubbled up
+	//		calldatacopy(0, 64, sub(calldatasize, 64))
+	//		if iszero(call(gas, instance, 0, 0, sub(calldatasize, 64), 0, 0)) {
+	//			returndatacopy(0, 0, returndatasize) revert(0, returndatasize)
+	//		}
+	//	}
+	//	mstore(0, instance) return(12, 20)
+	FactoryInitCode = "0x60a180600b6000396000f37f3d602d80600a3d3981f3363d3d373d3d3d363d7300000000000000000000000060005260003560601b6014527f5af43d82803e903d91602b57fd5bf300000000000000000000000000000000006028523360605260203560805260406060206037600034f580606e57600080fd5b604036038015609757806040600037600060008260006000865af16097573d600060003e3d6000fd5b506000526014600cf3"
+
+	// FactoryAddress is where create2.DeployDeterministic deploys FactoryInitCode
+	FactoryAddress = "0x68BE79972074a717A52d6D2316524789da6Be8B0"
+)
+
+var (
+	// EIP-1167 runtime code around the implementation address
+	runtimePrefix = common.FromHex("0x363d3d373d3d3d363d73")
+	runtimeSuffix = common.FromHex("0x5af43d82803e903d91602b57fd5bf3")
+	// EIP-1167 creation code returning the runtime code
+	creationPrefix = common.FromHex("0x3d602d80600a3d3981f3")
+)
+
+// Runtime returns the EIP-1167 runtime code of a clone of implementation
+func Runtime(implementation common.Address) []byte {
+	code := make([]byte, 0, len(runtimePrefix)+common.AddressLength+len(runtimeSuffix))
+	code = append(code, runtimePrefix...)
+	code = append(code, implementation.Bytes()...)
+	return append(code, runtimeSuffix...)
+}
+
+// InitCode returns the creation code of a clone of implementation
+func InitCode(implementation common.Address) []byte {
+	return append(append([]byte{}, creationPrefix...), Runtime(implementation)...)
+}
+
+// Implementation returns the implementation of an EIP-1167 clone, ok is false when code is no clone
+func Implementation(code []byte) (implementation common.Address, ok bool) {
+	if len(code) != len(runtimePrefix)+common.AddressLength+len(runtimeSuffix) ||
+		!bytes.HasPrefix(code, runtimePrefix) || !bytes.HasSuffix(code, runtimeSuffix) {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(code[len(runtimePrefix) : len(runtimePrefix)+common.AddressLength]), true
+}
+
+type FactoryOpts struct {
+	Address string // factory address, default is FactoryAddress
+}
+
+type Factory struct {
+	*create2.Deterministic
+}
+
+// NewFactory binds the clone factory, Install deploys it with create2.DeployDeterministic when the chain does not
+// have it yet
+func NewFactory(backend create2.Backend, ops *FactoryOpts) (*Factory, error) {
+	if ops == nil {
+		ops = &FactoryOpts{}
+	}
+	deterministic, err := create2.NewDeterministic(backend, ops.Address, hexutil.MustDecode(FactoryInitCode))
+	if err != nil {
+		return nil, err
+	}
+	return &Factory{Deterministic: deterministic}, nil
+}
+
+// ComputeAddress returns the address of the clone of implementation sender creates with salt
+func (f *Factory) ComputeAddress(sender, implementation common.Address, salt common.Hash) common.Address {
+	return create2.ComputeAddress(f.Address(), create2.SenderSalt(sender, salt), InitCode(implementation))
+}
+
+// Clone creates the clone of implementation with salt and calls it with initData in one transaction, a
+// reverted initializer reverts the clone as well. The clone address is known before the transaction is sent,
+// see ComputeAddress, a salt can only be used once per sender and implementation.
+func (f *Factory) Clone(ctx context.Context, signer *bind.TransactOpts, implementation common.Address, salt common.Hash, initData []byte) (common.Address, *types.Transaction, error) {
+	address := f.ComputeAddress(signer.From, implementation, salt)
+
+	deployed, err := create2.HasCode(ctx, f.Backend(), implementation)
+	if err != nil {
+		return address, nil, err
+	}
+	if !deployed {
+		return address, nil, fmt.Errorf("no implementation code at %s", implementation.Hex())
+	}
+
+	calldata := make([]byte, 0, 2*common.HashLength+len(initData))
+	calldata = append(calldata, common.LeftPadBytes(implementation.Bytes(), common.HashLength)...)
+	calldata = append(calldata, salt.Bytes()...)
+	calldata = append(calldata, initData...)
+
+	tx, err := f.Send(ctx, signer, calldata)
+	if err != nil {
+		return address, tx, fmt.Errorf("clone of %s failed: %w", implementation.Hex(), err)
+	}
+	return address, tx, nil
+}
@@ -0,0 +1,75 @@
+package clone
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/create2"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+func TestImplementation(t *testing.T) {
+	implementation := common.HexToAddress("0xbebebebebebebebebebebebebebebebebebebebe")
+	runtime := hexutil.Encode(Runtime(implementation))
+	if runtime != "0x363d3d373d3d3d363d73bebebebebebebebebebebebebebebebebebebebe5af43d82803e903d91602b57fd5bf3" {
+		t.Errorf("runtime:%s\n", runtime)
+	}
+
+	got, ok := Implementation(Runtime(implementation))
+	if !ok || got != implementation {
+		t.Errorf("implementation:%s ok:%v\n", got.Hex(), ok)
+	}
+	if _, ok = Implementation(common.FromHex(erc721.StandardERC721MetaData.Bin)); ok {
+		t.Errorf("bytecode of StandardERC721 is no clone\n")
+	}
+
+	factory := create2.ComputeAddress(common.HexToAddress(create2.DeployerAddress), common.Hash{}, hexutil.MustDecode(FactoryInitCode))
+	if factory != common.HexToAddress(FactoryAddress) {
+		t.Errorf("factory address:%s want:%s\n", factory.Hex(), FactoryAddress)
+	}
+}
+
+func TestFactory_Clone(t *testing.T) {
+	chain := testchain.New(t, 1)
+	sender, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+
+	ctx := context.Background()
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	implementation := chain.Deploy(t, signer, parsed, erc721.StandardERC721MetaData.Bin, "Standard", "STD")
+
+	factory, _ := NewFactory(chain.Backend, nil)
+	if err := factory.Install(ctx, signer); err != nil {
+		t.Fatalf("install factory err:%+v\n", err)
+	}
+
+	initData, _ := parsed.Pack("name")
+	salt := common.HexToHash("0x01")
+	expected := factory.ComputeAddress(sender, implementation, salt)
+
+	address, _, err := factory.Clone(ctx, signer, implementation, salt, initData)
+	if err != nil || address != expected {
+		t.Fatalf("clone address:%s want:%s err:%+v\n", address.Hex(), expected.Hex(), err)
+	}
+	code, _ := chain.Backend.CodeAt(ctx, address, nil)
+	if got, ok := Implementation(code); !ok || got != implementation {
+		t.Errorf("clone implementation:%s ok:%v\n", got.Hex(), ok)
+	}
+
+	// clone有自己的存储, 调用由实现合约执行
+	instance, _ := erc721.NewStandardERC721Caller(address, chain.Backend)
+	if name, err := instance.Name(nil); err != nil || name != "" {
+		t.Errorf("clone name:%s err:%+v\n", name, err)
+	}
+
+	// 重复的salt和回滚的初始化都失败
+	if _, _, err = factory.Clone(ctx, signer, implementation, salt, initData); err == nil {
+		t.Errorf("clone with a used salt should fail\n")
+	}
+	initData, _ = parsed.Pack("transferOwnership", sender)
+	if _, _, err = factory.Clone(ctx, signer, implementation, common.HexToHash("0x02"), initData); err == nil {
+		t.Errorf("clone with a reverted initializer should fail\n")
+	}
+}
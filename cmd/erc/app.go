@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"os"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/scanner"
+	"github.com/jason-bateman/go-erc-standard-contract/sink"
+)
+
+// app holds the contract of the running command
+type app struct {
+	cfg     *config
+	sender  string // keystore address of the write commands
+	erc721  *erc721.Contract
+	erc1155 *erc1155.Contract
+}
+
+func (a *app) close() {
+	if a.erc721 != nil {
+		a.erc721.ReleaseResource()
+	}
+	if a.erc1155 != nil {
+		a.erc1155.ReleaseResource()
+	}
+}
+
+// loadKey decrypts the keystore file and returns the hex private key accepted by AddTransactors
+func (a *app) loadKey() (string, error) {
+	if a.cfg.Keystore == "" {
+		return "", errors.New("keystore is required, set --keystore, ERC_KEYSTORE or keystore of the config file")
+	}
+	data, err := os.ReadFile(a.cfg.Keystore)
+	if err != nil {
+		return "", err
+	}
+	password, err := a.cfg.password()
+	if err != nil {
+		return "", err
+	}
+	key, err := keystore.DecryptKey(data, password)
+	if err != nil {
+		return "", err
+	}
+	a.sender = key.Address.Hex()
+	return hex.EncodeToString(crypto.FromECDSA(key.PrivateKey)), nil
+}
+
+// blockFlag registers --block of the read commands
+func blockFlag(fs *flag.FlagSet) *string {
+	return fs.String("block", "", "block to read at: latest, pending, safe, finalized or a number")
+}
+
+func blockTag(s string) []chainModel.BlockTag {
+	if s == "" {
+		return nil
+	}
+	return []chainModel.BlockTag{chainModel.BlockTag(s)}
+}
+
+type txFlags struct {
+	nonce *uint64
+	wait  *bool
+}
+
+// registerTxFlags registers the flags shared by the write commands
+func registerTxFlags(fs *flag.FlagSet) *txFlags {
+	return &txFlags{
+		nonce: fs.Uint64("nonce", 0, "nonce of the transaction, 0 uses the pending nonce"),
+		wait:  fs.Bool("wait", false, "wait for the receipt"),
+	}
+}
+
+type transactionWaiter interface {
+	WaitTransaction(ctx context.Context, txId string) (*types.Receipt, error)
+}
+
+// txResult prints the tx hash, and the receipt status when --wait is set
+func (f *txFlags) txResult(ctx context.Context, waiter transactionWaiter, txId string) (*result, error) {
+	if !*f.wait {
+		res := newResult("tx")
+		res.add(txId)
+		return res, nil
+	}
+
+	receipt, err := waiter.WaitTransaction(ctx, txId)
+	if err != nil {
+		return nil, err
+	}
+	status := "success"
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		status = "failed"
+	}
+	res := newResult("tx", "status", "block", "gas_used")
+	res.add(txId, status, receipt.BlockNumber.String(), strconv.FormatUint(receipt.GasUsed, 10))
+	return res, nil
+}
+
+type scanFlags struct {
+	from   *uint64
+	to     *uint64
+	events *string
+}
+
+func registerScanFlags(fs *flag.FlagSet) *scanFlags {
+	return &scanFlags{
+		from:   fs.Uint64("from", 0, "first block to scan"),
+		to:     fs.Uint64("to", 0, "last block to scan, 0 is the latest block"),
+		events: fs.String("events", "", "comma separated event names, default is all of the standard events"),
+	}
+}
+
+// eventNames returns the requested events, or all of the supported ones
+func (f *scanFlags) eventNames(supported []string) []string {
+	if *f.events == "" {
+		return supported
+	}
+	return splitList(*f.events)
+}
+
+type headFilterer interface {
+	scanner.EventFilterer
+	scanner.HeadReader
+}
+
+// scan filters the events of the block range window by window
+func (f *scanFlags) scan(ctx context.Context, filterer headFilterer) (*result, error) {
+	to := *f.to
+	if to == 0 {
+		latest, err := filterer.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		to = latest
+	}
+	if to < *f.from {
+		return nil, errors.New("--to must not be lower than --from")
+	}
+
+	collector := &collectSink{}
+	s, err := scanner.NewScanner(filterer, &scanner.ScannerOpts{Sinks: []sink.Sink{collector}})
+	if err != nil {
+		return nil, err
+	}
+	defer s.Close()
+
+	if _, err = s.Scan(ctx, *f.from, to); err != nil {
+		return nil, err
+	}
+
+	res := newResult("block", "index", "tx", "event", "contract", "payload")
+	for _, e := range collector.events {
+		payload, err := e.MessageJSON()
+		if err != nil {
+			return nil, err
+		}
+		res.add(strconv.FormatUint(e.BlockNumber, 10), strconv.FormatUint(e.BlockIndex, 10), e.TxId, e.Event, e.Contract, payload)
+	}
+	return res, nil
+}
+
+// collectSink keeps the scanned events in memory
+type collectSink struct {
+	events []*chainModel.EthereumEventMessage
+}
+
+func (s *collectSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func (s *collectSink) Close() error {
+	return nil
+}
+
+// scanHead adapts a contract to the scanner, the head is read from its backend
+type scanHead struct {
+	scanner.EventFilterer
+	scanner.HeadReader
+}
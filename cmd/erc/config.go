@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// config of the rpc, contract and signer, the flags override the environment which overrides the config file
+type config struct {
+	Rpcs         []string `json:"rpcs"`          // rpc endpoints in priority order
+	Contract     string   `json:"contract"`      // contract address
+	Keystore     string   `json:"keystore"`      // keystore file of the sender, required by the write commands
+	Password     string   `json:"-"`             // keystore password, only read from ERC_PASSWORD when --password-file is not set
+	PasswordFile string   `json:"password_file"` // file holding the keystore password
+	Multicall    string   `json:"multicall"`     // Multicall3 contract address of the batch reads
+	Output       string   `json:"output"`        // json, csv or table
+	Timeout      duration `json:"timeout"`       // timeout of the whole command
+}
+
+// duration accepts "30s" style strings in the config file
+type duration time.Duration
+
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
+
+// globalFlags are accepted by every command
+type globalFlags struct {
+	config       string
+	rpc          string
+	contract     string
+	keystore     string
+	passwordFile string
+	multicall    string
+	output       string
+	timeout      time.Duration
+}
+
+func registerGlobalFlags(fs *flag.FlagSet) *globalFlags {
+	g := &globalFlags{}
+	fs.StringVar(&g.config, "config", "", "json config file, env ERC_CONFIG")
+	fs.StringVar(&g.rpc, "rpc", "", "comma separated rpc endpoints, env ERC_RPC")
+	fs.StringVar(&g.contract, "contract", "", "contract address, env ERC_CONTRACT")
+	fs.StringVar(&g.keystore, "keystore", "", "keystore file of the sender, env ERC_KEYSTORE")
+	fs.StringVar(&g.passwordFile, "password-file", "", "file holding the keystore password, overrides env ERC_PASSWORD, which overrides env ERC_PASSWORD_FILE")
+	fs.StringVar(&g.multicall, "multicall", "", "Multicall3 address of the batch reads, env ERC_MULTICALL")
+	fs.StringVar(&g.output, "o", "", "output format: json, csv or table (default table), env ERC_OUTPUT")
+	fs.DurationVar(&g.timeout, "timeout", 0, "timeout of the command (default 1m), env ERC_TIMEOUT")
+	return g
+}
+
+// loadConfig merges the config file, the environment and the flags
+func loadConfig(g *globalFlags, getenv func(string) string) (*config, error) {
+	cfg := &config{}
+
+	path := first(g.config, getenv("ERC_CONFIG"))
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err = json.Unmarshal(data, cfg); err != nil {
+			return nil, fmt.Errorf("parse config %s: %v", path, err)
+		}
+	}
+
+	if rpc := first(g.rpc, getenv("ERC_RPC")); rpc != "" {
+		cfg.Rpcs = splitList(rpc)
+	}
+	cfg.Contract = first(g.contract, getenv("ERC_CONTRACT"), cfg.Contract)
+	cfg.Keystore = first(g.keystore, getenv("ERC_KEYSTORE"), cfg.Keystore)
+	// 显式指定的--password-file优先于ERC_PASSWORD
+	if g.passwordFile != "" {
+		cfg.PasswordFile = g.passwordFile
+	} else {
+		cfg.PasswordFile = first(getenv("ERC_PASSWORD_FILE"), cfg.PasswordFile)
+		cfg.Password = getenv("ERC_PASSWORD")
+	}
+	cfg.Multicall = first(g.multicall, getenv("ERC_MULTICALL"), cfg.Multicall)
+	cfg.Output = first(g.output, getenv("ERC_OUTPUT"), cfg.Output, outputTable)
+
+	if g.timeout > 0 {
+		cfg.Timeout = duration(g.timeout)
+	} else if s := getenv("ERC_TIMEOUT"); s != "" {
+		v, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ERC_TIMEOUT: %v", err)
+		}
+		cfg.Timeout = duration(v)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = duration(time.Minute)
+	}
+
+	if len(cfg.Rpcs) == 0 {
+		return nil, errors.New("rpc is required, set --rpc, ERC_RPC or rpcs of the config file")
+	}
+	if cfg.Contract == "" {
+		return nil, errors.New("contract is required, set --contract, ERC_CONTRACT or contract of the config file")
+	}
+	switch cfg.Output {
+	case outputJSON, outputCSV, outputTable:
+	default:
+		return nil, fmt.Errorf("unsupported output format:%s", cfg.Output)
+	}
+
+	return cfg, nil
+}
+
+// password returns the keystore password of ERC_PASSWORD or the password file, ERC_PASSWORD is dropped by
+// loadConfig when --password-file is set
+func (cfg *config) password() (string, error) {
+	if cfg.Password != "" {
+		return cfg.Password, nil
+	}
+	if cfg.PasswordFile == "" {
+		return "", errors.New("keystore password is required, set ERC_PASSWORD or --password-file")
+	}
+	data, err := os.ReadFile(cfg.PasswordFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(data), "\r\n"), nil
+}
+
+func first(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
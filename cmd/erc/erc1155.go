@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+)
+
+var erc1155Commands = []*command{
+	{name: "balance-of", usage: "print the balance of an owner for a token id", setup: erc1155BalanceOf},
+	{name: "balance-of-batch", usage: "print the balances of owner and token id pairs", setup: erc1155BalanceOfBatch},
+	{name: "uri", usage: "print the metadata uri of a token id", setup: erc1155URI},
+	{name: "transfer", usage: "transfer an amount of a token id", write: true, setup: erc1155Transfer},
+	{name: "approve", usage: "approve or revoke an operator of all tokens", write: true, setup: erc1155Approve},
+	{name: "scan", usage: "list the events of a block range", filter: true, setup: erc1155Scan},
+}
+
+func openERC1155(a *app, cmd *command) error {
+	contract, err := erc1155.NewContract(&erc1155.ContractOpts{
+		Rpcs:              a.cfg.Rpcs,
+		ContractAddr:      a.cfg.Contract,
+		EnableTransactors: cmd.write,
+		EnableFilter:      cmd.filter,
+		Multicall:         a.cfg.Multicall,
+	})
+	if err != nil {
+		return err
+	}
+	a.erc1155 = contract
+
+	if cmd.write {
+		key, err := a.loadKey()
+		if err != nil {
+			return err
+		}
+		return contract.AddTransactors([]string{key})
+	}
+	return nil
+}
+
+func erc1155BalanceOf(fs *flag.FlagSet) runFunc {
+	owner := fs.String("owner", "", "owner address")
+	id := fs.String("id", "", "token id")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"owner": *owner, "id": *id}); err != nil {
+			return nil, err
+		}
+		balance, err := a.erc1155.ReadBalanceOf(ctx, &model.MethodReadBalanceOf{Owner: *owner, Id: *id}, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("owner", "id", "balance")
+		res.add(*owner, *id, balance)
+		return res, nil
+	}
+}
+
+func erc1155BalanceOfBatch(fs *flag.FlagSet) runFunc {
+	owners := fs.String("owners", "", "comma separated owner addresses, one owner is paired with every id")
+	ids := fs.String("ids", "", "comma separated token ids")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"owners": *owners, "ids": *ids}); err != nil {
+			return nil, err
+		}
+		inputs := &model.MethodReadBalanceOfBatchInputs{Owners: splitList(*owners), Ids: splitList(*ids)}
+		if len(inputs.Owners) == 1 && len(inputs.Ids) > 1 {
+			for len(inputs.Owners) < len(inputs.Ids) {
+				inputs.Owners = append(inputs.Owners, inputs.Owners[0])
+			}
+		}
+		if len(inputs.Owners) != len(inputs.Ids) {
+			return nil, fmt.Errorf("%d owners do not match %d ids", len(inputs.Owners), len(inputs.Ids))
+		}
+
+		balances, err := a.erc1155.ReadBalanceOfBatch(ctx, inputs, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("owner", "id", "balance")
+		for i, balance := range balances {
+			res.add(inputs.Owners[i], inputs.Ids[i], balance)
+		}
+		return res, nil
+	}
+}
+
+func erc1155URI(fs *flag.FlagSet) runFunc {
+	id := fs.String("id", "", "token id")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"id": *id}); err != nil {
+			return nil, err
+		}
+		uri, err := a.erc1155.ReadUri(ctx, *id, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("id", "uri")
+		res.add(*id, uri)
+		return res, nil
+	}
+}
+
+func erc1155Transfer(fs *flag.FlagSet) runFunc {
+	from := fs.String("from", "", "current holder, default is the keystore address")
+	to := fs.String("to", "", "receiver address")
+	id := fs.String("id", "", "token id")
+	amount := fs.String("amount", "", "amount of the token id")
+	data := fs.String("data", "", "0x prefixed hex data passed to the receiver hook")
+	tx := registerTxFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"to": *to, "id": *id, "amount": *amount}); err != nil {
+			return nil, err
+		}
+		if *from != "" && !strings.EqualFold(*from, a.sender) {
+			return nil, fmt.Errorf("--from %s is not the keystore address %s", *from, a.sender)
+		}
+		payload, err := hex.DecodeString(strings.TrimPrefix(*data, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --data: %v", err)
+		}
+
+		txId, err := a.erc1155.WriteSafeTransferFrom(ctx, *tx.nonce, &model.MethodWriteSafeTransferFromInputs{
+			From: a.sender, To: *to, Id: *id, Amount: *amount, Data: payload,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tx.txResult(ctx, a.erc1155, txId)
+	}
+}
+
+func erc1155Approve(fs *flag.FlagSet) runFunc {
+	operator := fs.String("operator", "", "operator address")
+	approved := fs.Bool("approved", true, "false revokes the operator")
+	tx := registerTxFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"operator": *operator}); err != nil {
+			return nil, err
+		}
+		txId, err := a.erc1155.WriteSetApprovalForAll(ctx, a.sender, *tx.nonce, &model.MethodWriteSetApprovalForAllInputs{
+			Operator: *operator, Approved: *approved,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tx.txResult(ctx, a.erc1155, txId)
+	}
+}
+
+func erc1155Scan(fs *flag.FlagSet) runFunc {
+	scan := registerScanFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		byName := make(map[string]model.ContractEvent, len(model.SupportEvents))
+		supported := make([]string, 0, len(model.SupportEvents))
+		for e, name := range model.SupportEvents {
+			byName[name] = e
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+
+		var events []model.ContractEvent
+		for _, name := range scan.eventNames(supported) {
+			e, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unsupported Event:%s", name)
+			}
+			events = append(events, e)
+		}
+		if err := a.erc1155.AddEvents(events); err != nil {
+			return nil, err
+		}
+
+		return scan.scan(ctx, scanHead{a.erc1155, a.erc1155.GetBackend()})
+	}
+}
@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+)
+
+var erc721Commands = []*command{
+	{name: "owner-of", usage: "print the owner of a token", setup: erc721OwnerOf},
+	{name: "balance-of", usage: "print the token count of an owner", setup: erc721BalanceOf},
+	{name: "token-uri", usage: "print the metadata uri of a token", setup: erc721TokenURI},
+	{name: "tokens-of", usage: "list the tokens of an owner, requires ERC721Enumerable", setup: erc721TokensOf},
+	{name: "transfer", usage: "transfer a token", write: true, setup: erc721Transfer},
+	{name: "approve", usage: "approve an address to transfer a token", write: true, setup: erc721Approve},
+	{name: "approve-all", usage: "approve or revoke an operator of all tokens", write: true, setup: erc721ApproveAll},
+	{name: "scan", usage: "list the events of a block range", filter: true, setup: erc721Scan},
+}
+
+func openERC721(a *app, cmd *command) error {
+	contract, err := erc721.NewContract(&erc721.ContractOpts{
+		Rpcs:              a.cfg.Rpcs,
+		ContractAddr:      a.cfg.Contract,
+		EnableTransactors: cmd.write,
+		EnableFilter:      cmd.filter,
+		Multicall:         a.cfg.Multicall,
+	})
+	if err != nil {
+		return err
+	}
+	a.erc721 = contract
+
+	if cmd.write {
+		key, err := a.loadKey()
+		if err != nil {
+			return err
+		}
+		return contract.AddTransactors([]string{key})
+	}
+	return nil
+}
+
+func erc721OwnerOf(fs *flag.FlagSet) runFunc {
+	id := fs.String("id", "", "token id")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"id": *id}); err != nil {
+			return nil, err
+		}
+		owner, err := a.erc721.ReadOwnerOf(ctx, *id, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("token_id", "owner")
+		res.add(*id, owner)
+		return res, nil
+	}
+}
+
+func erc721BalanceOf(fs *flag.FlagSet) runFunc {
+	owner := fs.String("owner", "", "owner address")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"owner": *owner}); err != nil {
+			return nil, err
+		}
+		balance, err := a.erc721.ReadBalanceOf(ctx, *owner, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("owner", "balance")
+		res.add(*owner, balance)
+		return res, nil
+	}
+}
+
+func erc721TokenURI(fs *flag.FlagSet) runFunc {
+	id := fs.String("id", "", "token id")
+	block := blockFlag(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"id": *id}); err != nil {
+			return nil, err
+		}
+		uri, err := a.erc721.ReadTokenURI(ctx, *id, blockTag(*block)...)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("token_id", "token_uri")
+		res.add(*id, uri)
+		return res, nil
+	}
+}
+
+func erc721TokensOf(fs *flag.FlagSet) runFunc {
+	owner := fs.String("owner", "", "owner address")
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"owner": *owner}); err != nil {
+			return nil, err
+		}
+		tokens, err := a.erc721.ListTokensOfOwner(ctx, *owner)
+		if err != nil {
+			return nil, err
+		}
+		res := newResult("token_id", "owner", "token_uri")
+		for _, token := range tokens {
+			res.add(token.TokenId, token.Owner, token.TokenURI)
+		}
+		return res, nil
+	}
+}
+
+func erc721Transfer(fs *flag.FlagSet) runFunc {
+	from := fs.String("from", "", "current owner, default is the keystore address")
+	to := fs.String("to", "", "receiver address")
+	id := fs.String("id", "", "token id")
+	safe := fs.Bool("safe", false, "use safeTransferFrom, which checks that a receiver contract accepts the token")
+	value := fs.String("value", "", "native currency sent with the call, e.g. 0.1")
+	tx := registerTxFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"to": *to, "id": *id}); err != nil {
+			return nil, err
+		}
+		if *from != "" && !strings.EqualFold(*from, a.sender) {
+			return nil, fmt.Errorf("--from %s is not the keystore address %s", *from, a.sender)
+		}
+
+		var txId string
+		var err error
+		if *safe {
+			txId, err = a.erc721.WriteSafeTransferFromWithoutData(ctx, *tx.nonce, &model.MethodWriteSafeTransferFromWithoutDataInputs{
+				PayableEther: *value, From: a.sender, To: *to, Id: *id,
+			})
+		} else {
+			txId, err = a.erc721.WriteTransferFrom(ctx, *tx.nonce, &model.MethodWriteTransferFromInputs{
+				PayableEther: *value, From: a.sender, To: *to, Id: *id,
+			})
+		}
+		if err != nil {
+			return nil, err
+		}
+		return tx.txResult(ctx, a.erc721, txId)
+	}
+}
+
+func erc721Approve(fs *flag.FlagSet) runFunc {
+	to := fs.String("to", "", "approved address")
+	id := fs.String("id", "", "token id")
+	value := fs.String("value", "", "native currency sent with the call, e.g. 0.1")
+	tx := registerTxFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"to": *to, "id": *id}); err != nil {
+			return nil, err
+		}
+		txId, err := a.erc721.WriteApprove(ctx, a.sender, *tx.nonce, &model.MethodWriteApproveInputs{
+			PayableEther: *value, ApprovedAddress: *to, Id: *id,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tx.txResult(ctx, a.erc721, txId)
+	}
+}
+
+func erc721ApproveAll(fs *flag.FlagSet) runFunc {
+	operator := fs.String("operator", "", "operator address")
+	approved := fs.Bool("approved", true, "false revokes the operator")
+	tx := registerTxFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		if err := required(map[string]string{"operator": *operator}); err != nil {
+			return nil, err
+		}
+		txId, err := a.erc721.WriteSetApprovalForAll(ctx, a.sender, *tx.nonce, &model.MethodWriteSetApprovalForAllInputs{
+			Operator: *operator, Approved: *approved,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return tx.txResult(ctx, a.erc721, txId)
+	}
+}
+
+func erc721Scan(fs *flag.FlagSet) runFunc {
+	scan := registerScanFlags(fs)
+
+	return func(ctx context.Context, a *app) (*result, error) {
+		byName := make(map[string]model.ContractEvent, len(model.SupportEvents))
+		supported := make([]string, 0, len(model.SupportEvents))
+		for e, name := range model.SupportEvents {
+			byName[name] = e
+			supported = append(supported, name)
+		}
+		sort.Strings(supported)
+
+		var events []model.ContractEvent
+		for _, name := range scan.eventNames(supported) {
+			e, ok := byName[name]
+			if !ok {
+				return nil, fmt.Errorf("unsupported Event:%s", name)
+			}
+			events = append(events, e)
+		}
+		if err := a.erc721.AddEvents(events); err != nil {
+			return nil, err
+		}
+
+		return scan.scan(ctx, scanHead{a.erc721, a.erc721.GetBackend()})
+	}
+}
@@ -0,0 +1,135 @@
+// Command erc reads, writes and scans ERC721 and ERC1155 contracts.
+//
+//	erc erc721 owner-of --rpc https://node --contract 0x... --id 1
+//	erc erc1155 balance-of-batch --owners 0x...,0x... --ids 1,2 -o csv
+//	erc erc721 transfer --keystore key.json --to 0x... --id 1 --wait
+//	erc erc1155 scan --from 100 --to 200 -o json
+//
+// The rpc, contract, keystore and output format can also be set with the ERC_* environment
+// variables or a json config file, see erc -h.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+)
+
+type runFunc func(ctx context.Context, a *app) (*result, error)
+
+type command struct {
+	name   string
+	usage  string
+	write  bool                           // signs transactions with the keystore
+	filter bool                           // filters events
+	setup  func(fs *flag.FlagSet) runFunc // registers the flags of the command
+}
+
+type standard struct {
+	commands []*command
+	open     func(a *app, cmd *command) error // creates the contract of the app
+}
+
+var standards = map[string]*standard{
+	"erc721":  {commands: erc721Commands, open: openERC721},
+	"erc1155": {commands: erc1155Commands, open: openERC1155},
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout, os.Stderr, os.Getenv); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout, stderr io.Writer, getenv func(string) string) error {
+	if len(args) < 2 {
+		usage(stderr)
+		return flag.ErrHelp
+	}
+	std, ok := standards[args[0]]
+	if !ok {
+		usage(stderr)
+		return fmt.Errorf("unknown contract standard:%s", args[0])
+	}
+	var cmd *command
+	for _, c := range std.commands {
+		if c.name == args[1] {
+			cmd = c
+		}
+	}
+	if cmd == nil {
+		usage(stderr)
+		return fmt.Errorf("unknown %s command:%s", args[0], args[1])
+	}
+
+	fs := flag.NewFlagSet("erc "+args[0]+" "+args[1], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	g := registerGlobalFlags(fs)
+	exec := cmd.setup(fs)
+	if err := fs.Parse(args[2:]); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(g, getenv)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(cfg.Timeout))
+	defer cancel()
+
+	a := &app{cfg: cfg}
+	if err = std.open(a, cmd); err != nil {
+		return err
+	}
+	defer a.close()
+
+	res, err := exec(ctx, a)
+	if err != nil {
+		return err
+	}
+	return res.write(stdout, cfg.Output)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: erc <erc721|erc1155> <command> [flags]")
+	names := make([]string, 0, len(standards))
+	for name := range standards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(w, "\n%s commands:\n", name)
+		for _, c := range standards[name].commands {
+			fmt.Fprintf(w, "  %-18s %s\n", c.name, c.usage)
+		}
+	}
+	fmt.Fprintln(w, "\nenvironment: ERC_CONFIG, ERC_RPC, ERC_CONTRACT, ERC_KEYSTORE, ERC_PASSWORD, ERC_PASSWORD_FILE, ERC_MULTICALL, ERC_OUTPUT, ERC_TIMEOUT")
+	fmt.Fprintln(w, "run erc <standard> <command> -h for the flags of a command")
+}
+
+func required(flags map[string]string) error {
+	var missing []string
+	for name, value := range flags {
+		if value == "" {
+			missing = append(missing, "--"+name)
+		}
+	}
+	if len(missing) != 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("%s is required", strings.Join(missing, ", "))
+	}
+	return nil
+}
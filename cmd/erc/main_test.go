@@ -0,0 +1,144 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+)
+
+func env(values map[string]string) func(string) string {
+	return func(key string) string { return values[key] }
+}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "erc.json")
+	content := `{"rpcs":["https://file-node"],"contract":"0xfile","keystore":"file.json","output":"csv","timeout":"10s"}`
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// the environment overrides the config file, the flags override the environment
+	g := &globalFlags{config: path, contract: "0xflag"}
+	cfg, err := loadConfig(g, env(map[string]string{"ERC_RPC": "https://a, https://b", "ERC_CONTRACT": "0xenv", "ERC_OUTPUT": "json"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Rpcs) != 2 || cfg.Rpcs[1] != "https://b" {
+		t.Errorf("unexpected rpcs %v", cfg.Rpcs)
+	}
+	if cfg.Contract != "0xflag" || cfg.Keystore != "file.json" || cfg.Output != outputJSON {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	if time.Duration(cfg.Timeout) != 10*time.Second {
+		t.Errorf("unexpected timeout %v", time.Duration(cfg.Timeout))
+	}
+
+	// an explicit password file wins over ERC_PASSWORD, which wins over ERC_PASSWORD_FILE
+	passwordFile := filepath.Join(t.TempDir(), "password.txt")
+	if err = os.WriteFile(passwordFile, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	passwordEnv := map[string]string{"ERC_RPC": "https://a", "ERC_CONTRACT": "0x1", "ERC_PASSWORD": "from-env", "ERC_PASSWORD_FILE": "missing.txt"}
+	cfg, err = loadConfig(&globalFlags{passwordFile: passwordFile}, env(passwordEnv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password, err := cfg.password(); err != nil || password != "from-file" {
+		t.Errorf("password of the flag file:%s err:%+v", password, err)
+	}
+	cfg, err = loadConfig(&globalFlags{}, env(passwordEnv))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password, err := cfg.password(); err != nil || password != "from-env" {
+		t.Errorf("password of the environment:%s err:%+v", password, err)
+	}
+
+	if _, err = loadConfig(&globalFlags{rpc: "https://a"}, env(nil)); err == nil {
+		t.Errorf("a missing contract should fail")
+	}
+	if _, err = loadConfig(&globalFlags{rpc: "https://a", contract: "0x1", output: "xml"}, env(nil)); err == nil {
+		t.Errorf("an unknown output format should fail")
+	}
+}
+
+func TestResult_Write(t *testing.T) {
+	res := newResult("owner", "id", "balance")
+	res.add("0xa", "1", "10")
+	res.add("0xb", "2", "0")
+
+	var b bytes.Buffer
+	if err := res.write(&b, outputCSV); err != nil {
+		t.Fatal(err)
+	}
+	if b.String() != "owner,id,balance\n0xa,1,10\n0xb,2,0\n" {
+		t.Errorf("unexpected csv %q", b.String())
+	}
+
+	b.Reset()
+	if err := res.write(&b, outputJSON); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(b.String(), `"balance": "10"`) || !strings.HasPrefix(b.String(), "[") {
+		t.Errorf("unexpected json %s", b.String())
+	}
+
+	b.Reset()
+	if err := res.write(&b, outputTable); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(b.String(), "\n")
+	if lines[0] != "OWNER  ID  BALANCE" || lines[1] != "0xa    1   10" {
+		t.Errorf("unexpected table %q", b.String())
+	}
+}
+
+func TestApp_LoadKey(t *testing.T) {
+	privateKey, _ := crypto.GenerateKey()
+	key := &keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(privateKey.PublicKey), PrivateKey: privateKey}
+	data, err := keystore.EncryptKey(key, "secret", keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.json")
+	if err = os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	a := &app{cfg: &config{Keystore: path, Password: "secret"}}
+	hexKey, err := a.loadKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.sender != key.Address.Hex() {
+		t.Errorf("unexpected sender %s", a.sender)
+	}
+	if decoded, err := crypto.HexToECDSA(hexKey); err != nil || decoded.D.Cmp(privateKey.D) != 0 {
+		t.Errorf("the hex key should decode to the keystore key")
+	}
+
+	a.cfg.Password = "wrong"
+	if _, err = a.loadKey(); err == nil {
+		t.Errorf("a wrong password should fail")
+	}
+}
+
+func TestRun_Usage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"erc20", "balance-of"}, &stdout, &stderr, env(nil)); err == nil {
+		t.Errorf("an unknown standard should fail")
+	}
+	if !strings.Contains(stderr.String(), "balance-of-batch") {
+		t.Errorf("usage should list the commands, got %s", stderr.String())
+	}
+	if err := run([]string{"erc721", "owner-of", "--id", "1"}, &stdout, &stderr, env(nil)); err == nil || !strings.Contains(err.Error(), "rpc is required") {
+		t.Errorf("a missing rpc should fail, got %v", err)
+	}
+}
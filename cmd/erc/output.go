@@ -0,0 +1,68 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	outputJSON  = "json"
+	outputCSV   = "csv"
+	outputTable = "table"
+)
+
+// result is the output of a command, one row per record
+type result struct {
+	header []string
+	rows   [][]string
+}
+
+func newResult(header ...string) *result {
+	return &result{header: header}
+}
+
+func (r *result) add(values ...string) {
+	r.rows = append(r.rows, values)
+}
+
+// write prints the result as a json array of objects, csv with a header line or an aligned table
+func (r *result) write(w io.Writer, format string) error {
+	switch format {
+	case outputJSON:
+		records := make([]map[string]string, 0, len(r.rows))
+		for _, row := range r.rows {
+			record := make(map[string]string, len(r.header))
+			for i, name := range r.header {
+				record[name] = row[i]
+			}
+			records = append(records, record)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(r.header); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(r.rows); err != nil {
+			return err
+		}
+		return cw.Error()
+
+	case outputTable:
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, strings.ToUpper(strings.Join(r.header, "\t")))
+		for _, row := range r.rows {
+			fmt.Fprintln(tw, strings.Join(row, "\t"))
+		}
+		return tw.Flush()
+	}
+
+	return fmt.Errorf("unsupported output format:%s", format)
+}
@@ -0,0 +1,364 @@
+// Command ercgen generates Go bindings that compile against contracts/common/bind, the
+// fuzzy address aware fork of the go-ethereum bind package used by this repository.
+//
+//	ercgen --abi erc721.abi --bin erc721.bin --type StandardERC721 --pkg erc721 --out erc721.go
+//	solc --combined-json abi,bin,hashes erc721.sol | ercgen --combined-json - --pkg erc721 --out erc721.go
+//
+// With --sol it runs the local solc itself, after checking it against the pragmas of the sources and
+// the version pinned in --solc-lock, so that the bytecode always matches the .sol files.
+//
+//	ercgen --sol erc721.sol --solc-lock solc.lock --exclude strings --pkg erc721 --out erc721.go
+//
+// With --wrapper it also generates the wrapper layer like contracts/erc721: the binding goes
+// to <dir>/contract, the Contract wrapper to <dir>/<pkg>.go and its inputs and events to <dir>/model.
+//
+//	ercgen --abi erc20.abi --bin erc20.bin --type StandardERC20 --pkg erc20 --wrapper contracts/erc20
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/compiler"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+// contractInput is one contract to generate the binding of
+type contractInput struct {
+	name string            // Go type name
+	abi  string            // abi json
+	bin  string            // deploy bytecode, empty generates no Deploy function
+	sigs map[string]string // function signatures by selector, optional
+}
+
+type options struct {
+	abiFile      string
+	binFile      string
+	typeName     string
+	combinedJSON string
+	exclude      string
+	pkg          string
+	out          string
+	alias        string
+	wrapper      string
+	importPath   string
+	sol          string
+	solc         string
+	solcLock     string
+	updateLock   bool
+}
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintln(os.Stderr, "error:", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	var opts options
+
+	fs := flag.NewFlagSet("ercgen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.StringVar(&opts.abiFile, "abi", "", "abi json file of the contract, - reads stdin")
+	fs.StringVar(&opts.binFile, "bin", "", "bytecode file of the contract, optional")
+	fs.StringVar(&opts.typeName, "type", "", "Go type name of the --abi contract, default is the package name")
+	fs.StringVar(&opts.combinedJSON, "combined-json", "", "solc --combined-json abi,bin,hashes output, - reads stdin")
+	fs.StringVar(&opts.sol, "sol", "", "comma separated solidity sources compiled with --solc")
+	fs.StringVar(&opts.solc, "solc", "solc", "local solc binary compiling the --sol sources")
+	fs.StringVar(&opts.solcLock, "solc-lock", "solc.lock", "lockfile pinning the solc version of the --sol sources, created when missing")
+	fs.BoolVar(&opts.updateLock, "update-lock", false, "pin the version of --solc in --solc-lock instead of checking it")
+	fs.StringVar(&opts.exclude, "exclude", "", "comma separated contract names of the --combined-json or --sol output to skip")
+	fs.StringVar(&opts.pkg, "pkg", "", "package name of the generated file")
+	fs.StringVar(&opts.out, "out", "", "output file, default is stdout")
+	fs.StringVar(&opts.alias, "alias", "", "comma separated original=alias pairs renaming methods or events, e.g. transfer=transfer0")
+	fs.StringVar(&opts.wrapper, "wrapper", "", "directory of the generated wrapper layer, the binding goes to its contract sub directory")
+	fs.StringVar(&opts.importPath, "import-path", "", "import path of the --wrapper directory, default is resolved from go.mod")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if opts.pkg == "" {
+		return errors.New("--pkg is required")
+	}
+	if opts.out != "" && opts.wrapper != "" {
+		return errors.New("--out and --wrapper can not be used together")
+	}
+
+	var inputs []*contractInput
+	var libs map[string]string
+	var err error
+	switch {
+	case countSet(opts.combinedJSON, opts.abiFile, opts.sol) > 1:
+		return errors.New("only one of --abi, --combined-json and --sol can be used")
+	case opts.sol != "":
+		data, err := compileSources(opts.solc, splitList(opts.sol), opts.solcLock, opts.updateLock)
+		if err != nil {
+			return err
+		}
+		inputs, libs, err = parseCombinedJSON(data, splitList(opts.exclude))
+		if err != nil {
+			return err
+		}
+	case opts.combinedJSON != "":
+		data, err := readInput(opts.combinedJSON, stdin)
+		if err != nil {
+			return err
+		}
+		inputs, libs, err = parseCombinedJSON(data, splitList(opts.exclude))
+		if err != nil {
+			return err
+		}
+	case opts.abiFile != "":
+		input, err := loadABI(&opts, stdin)
+		if err != nil {
+			return err
+		}
+		inputs = []*contractInput{input}
+	default:
+		return errors.New("--abi, --combined-json or --sol is required")
+	}
+
+	aliases, err := parseAliases(opts.alias)
+	if err != nil {
+		return err
+	}
+
+	if opts.wrapper != "" {
+		return generateWrapper(&opts, inputs, libs, aliases, stderr)
+	}
+
+	code, err := generate(inputs, opts.pkg, libs, aliases)
+	if err != nil {
+		return err
+	}
+
+	if opts.out == "" {
+		_, err = io.WriteString(stdout, code)
+		return err
+	}
+	return os.WriteFile(opts.out, []byte(code), 0644)
+}
+
+// generate runs bind.Bind over the contracts, the output is gofmt formatted
+func generate(inputs []*contractInput, pkg string, libs map[string]string, aliases map[string]string) (string, error) {
+	if len(inputs) == 0 {
+		return "", errors.New("no contract to generate")
+	}
+	var (
+		types = make([]string, len(inputs))
+		abis  = make([]string, len(inputs))
+		bins  = make([]string, len(inputs))
+		sigs  = make([]map[string]string, len(inputs))
+	)
+	for i, input := range inputs {
+		types[i], abis[i], bins[i], sigs[i] = input.name, input.abi, input.bin, input.sigs
+	}
+	if libs == nil {
+		libs = make(map[string]string)
+	}
+	if aliases == nil {
+		aliases = make(map[string]string)
+	}
+	return bind.Bind(types, abis, bins, sigs, pkg, bind.LangGo, libs, aliases)
+}
+
+// generateWrapper writes the binding and the wrapper layer of one contract into opts.wrapper
+func generateWrapper(opts *options, inputs []*contractInput, libs map[string]string, aliases map[string]string, stderr io.Writer) error {
+	// 包装层只针对一个合约, combined-json包含多个合约时由--type指定
+	var input *contractInput
+	for _, in := range inputs {
+		if len(inputs) == 1 || in.name == opts.typeName {
+			input = in
+		}
+	}
+	if input == nil {
+		return errors.New("--type is required to choose the wrapped contract")
+	}
+
+	importPath := opts.importPath
+	if importPath == "" {
+		var err error
+		if importPath, err = resolveImportPath(opts.wrapper); err != nil {
+			return err
+		}
+	}
+
+	binding, err := generate([]*contractInput{input}, opts.pkg, libs, aliases)
+	if err != nil {
+		return err
+	}
+	wrapper, err := bind.BindWrapper(input.abi, aliases, &bind.WrapperOpts{
+		Type:          input.name,
+		Package:       opts.pkg,
+		BindingImport: path.Join(importPath, "contract"),
+		ModelImport:   path.Join(importPath, "model"),
+	})
+	if err != nil {
+		return err
+	}
+	for _, skipped := range wrapper.Skipped {
+		fmt.Fprintln(stderr, "skipped:", skipped)
+	}
+
+	files := map[string]string{
+		filepath.Join(opts.wrapper, "contract", opts.pkg+".go"): binding,
+		filepath.Join(opts.wrapper, opts.pkg+".go"):             wrapper.Contract,
+		filepath.Join(opts.wrapper, "model", "method.go"):       wrapper.Method,
+		filepath.Join(opts.wrapper, "model", "event.go"):        wrapper.Event,
+	}
+	for file, code := range files {
+		if err = os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			return err
+		}
+		if err = os.WriteFile(file, []byte(code), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveImportPath finds the go.mod above dir and joins its module path with the relative directory
+func resolveImportPath(dir string) (string, error) {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := abs; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			module := modulePath(data)
+			if module == "" {
+				return "", fmt.Errorf("no module path in %s", filepath.Join(root, "go.mod"))
+			}
+			rel, err := filepath.Rel(root, abs)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(module, filepath.ToSlash(rel)), nil
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("no go.mod found above %s, use --import-path", dir)
+		}
+	}
+}
+
+func modulePath(gomod []byte) string {
+	for _, line := range strings.Split(string(gomod), "\n") {
+		if fields := strings.Fields(line); len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+func loadABI(opts *options, stdin io.Reader) (*contractInput, error) {
+	abi, err := readInput(opts.abiFile, stdin)
+	if err != nil {
+		return nil, err
+	}
+	input := &contractInput{name: opts.typeName, abi: string(abi)}
+	if input.name == "" {
+		input.name = opts.pkg
+	}
+	if opts.binFile != "" {
+		bin, err := os.ReadFile(opts.binFile)
+		if err != nil {
+			return nil, err
+		}
+		input.bin = strings.TrimSpace(string(bin))
+	}
+	return input, nil
+}
+
+// parseCombinedJSON returns the contracts of a solc --combined-json output sorted by name, and the
+// link pattern of every contract so that the Deploy functions deploy the libraries first.
+func parseCombinedJSON(data []byte, exclude []string) ([]*contractInput, map[string]string, error) {
+	contracts, err := compiler.ParseCombinedJSON(data, "", "", "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skip := make(map[string]bool, len(exclude))
+	for _, name := range exclude {
+		skip[strings.ToLower(name)] = true
+	}
+
+	var inputs []*contractInput
+	libs := make(map[string]string)
+	for fullName, contract := range contracts {
+		// fullName is source:Name
+		parts := strings.Split(fullName, ":")
+		name := parts[len(parts)-1]
+		if skip[strings.ToLower(name)] {
+			continue
+		}
+		abi, err := jsonString(contract.Info.AbiDefinition)
+		if err != nil {
+			return nil, nil, err
+		}
+		inputs = append(inputs, &contractInput{name: name, abi: abi, bin: contract.Code, sigs: contract.Hashes})
+		libs[crypto.Keccak256Hash([]byte(fullName)).String()[2:36]] = name
+	}
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].name < inputs[j].name })
+
+	return inputs, libs, nil
+}
+
+func parseAliases(s string) (map[string]string, error) {
+	aliases := make(map[string]string)
+	for _, pair := range splitList(s) {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+			return nil, fmt.Errorf("invalid alias:%s", pair)
+		}
+		aliases[kv[0]] = kv[1]
+	}
+	return aliases, nil
+}
+
+func readInput(path string, stdin io.Reader) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
+
+func countSet(values ...string) int {
+	n := 0
+	for _, v := range values {
+		if v != "" {
+			n++
+		}
+	}
+	return n
+}
+
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+func jsonString(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,423 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+)
+
+func TestRun_ABI(t *testing.T) {
+	dir := t.TempDir()
+	abiFile, binFile, out := filepath.Join(dir, "erc721.abi"), filepath.Join(dir, "erc721.bin"), filepath.Join(dir, "erc721.go")
+	_ = os.WriteFile(abiFile, []byte(erc721.StandardERC721MetaData.ABI), 0644)
+	_ = os.WriteFile(binFile, []byte(erc721.StandardERC721MetaData.Bin[2:]+"\n"), 0644)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"--abi", abiFile, "--bin", binFile, "--type", "StandardERC721", "--pkg", "erc721", "--out", out}
+	if err := run(args, nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = parser.ParseFile(token.NewFileSet(), out, code, 0); err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	for _, want := range []string{
+		`"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"`,
+		"func NewStandardERC721Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer)",
+		"return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil",
+		"func DeployStandardERC721(auth *bind.TransactOpts, backend bind.ContractBackend, name string, symbol string)",
+	} {
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated code should contain %s", want)
+		}
+	}
+	if strings.Contains(string(code), "go-ethereum/accounts/abi/bind") {
+		t.Errorf("generated code should not import the upstream bind package")
+	}
+}
+
+func TestRun_CombinedJSON(t *testing.T) {
+	hashes := make(map[string]string)
+	for selector, sig := range erc721.StandardERC721MetaData.Sigs {
+		hashes[sig] = selector
+	}
+	combined := map[string]interface{}{
+		"contracts": map[string]interface{}{
+			"erc721.sol:StandardERC721": map[string]interface{}{
+				"abi":    json.RawMessage(erc721.StandardERC721MetaData.ABI),
+				"bin":    erc721.StandardERC721MetaData.Bin[2:],
+				"hashes": hashes,
+			},
+			"erc721.sol:Strings": map[string]interface{}{"abi": json.RawMessage("[]"), "bin": ""},
+		},
+		"version": "0.8.0",
+	}
+	data, _ := json.Marshal(combined)
+
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"--combined-json", "-", "--pkg", "erc721", "--exclude", "strings"}, bytes.NewReader(data), &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	code := stdout.String()
+	if !strings.Contains(code, "var StandardERC721MetaData = &bind.MetaData{") || !strings.Contains(code, `"23b872dd": "transferFrom(address,address,uint256)",`) {
+		t.Errorf("unexpected generated code")
+	}
+	if strings.Contains(code, "StringsMetaData") {
+		t.Errorf("excluded contract should not be generated")
+	}
+}
+
+// combinedJSONOf rebuilds the solc --combined-json output of a generated binding from its MetaData variables
+func combinedJSONOf(t *testing.T, file string) []byte {
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	contracts := make(map[string]interface{})
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			name := vs.Names[0].Name
+			unary, ok := vs.Values[0].(*ast.UnaryExpr)
+			if !strings.HasSuffix(name, "MetaData") || !ok {
+				continue
+			}
+
+			contract := map[string]interface{}{"abi": json.RawMessage("[]"), "bin": ""}
+			for _, elt := range unary.X.(*ast.CompositeLit).Elts {
+				kv := elt.(*ast.KeyValueExpr)
+				switch kv.Key.(*ast.Ident).Name {
+				case "ABI":
+					abi, _ := strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+					contract["abi"] = json.RawMessage(abi)
+				case "Bin":
+					bin, _ := strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+					contract["bin"] = strings.TrimPrefix(bin, "0x")
+				case "Sigs":
+					hashes := make(map[string]string)
+					for _, e := range kv.Value.(*ast.CompositeLit).Elts {
+						pair := e.(*ast.KeyValueExpr)
+						selector, _ := strconv.Unquote(pair.Key.(*ast.BasicLit).Value)
+						sig, _ := strconv.Unquote(pair.Value.(*ast.BasicLit).Value)
+						hashes[sig] = selector
+					}
+					contract["hashes"] = hashes
+				}
+			}
+			contracts["contract.sol:"+strings.TrimSuffix(name, "MetaData")] = contract
+		}
+	}
+
+	data, err := json.Marshal(map[string]interface{}{"contracts": contracts, "version": "0.8.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+// the committed bindings must be the unmodified output of ercgen
+func TestRun_CommittedBindings(t *testing.T) {
+	for file, pkg := range map[string]string{
+		"../../contracts/erc721/contract/erc721.go":                 "erc721",
+		"../../contracts/erc721/contract/erc721_initializable.go":   "erc721",
+		"../../contracts/erc1155/contract/erc1155.go":               "erc1155",
+		"../../contracts/erc1155/contract/erc1155_initializable.go": "erc1155",
+		"../../proxy/contract/proxy.go":                             "proxy",
+	} {
+		committed, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var stdout, stderr bytes.Buffer
+		if err = run([]string{"--combined-json", "-", "--pkg", pkg}, bytes.NewReader(combinedJSONOf(t, file)), &stdout, &stderr); err != nil {
+			t.Fatal(err)
+		}
+		if stdout.String() != string(committed) {
+			t.Errorf("%s differs from the ercgen output, regenerate it instead of editing it", file)
+		}
+	}
+}
+
+// the bytecode of the committed bindings must be built from the sources with the compiler and settings of the
+// lockfiles, it is only checked when the local solc is the pinned version
+func TestRun_CommittedBytecode(t *testing.T) {
+	solc, err := exec.LookPath("solc")
+	if err != nil {
+		t.Skip("solc not found")
+	}
+	current, err := solcVersion(solc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for file, source := range map[string][2]string{
+		"../../contracts/erc721/contract/erc721.go":                 {"../../contracts/erc721/contract/erc721.sol", "../../contracts/solc.lock"},
+		"../../contracts/erc721/contract/erc721_initializable.go":   {"../../contracts/erc721/contract/erc721_initializable.sol", "../../contracts/solc-upgradeable.lock"},
+		"../../contracts/erc1155/contract/erc1155.go":               {"../../contracts/erc1155/contract/erc1155.sol", "../../contracts/solc.lock"},
+		"../../contracts/erc1155/contract/erc1155_initializable.go": {"../../contracts/erc1155/contract/erc1155_initializable.sol", "../../contracts/solc-upgradeable.lock"},
+		"../../proxy/contract/proxy.go":                             {"../../proxy/contract/proxy.sol", "../../contracts/solc-upgradeable.lock"},
+	} {
+		data, err := os.ReadFile(source[1])
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lock solcLock
+		if err = json.Unmarshal(data, &lock); err != nil {
+			t.Fatal(err)
+		}
+		if lock.Version != current.String() {
+			t.Logf("%s pins solc %s, the local solc is %s", source[1], lock.Version, current)
+			continue
+		}
+
+		output, err := compileSources(solc, []string{source[0]}, source[1], false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compiled, _, err := parseCombinedJSON(output, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bins := make(map[string]string)
+		for _, contract := range compiled {
+			bins[contract.name] = contract.bin
+		}
+
+		var committed struct {
+			Contracts map[string]struct {
+				Bin string `json:"bin"`
+			} `json:"contracts"`
+		}
+		_ = json.Unmarshal(combinedJSONOf(t, file), &committed)
+		for name, contract := range committed.Contracts {
+			name = strings.TrimPrefix(name, "contract.sol:")
+			if contract.Bin != "" && withoutMetadata(contract.Bin) != withoutMetadata(strings.TrimPrefix(bins[name], "0x")) {
+				t.Errorf("bytecode of %s in %s is not built from %s with the settings of %s", name, file, source[0], source[1])
+			}
+		}
+	}
+}
+
+// metadataPattern is the cbor metadata solc appends to every runtime and creation code: the ipfs hash of the
+// metadata and the compiler version
+var metadataPattern = regexp.MustCompile(`a264697066735822[0-9a-f]{68}64736f6c6343[0-9a-f]{6}0033`)
+
+// withoutMetadata strips the metadata of the bytecode, its hash covers the source paths which differ between the
+// test and the generation from the repository root
+func withoutMetadata(bin string) string {
+	return metadataPattern.ReplaceAllString(strings.ToLower(bin), "")
+}
+
+// the generated binding and wrapper packages must pass go build and go vet
+func TestRun_BuildGenerated(t *testing.T) {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+
+	// 生成的包在临时模块中, 通过replace引用本模块的contracts/common/bind
+	root, err := filepath.Abs("../..")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	goMod := "module testgen\n\ngo 1.17\n\nrequire github.com/jason-bateman/go-erc-standard-contract v0.0.0\n\n" +
+		"replace github.com/jason-bateman/go-erc-standard-contract => " + strconv.Quote(root) + "\n"
+	if err = os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644); err != nil {
+		t.Fatal(err)
+	}
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"--combined-json", "-", "--pkg", "testgen", "--out", filepath.Join(dir, "binding.go")}
+	if err = run(args, bytes.NewReader(combinedJSONOf(t, "../../contracts/erc1155/contract/erc1155.go")), &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	args = []string{"--combined-json", "-", "--type", "StandardERC721", "--pkg", "erc721", "--wrapper", filepath.Join(dir, "erc721"), "--import-path", "testgen/erc721"}
+	if err = run(args, bytes.NewReader(combinedJSONOf(t, "../../contracts/erc721/contract/erc721.go")), &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+
+	// -mod=mod adds the requirements of the imports from the module cache
+	for _, command := range [][]string{{"build", "-mod=mod", "./..."}, {"vet", "-mod=mod", "./..."}} {
+		cmd := exec.Command(goBin, command...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOFLAGS=", "GOPROXY=off", "GOWORK=off")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Errorf("go %s of the generated package err:%v\n%s", command[0], err, out)
+		}
+	}
+}
+
+func TestRun_Wrapper(t *testing.T) {
+	dir := t.TempDir()
+	abiFile := filepath.Join(dir, "erc721.abi")
+	_ = os.WriteFile(abiFile, []byte(erc721.StandardERC721MetaData.ABI), 0644)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"--abi", abiFile, "--type", "StandardERC721", "--pkg", "erc721", "--wrapper", filepath.Join(dir, "erc721"), "--import-path", "example.com/erc721"}
+	if err := run(args, nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+
+	for file, want := range map[string]string{
+		"contract/erc721.go": "func NewStandardERC721Caller(address common.Address, caller bind.ContractCaller)",
+		"erc721.go":          `erc721 "example.com/erc721/contract"`,
+		"model/method.go":    "type MethodWriteTransferFromInputs struct",
+		"model/event.go":     "var SupportEvents = map[ContractEvent]string{",
+	} {
+		path := filepath.Join(dir, "erc721", file)
+		code, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = parser.ParseFile(token.NewFileSet(), path, code, 0); err != nil {
+			t.Fatalf("generated %s does not parse: %v", file, err)
+		}
+		if !strings.Contains(string(code), want) {
+			t.Errorf("generated %s should contain %s", file, want)
+		}
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("wrapper mode should not write stdout")
+	}
+}
+
+// the erc721 and erc1155 wrappers are written by hand around their standard methods, the rest of them must stay the
+// scaffold the wrapper template generates, so that a fix of one reaches the other
+func TestRun_WrapperScaffold(t *testing.T) {
+	for pkg, typeName := range map[string]string{"erc721": "StandardERC721", "erc1155": "StandardERC1155"} {
+		dir := t.TempDir()
+		file := filepath.Join("../../contracts", pkg, pkg+".go")
+
+		var stdout, stderr bytes.Buffer
+		args := []string{"--combined-json", "-", "--type", typeName, "--pkg", pkg, "--wrapper", dir, "--import-path", "github.com/jason-bateman/go-erc-standard-contract/contracts/" + pkg}
+		if err := run(args, bytes.NewReader(combinedJSONOf(t, filepath.Join("../../contracts", pkg, "contract", pkg+".go"))), &stdout, &stderr); err != nil {
+			t.Fatal(err)
+		}
+
+		generated, written := scaffoldOf(t, filepath.Join(dir, pkg+".go")), scaffoldOf(t, file)
+		for name, code := range generated {
+			if written[name] != code {
+				t.Errorf("%s of %s differs from the wrapper template, change both", name, file)
+			}
+		}
+	}
+}
+
+// scaffoldOf returns the declarations of a wrapper file which do not depend on the abi, keyed by name and printed
+// without comments and layout. Receivers are renamed to c, the event cases of FilterEvents are left out.
+func scaffoldOf(t *testing.T, file string) map[string]string {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decls := make(map[string]ast.Node)
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			name := decl.Name.Name
+			if strings.HasPrefix(name, "Read") || strings.HasPrefix(name, "Write") || name == "SetEventFilter" ||
+				(strings.HasPrefix(name, "event") && name != "eventGeneric" && name != "eventMsgCommonFill") {
+				continue
+			}
+			if decl.Recv != nil && len(decl.Recv.List[0].Names) != 0 {
+				receiver := decl.Recv.List[0].Names[0].Name
+				ast.Inspect(decl, func(node ast.Node) bool {
+					if ident, ok := node.(*ast.Ident); ok && ident.Name == receiver {
+						ident.Name = "c"
+					}
+					return true
+				})
+			}
+			if name == "FilterEvents" {
+				ast.Inspect(decl.Body, func(node ast.Node) bool {
+					if sw, ok := node.(*ast.SwitchStmt); ok {
+						var clauses []ast.Stmt
+						for _, clause := range sw.Body.List {
+							if clause.(*ast.CaseClause).List == nil {
+								clauses = append(clauses, clause)
+							}
+						}
+						sw.Body.List = clauses
+					}
+					return true
+				})
+			}
+			decls[name] = decl
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				if spec, ok := spec.(*ast.TypeSpec); ok && spec.Name.Name != "eventTopics" {
+					decls["type "+spec.Name.Name] = spec
+				}
+			}
+		}
+	}
+
+	scaffold := make(map[string]string, len(decls))
+	for name, decl := range decls {
+		var b bytes.Buffer
+		if err = printer.Fprint(&b, fset, decl); err != nil {
+			t.Fatal(err)
+		}
+		scaffold[name] = strings.Join(strings.Fields(b.String()), " ")
+	}
+	return scaffold
+}
+
+func TestResolveImportPath(t *testing.T) {
+	importPath, err := resolveImportPath("../../contracts/erc721")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importPath != "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721" {
+		t.Errorf("unexpected import path %s", importPath)
+	}
+}
+
+func TestRun_Invalid(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := run([]string{"--abi", "x.abi"}, nil, &stdout, &stderr); err == nil {
+		t.Errorf("missing --pkg should fail")
+	}
+	if err := run([]string{"--pkg", "x"}, nil, &stdout, &stderr); err == nil {
+		t.Errorf("missing input should fail")
+	}
+	if err := run([]string{"--abi", "x.abi", "--pkg", "x", "--wrapper", "x", "--out", "x.go"}, nil, &stdout, &stderr); err == nil {
+		t.Errorf("--out with --wrapper should fail")
+	}
+	if _, err := parseAliases("transfer"); err == nil {
+		t.Errorf("alias without = should fail")
+	}
+}
@@ -0,0 +1,241 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	pragmaPattern  = regexp.MustCompile(`pragma\s+solidity\s+([^;]+);`)
+	versionPattern = regexp.MustCompile(`(\d+)\.(\d+)\.(\d+)`)
+	partialPattern = regexp.MustCompile(`^(\^|~|>=|<=|>|<|=)?(\d+)(?:\.(\d+))?(?:\.(\d+))?$`)
+	operatorSpace  = regexp.MustCompile(`(\^|~|>=|<=|>|<|=)\s+`)
+)
+
+// solcLock pins the compiler version and the settings the bytecode of the generated bindings is built with
+type solcLock struct {
+	Version      string `json:"version"`
+	EVMVersion   string `json:"evm_version,omitempty"`   // --evm-version, empty is the default of the compiler
+	Optimize     bool   `json:"optimize,omitempty"`      // --optimize
+	OptimizeRuns int    `json:"optimize_runs,omitempty"` // --optimize-runs, zero is the default of the compiler
+}
+
+// args returns the solc flags of the pinned settings
+func (l *solcLock) args() []string {
+	var args []string
+	if l.EVMVersion != "" {
+		args = append(args, "--evm-version", l.EVMVersion)
+	}
+	if l.Optimize {
+		args = append(args, "--optimize")
+		if l.OptimizeRuns > 0 {
+			args = append(args, "--optimize-runs", strconv.Itoa(l.OptimizeRuns))
+		}
+	}
+	return args
+}
+
+// version is a solidity compiler version
+type version [3]int
+
+func parseVersion(s string) (version, error) {
+	var v version
+	match := versionPattern.FindStringSubmatch(s)
+	if match == nil {
+		return v, fmt.Errorf("invalid solc version:%s", s)
+	}
+	for i := range v {
+		v[i], _ = strconv.Atoi(match[i+1])
+	}
+	return v, nil
+}
+
+func (v version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v[0], v[1], v[2])
+}
+
+func (v version) compare(o version) int {
+	for i := range v {
+		if v[i] != o[i] {
+			if v[i] < o[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// satisfies reports whether v matches a pragma constraint like ^0.8.0, >=0.4.22 <0.9.0 or 0.5.16 || ^0.8.0
+func (v version) satisfies(constraint string) (bool, error) {
+	for _, set := range strings.Split(constraint, "||") {
+		ok := true
+		for _, c := range strings.Fields(normalizeConstraint(set)) {
+			matched, err := v.matches(c)
+			if err != nil {
+				return false, err
+			}
+			ok = ok && matched
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// normalizeConstraint glues the operators to their versions, e.g. ">= 0.8.0" to ">=0.8.0"
+func normalizeConstraint(set string) string {
+	return operatorSpace.ReplaceAllString(set, "$1")
+}
+
+func (v version) matches(c string) (bool, error) {
+	match := partialPattern.FindStringSubmatch(c)
+	if match == nil {
+		return false, fmt.Errorf("invalid pragma constraint:%s", c)
+	}
+	var bound version
+	parts := 1
+	for i := 0; i < 3; i++ {
+		if match[i+2] != "" {
+			bound[i], _ = strconv.Atoi(match[i+2])
+			parts = i + 1
+		}
+	}
+	cmp := v.compare(bound)
+
+	switch match[1] {
+	case "^":
+		// ^0.8.0 锁定第一个非零位: >=0.8.0 <0.9.0
+		var upper version
+		switch {
+		case bound[0] != 0 || parts == 1:
+			upper = version{bound[0] + 1, 0, 0}
+		case bound[1] != 0 || parts == 2:
+			upper = version{0, bound[1] + 1, 0}
+		default:
+			upper = version{0, 0, bound[2] + 1}
+		}
+		return cmp >= 0 && v.compare(upper) < 0, nil
+	case "~":
+		upper := version{bound[0], bound[1] + 1, 0}
+		if parts == 1 {
+			upper = version{bound[0] + 1, 0, 0}
+		}
+		return cmp >= 0 && v.compare(upper) < 0, nil
+	case ">=":
+		return cmp >= 0, nil
+	case "<=":
+		return cmp <= 0 || v.samePrefix(bound, parts), nil
+	case ">":
+		return cmp > 0 && !v.samePrefix(bound, parts), nil
+	case "<":
+		return cmp < 0, nil
+	default:
+		return v.samePrefix(bound, parts), nil
+	}
+}
+
+// samePrefix matches a partial version, e.g. 0.8.3 is 0.8
+func (v version) samePrefix(o version, parts int) bool {
+	for i := 0; i < parts; i++ {
+		if v[i] != o[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// sourcePragmas returns the version constraints of every pragma solidity of the source
+func sourcePragmas(source []byte) []string {
+	var pragmas []string
+	for _, match := range pragmaPattern.FindAllSubmatch(source, -1) {
+		pragmas = append(pragmas, strings.TrimSpace(string(match[1])))
+	}
+	return pragmas
+}
+
+// solcVersion runs solc --version, both solc and solcjs print the version as x.y.z+commit
+func solcVersion(solc string) (version, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(solc, "--version")
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err := cmd.Run(); err != nil {
+		return version{}, fmt.Errorf("%s --version: %v %s", solc, err, strings.TrimSpace(stderr.String()))
+	}
+	return parseVersion(stdout.String())
+}
+
+// compileSources checks the configured solc against the pragmas of the sources and the version pinned in the
+// lockfile, then returns its --combined-json output built with the settings of the lockfile. A missing lockfile, or
+// updateLock, pins the solc version and keeps the settings.
+func compileSources(solc string, sources []string, lockFile string, updateLock bool) ([]byte, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("no solidity source to compile")
+	}
+
+	current, err := solcVersion(solc)
+	if err != nil {
+		return nil, err
+	}
+
+	// 锁定文件存在时要求本地solc与锁定版本一致
+	var lock solcLock
+	data, err := os.ReadFile(lockFile)
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(data, &lock); err != nil {
+			return nil, fmt.Errorf("invalid lockfile %s: %v", lockFile, err)
+		}
+		if updateLock {
+			lock.Version = current.String()
+		} else if lock.Version != current.String() {
+			return nil, fmt.Errorf("%s is version %s but %s pins %s, switch the compiler or use --update-lock", solc, current, lockFile, lock.Version)
+		}
+	case os.IsNotExist(err):
+		lock.Version = current.String()
+	default:
+		return nil, err
+	}
+
+	for _, source := range sources {
+		code, err := os.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+		for _, pragma := range sourcePragmas(code) {
+			ok, err := current.satisfies(pragma)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", source, err)
+			}
+			if !ok {
+				return nil, fmt.Errorf("%s requires solidity %s, %s is version %s", source, pragma, solc, current)
+			}
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(solc, append(append([]string{"--combined-json", "abi,bin,hashes"}, lock.args()...), sources...)...)
+	cmd.Stdout, cmd.Stderr = &stdout, &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %v\n%s", solc, err, strings.TrimSpace(stderr.String()))
+	}
+
+	if len(data) == 0 || updateLock {
+		data, err = json.MarshalIndent(&lock, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if err = os.WriteFile(lockFile, append(data, '\n'), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+)
+
+func TestVersion_Satisfies(t *testing.T) {
+	for _, c := range []struct {
+		version    string
+		constraint string
+		want       bool
+	}{
+		{"0.8.0", "^0.8.0", true},
+		{"0.8.19", "^0.8", true},
+		{"0.9.0", "^0.8.0", false},
+		{"0.7.6", "^0.8.0", false},
+		{"0.0.4", "^0.0.3", false},
+		{"1.5.0", "^1.2", true},
+		{"0.8.4", "~0.8.1", true},
+		{"0.5.16", "0.5.16", true},
+		{"0.5.17", "=0.5.16", false},
+		{"0.8.0", ">=0.4.22 <0.9.0", true},
+		{"0.8.0", ">= 0.4.22 < 0.8.0", false},
+		{"0.8.5", ">0.8", false},
+		{"0.8.5", "<=0.8", true},
+		{"0.8.0", "0.5.16 || ^0.8.0", true},
+	} {
+		v, err := parseVersion(c.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := v.satisfies(c.constraint)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != c.want {
+			t.Errorf("%s satisfies %s = %v, want %v", c.version, c.constraint, got, c.want)
+		}
+	}
+	if _, err := (version{0, 8, 0}).satisfies("^x.8"); err == nil {
+		t.Errorf("invalid constraint should fail")
+	}
+}
+
+func TestSourcePragmas(t *testing.T) {
+	pragmas := sourcePragmas([]byte("// SPDX\npragma solidity ^0.8.0;\ncontract A {}\npragma  solidity >=0.4.22 <0.9.0 ;\n"))
+	if len(pragmas) != 2 || pragmas[0] != "^0.8.0" || pragmas[1] != ">=0.4.22 <0.9.0" {
+		t.Errorf("unexpected pragmas %v", pragmas)
+	}
+}
+
+// fakeSolc writes a solc that prints the version and a combined json of StandardERC721, the compile arguments are
+// saved in the args file of dir
+func fakeSolc(t *testing.T, dir string, version string) string {
+	if runtime.GOOS == "windows" {
+		t.Skip("fake solc is a shell script")
+	}
+	combined := map[string]interface{}{
+		"contracts": map[string]interface{}{
+			"erc721.sol:StandardERC721": map[string]interface{}{
+				"abi": json.RawMessage(erc721.StandardERC721MetaData.ABI),
+				"bin": erc721.StandardERC721MetaData.Bin[2:],
+			},
+		},
+		"version": version,
+	}
+	data, _ := json.Marshal(combined)
+	_ = os.WriteFile(filepath.Join(dir, "combined.json"), data, 0644)
+
+	solc := filepath.Join(dir, "solc")
+	script := "#!/bin/sh\nif [ \"$1\" = \"--version\" ]; then\n  echo 'solc, the solidity compiler commandline interface'\n  echo 'Version: " + version + "+commit.c7dfd78e.Linux.g++'\n  exit 0\nfi\necho \"$@\" > " + filepath.Join(dir, "args") + "\ncat " + filepath.Join(dir, "combined.json") + "\n"
+	if err := os.WriteFile(solc, []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return solc
+}
+
+func TestRun_Sol(t *testing.T) {
+	dir := t.TempDir()
+	source, lock := filepath.Join(dir, "erc721.sol"), filepath.Join(dir, "solc.lock")
+	_ = os.WriteFile(source, []byte("pragma solidity ^0.8.0;\ncontract StandardERC721 {}\n"), 0644)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"--sol", source, "--solc", fakeSolc(t, dir, "0.8.0"), "--solc-lock", lock, "--pkg", "erc721"}
+	if err := run(args, nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(stdout.String(), "var StandardERC721MetaData = &bind.MetaData{") {
+		t.Errorf("unexpected generated code")
+	}
+	data, err := os.ReadFile(lock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"version": "0.8.0"`) {
+		t.Errorf("unexpected lockfile %s", data)
+	}
+
+	// 本地solc与锁定版本不一致
+	args[3] = fakeSolc(t, dir, "0.8.1")
+	if err = run(args, nil, &stdout, &stderr); err == nil || !strings.Contains(err.Error(), "pins 0.8.0") {
+		t.Errorf("solc not matching the lockfile should fail, got %v", err)
+	}
+	if err = run(append(args, "--update-lock"), nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ = os.ReadFile(lock); !strings.Contains(string(data), `"version": "0.8.1"`) {
+		t.Errorf("lockfile should be updated, got %s", data)
+	}
+
+	// pragma不满足
+	args[3] = fakeSolc(t, dir, "0.7.6")
+	if err = run(append(args, "--update-lock"), nil, &stdout, &stderr); err == nil || !strings.Contains(err.Error(), "requires solidity ^0.8.0") {
+		t.Errorf("solc not matching the pragma should fail, got %v", err)
+	}
+}
+
+func TestRun_SolLockSettings(t *testing.T) {
+	dir := t.TempDir()
+	source, lock := filepath.Join(dir, "erc721.sol"), filepath.Join(dir, "solc.lock")
+	_ = os.WriteFile(source, []byte("pragma solidity ^0.8.0;\ncontract StandardERC721 {}\n"), 0644)
+	_ = os.WriteFile(lock, []byte(`{"version":"0.8.0","evm_version":"london","optimize":true,"optimize_runs":200}`), 0644)
+
+	var stdout, stderr bytes.Buffer
+	args := []string{"--sol", source, "--solc", fakeSolc(t, dir, "0.8.0"), "--solc-lock", lock, "--pkg", "erc721"}
+	if err := run(args, nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(filepath.Join(dir, "args"))
+	if want := "--combined-json abi,bin,hashes --evm-version london --optimize --optimize-runs 200 " + source; strings.TrimSpace(string(data)) != want {
+		t.Errorf("solc args %s, want %s", data, want)
+	}
+
+	// 更新锁定版本时保留编译设置
+	args[3] = fakeSolc(t, dir, "0.8.1")
+	if err := run(append(args, "--update-lock"), nil, &stdout, &stderr); err != nil {
+		t.Fatal(err)
+	}
+	var updated solcLock
+	data, _ = os.ReadFile(lock)
+	if err := json.Unmarshal(data, &updated); err != nil || updated != (solcLock{Version: "0.8.1", EVMVersion: "london", Optimize: true, OptimizeRuns: 200}) {
+		t.Errorf("updated lockfile %s err:%v", data, err)
+	}
+}
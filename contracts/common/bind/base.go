@@ -30,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/jason-bateman/go-erc-standard-contract/tracing"
 )
 
 // SignerFn is a signer function callback when a contract requires a method to
@@ -65,8 +66,9 @@ type TransactOpts struct {
 // FilterOpts is the collection of options to fine tune filtering for events
 // within a bound contract.
 type FilterOpts struct {
-	Start uint64  // Start of the queried range
-	End   *uint64 // End of the range (nil = latest)
+	Start     uint64           // Start of the queried range
+	End       *uint64          // End of the range (nil = latest)
+	Addresses []common.Address // Contract addresses to match instead of the bound one (nil = bound address)
 
 	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
 }
@@ -74,8 +76,9 @@ type FilterOpts struct {
 // WatchOpts is the collection of options to fine tune subscribing for events
 // within a bound contract.
 type WatchOpts struct {
-	Start   *uint64         // Start of the queried range (nil = latest)
-	Context context.Context // Network context to support cancellation and timeouts (nil = no timeout)
+	Start     *uint64          // Start of the queried range (nil = latest)
+	Addresses []common.Address // Contract addresses to match instead of the bound one (nil = bound address)
+	Context   context.Context  // Network context to support cancellation and timeouts (nil = no timeout)
 }
 
 // MetaData collects all metadata for a bound contract.
@@ -148,7 +151,7 @@ func DeployContract(opts *TransactOpts, abi abi.ABI, bytecode []byte, backend Co
 // sets the output to result. The result type might be a single field for simple
 // returns, a slice of interfaces for anonymous returns and a struct for named
 // returns.
-func (c *BoundContract) Call(opts *CallOpts, results *[]interface{}, method string, params ...interface{}) error {
+func (c *BoundContract) Call(opts *CallOpts, results *[]interface{}, method string, params ...interface{}) (err error) {
 	// Don't crash on a lazy user
 	if opts == nil {
 		opts = new(CallOpts)
@@ -156,6 +159,10 @@ func (c *BoundContract) Call(opts *CallOpts, results *[]interface{}, method stri
 	if results == nil {
 		results = new([]interface{})
 	}
+	ctx, span := tracing.Start(ensureContext(opts.Context), "bind.Call",
+		tracing.String(tracing.AttrContract, c.address.Hex()), tracing.String(tracing.AttrMethod, method))
+	defer func() { span.End(err) }()
+
 	// Pack the input, call and unpack the results
 	input, err := c.abi.Pack(method, params...)
 	if err != nil {
@@ -163,7 +170,6 @@ func (c *BoundContract) Call(opts *CallOpts, results *[]interface{}, method stri
 	}
 	var (
 		msg    = ethereum.CallMsg{From: opts.From, To: &c.address, Data: input}
-		ctx    = ensureContext(opts.Context)
 		code   []byte
 		output []byte
 	)
@@ -362,15 +368,19 @@ func (c *BoundContract) getNonce(opts *TransactOpts) (uint64, error) {
 
 // transact executes an actual transaction invocation, first deriving any missing
 // authorization fields, and then scheduling the transaction for execution.
-func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, input []byte) (*types.Transaction, error) {
+func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, input []byte) (_ *types.Transaction, err error) {
+	ctx, span := tracing.Start(ensureContext(opts.Context), "bind.transact", c.transactAttributes(opts, contract, input)...)
+	defer func() { span.End(err) }()
+	// The rpc calls below run inside the span
+	copied := *opts
+	copied.Context = ctx
+	opts = &copied
+
 	if opts.GasPrice != nil && (opts.GasFeeCap != nil || opts.GasTipCap != nil) {
 		return nil, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
 	}
 	// Create the transaction
-	var (
-		rawTx *types.Transaction
-		err   error
-	)
+	var rawTx *types.Transaction
 	if opts.GasPrice != nil {
 		rawTx, err = c.createLegacyTx(opts, contract, input)
 	} else {
@@ -395,22 +405,51 @@ func (c *BoundContract) transact(opts *TransactOpts, contract *common.Address, i
 	if err != nil {
 		return nil, err
 	}
+	span.SetAttributes(tracing.String(tracing.AttrTxHash, signedTx.Hash().Hex()), tracing.Uint64(tracing.AttrNonce, signedTx.Nonce()))
+	if chainId := signedTx.ChainId(); chainId != nil && chainId.Sign() > 0 {
+		span.SetAttributes(tracing.String(tracing.AttrChainId, chainId.String()))
+	}
 	if opts.NoSend {
 		return signedTx, nil
 	}
-	if err := c.transactor.SendTransaction(ensureContext(opts.Context), signedTx); err != nil {
+	if err := c.transactor.SendTransaction(ctx, signedTx); err != nil {
 		return nil, err
 	}
 	return signedTx, nil
 }
 
+// transactAttributes returns the span attributes known before the transaction is created
+func (c *BoundContract) transactAttributes(opts *TransactOpts, contract *common.Address, input []byte) []tracing.Attribute {
+	attrs := []tracing.Attribute{tracing.String(tracing.AttrFrom, opts.From.Hex())}
+	if contract == nil {
+		return append(attrs, tracing.String(tracing.AttrMethod, "constructor"))
+	}
+	attrs = append(attrs, tracing.String(tracing.AttrContract, contract.Hex()))
+	if len(input) >= 4 {
+		if method, err := c.abi.MethodById(input[:4]); err == nil {
+			attrs = append(attrs, tracing.String(tracing.AttrMethod, method.RawName))
+		}
+	}
+	return attrs
+}
+
 // FilterLogs filters contract logs for past blocks, returning the necessary
 // channels to construct a strongly typed bound iterator on top of them.
-func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) (chan types.Log, event.Subscription, error) {
+func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]interface{}) (_ chan types.Log, _ event.Subscription, err error) {
 	// Don't crash on a lazy user
 	if opts == nil {
 		opts = new(FilterOpts)
 	}
+	ctx, span := tracing.Start(ensureContext(opts.Context), "bind.FilterLogs",
+		tracing.String(tracing.AttrEvent, name), tracing.Uint64(tracing.AttrFromBlock, opts.Start))
+	if opts.End != nil {
+		span.SetAttributes(tracing.Uint64(tracing.AttrToBlock, *opts.End))
+	}
+	if !c.fuzzyAddress && len(opts.Addresses) == 0 {
+		span.SetAttributes(tracing.String(tracing.AttrContract, c.address.Hex()))
+	}
+	defer func() { span.End(err) }()
+
 	// Append the event selector to the query parameters and construct the topic set
 	query = append([][]interface{}{{c.abi.Events[name].ID}}, query...)
 
@@ -422,7 +461,9 @@ func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]int
 	logs := make(chan types.Log, 128)
 
 	var addresses []common.Address
-	if c.fuzzyAddress {
+	if len(opts.Addresses) != 0 {
+		addresses = opts.Addresses
+	} else if c.fuzzyAddress {
 		addresses = []common.Address{}
 	} else {
 		addresses = []common.Address{c.address}
@@ -439,10 +480,11 @@ func (c *BoundContract) FilterLogs(opts *FilterOpts, name string, query ...[]int
 	/* TODO(karalabe): Replace the rest of the method below with this when supported
 	sub, err := c.filterer.SubscribeFilterLogs(ensureContext(opts.Context), config, logs)
 	*/
-	buff, err := c.filterer.FilterLogs(ensureContext(opts.Context), config)
+	buff, err := c.filterer.FilterLogs(ctx, config)
 	if err != nil {
 		return nil, nil, err
 	}
+	span.SetAttributes(tracing.Int64(tracing.AttrLogs, int64(len(buff))))
 	sub, err := event.NewSubscription(func(quit <-chan struct{}) error {
 		for _, log := range buff {
 			select {
@@ -478,7 +520,9 @@ func (c *BoundContract) WatchLogs(opts *WatchOpts, name string, query ...[]inter
 	logs := make(chan types.Log, 128)
 
 	var addresses []common.Address
-	if c.fuzzyAddress {
+	if len(opts.Addresses) != 0 {
+		addresses = opts.Addresses
+	} else if c.fuzzyAddress {
 		addresses = []common.Address{}
 	} else {
 		addresses = []common.Address{c.address}
@@ -0,0 +1,111 @@
+package bind_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/tracing"
+)
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]string
+	ended bool
+	err   error
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...tracing.Attribute) {
+	for _, attr := range attrs {
+		s.attrs[attr.Key] = attr.Value
+	}
+}
+
+func (s *recordedSpan) End(err error) {
+	s.ended, s.err = true, err
+}
+
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (r *recordingTracer) Start(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	span := &recordedSpan{name: name, attrs: make(map[string]string)}
+	span.SetAttributes(attrs...)
+	r.spans = append(r.spans, span)
+	return ctx, span
+}
+
+func (r *recordingTracer) find(name string) *recordedSpan {
+	for _, span := range r.spans {
+		if span.name == name {
+			return span
+		}
+	}
+	return nil
+}
+
+func TestBoundContract_Tracing(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{auth.From: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)}}, 30000000)
+	defer backend.Close()
+
+	tracer := &recordingTracer{}
+	ctx := tracing.ContextWithTracer(context.Background(), tracer, tracing.Int64(tracing.AttrChainId, 1337))
+	auth.Context = ctx
+
+	address, tx, instance, err := erc721.DeployStandardERC721(auth, backend, "Standard", "STD")
+	if err != nil {
+		t.Fatalf("DeployStandardERC721 err:%+v\n", err)
+	}
+	backend.Commit()
+
+	transact := tracer.find("bind.transact")
+	if transact == nil || !transact.ended || transact.err != nil {
+		t.Fatalf("expect an ended bind.transact span, got %+v", transact)
+	}
+	if transact.attrs[tracing.AttrTxHash] != tx.Hash().Hex() || transact.attrs[tracing.AttrMethod] != "constructor" {
+		t.Errorf("unexpected transact attributes %v", transact.attrs)
+	}
+
+	if _, err = instance.Name(&bind.CallOpts{Context: ctx}); err != nil {
+		t.Fatal(err)
+	}
+	call := tracer.find("bind.Call")
+	if call == nil || !call.ended {
+		t.Fatalf("expect an ended bind.Call span, got %+v", call)
+	}
+	if call.attrs[tracing.AttrChainId] != "1337" || call.attrs[tracing.AttrMethod] != "name" || call.attrs[tracing.AttrContract] != address.Hex() {
+		t.Errorf("unexpected call attributes %v", call.attrs)
+	}
+
+	// the call reverts, the span ends with the error
+	if _, err = instance.OwnerOf(&bind.CallOpts{Context: ctx}, big.NewInt(1)); err == nil {
+		t.Fatal("ownerOf of a missing token should fail")
+	}
+	if last := tracer.spans[len(tracer.spans)-1]; last.err == nil {
+		t.Errorf("the failed call span should record the error")
+	}
+
+	iter, err := instance.FilterTransfer(&bind.FilterOpts{Context: ctx}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = iter.Close()
+	if filter := tracer.find("bind.FilterLogs"); filter == nil || filter.attrs[tracing.AttrEvent] != "Transfer" {
+		t.Errorf("expect a bind.FilterLogs span of Transfer, got %+v", filter)
+	}
+}
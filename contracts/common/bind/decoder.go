@@ -0,0 +1,107 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DecodedEvent is a contract event decoded without a generated binding.
+type DecodedEvent struct {
+	Name string                 // Name of the event in the contract ABI
+	Args map[string]interface{} // Indexed and non-indexed arguments by name
+	Raw  types.Log              // Blockchain specific contextual infos
+}
+
+// EventDecoder filters and decodes any event declared in a contract ABI, so that
+// events without a hand written wrapper can be consumed as a name plus arguments.
+type EventDecoder struct {
+	abi      abi.ABI
+	contract *BoundContract
+}
+
+// NewEventDecoder creates an event decoder driven by the ABI of the metadata.
+func NewEventDecoder(address common.Address, fuzzyAddress bool, metadata *MetaData, filterer ContractFilterer) (*EventDecoder, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	if parsed == nil {
+		return nil, errors.New("GetABI returned nil")
+	}
+	return &EventDecoder{
+		abi:      *parsed,
+		contract: NewBoundContract(address, fuzzyAddress, *parsed, nil, nil, filterer),
+	}, nil
+}
+
+// HasEvent reports whether the ABI declares the named event.
+func (d *EventDecoder) HasEvent(name string) bool {
+	_, ok := d.abi.Events[name]
+	return ok
+}
+
+// FilterEvent retrieves and decodes the named event, the query restricts the
+// indexed arguments in declaration order the same way as the generated filterers.
+func (d *EventDecoder) FilterEvent(opts *FilterOpts, name string, query ...[]interface{}) ([]*DecodedEvent, error) {
+	if !d.HasEvent(name) {
+		return nil, fmt.Errorf("event %s not found in abi", name)
+	}
+	logs, sub, err := d.contract.FilterLogs(opts, name, query...)
+	if err != nil {
+		return nil, err
+	}
+	defer sub.Unsubscribe()
+
+	var events []*DecodedEvent
+	for {
+		select {
+		case log := <-logs:
+			event, err := d.decode(name, log)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, event)
+		case err := <-sub.Err():
+			if err != nil {
+				return nil, err
+			}
+			// The subscription is done once the buffered logs are delivered, drain the rest
+			for {
+				select {
+				case log := <-logs:
+					event, err := d.decode(name, log)
+					if err != nil {
+						return nil, err
+					}
+					events = append(events, event)
+				default:
+					return events, nil
+				}
+			}
+		}
+	}
+}
+
+// Decode decodes a log of any event declared in the ABI, looked up by its topic.
+func (d *EventDecoder) Decode(log types.Log) (*DecodedEvent, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.New("anonymous events are not supported")
+	}
+	event, err := d.abi.EventByID(log.Topics[0])
+	if err != nil {
+		return nil, err
+	}
+	return d.decode(event.Name, log)
+}
+
+func (d *EventDecoder) decode(name string, log types.Log) (*DecodedEvent, error) {
+	args := make(map[string]interface{})
+	if err := d.contract.UnpackLogIntoMap(args, name, log); err != nil {
+		return nil, err
+	}
+	return &DecodedEvent{Name: name, Args: args, Raw: log}, nil
+}
@@ -0,0 +1,48 @@
+package bind_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+)
+
+func TestEventDecoder_Decode(t *testing.T) {
+	decoder, err := bind.NewEventDecoder(common.Address{}, true, erc721.StandardERC721MetaData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !decoder.HasEvent("OwnershipTransferred") || decoder.HasEvent("Unknown") {
+		t.Fatal("unexpected HasEvent result")
+	}
+
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	previousOwner := common.HexToAddress("0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F")
+	newOwner := common.HexToAddress("0x604e91519c3F515D93050AE3B909d9AD037085b5")
+	log := types.Log{
+		Address: common.HexToAddress("0x0bB31BA49d2b9604Ea1640DE4d70D861920AcDe9"),
+		Topics: []common.Hash{
+			parsed.Events["OwnershipTransferred"].ID,
+			common.BytesToHash(previousOwner.Bytes()),
+			common.BytesToHash(newOwner.Bytes()),
+		},
+	}
+
+	event, err := decoder.Decode(log)
+	if err != nil {
+		t.Fatalf("Decode err:%+v\n", err)
+	}
+	if event.Name != "OwnershipTransferred" {
+		t.Errorf("unexpected event name %s", event.Name)
+	}
+	if event.Args["previousOwner"] != previousOwner || event.Args["newOwner"] != newOwner {
+		t.Errorf("unexpected args %+v", event.Args)
+	}
+
+	log.Topics[0] = common.Hash{}
+	if _, err = decoder.Decode(log); err == nil {
+		t.Error("unknown topic should not be decoded")
+	}
+}
@@ -72,6 +72,65 @@ type tmplStruct struct {
 	Fields []*tmplField // Struct fields definition depends on the binding language.
 }
 
+// tmplWrapper is the data structure required to fill the wrapper templates.
+type tmplWrapper struct {
+	Package       string               // Name of the wrapper package, also the name the binding is imported as
+	Type          string               // Type name of the wrapped contract binding
+	BindingImport string               // Import path of the contract binding
+	ModelImport   string               // Import path of the wrapper model package
+	Calls         []*tmplWrapperMethod // Read* methods, sorted by name
+	Transacts     []*tmplWrapperMethod // Write* methods, sorted by name
+	Events        []*tmplWrapperEvent  // Filtered events, sorted by name
+	Topics        []*tmplWrapperTopic  // Indexed event arguments the events can be filtered by
+	Skipped       []string             // Methods and events with types the wrapper can not convert
+	Hexutil       bool                 // Whether some conversion formats fixed bytes with hexutil
+	Payable       bool                 // Whether some Write* inputs carry a payable value
+}
+
+// tmplWrapperMethod is a Read* or Write* method of the wrapper with its conversions prepared.
+type tmplWrapperMethod struct {
+	Name    string            // Normalized name of the binding method
+	RawName string            // Solidity name reported to the metrics
+	Sig     string            // Solidity signature for the doc comments
+	Payable bool              // Whether the inputs carry a payable value
+	Param   *tmplWrapperArg   // The only input of a Read* method, passed as a plain parameter
+	Inputs  []*tmplWrapperArg // Inputs converted into the binding arguments
+	Outputs []*tmplWrapperArg // Outputs converted from the binding results
+	Parse   string            // Statements converting all the inputs
+	Result  string            // Model type returned by a Read* method
+	Zero    string            // Zero value of Result returned on errors
+	Returns string            // Left hand side of the binding call
+}
+
+// tmplWrapperEvent is a typed event filter of the wrapper.
+type tmplWrapperEvent struct {
+	Name    string            // Normalized name of the binding event
+	RawName string            // Solidity name listed in SupportEvents
+	Sig     string            // Solidity signature for the doc comments
+	Topics  string            // Topic filter arguments, nil for the indexed arguments without a topic filter
+	Fields  []*tmplWrapperArg // Payload fields converted from the binding event
+}
+
+// tmplWrapperTopic is an indexed event argument the events can be filtered by.
+type tmplWrapperTopic struct {
+	Name   string // Field name in model.EventFilter
+	Json   string // Json key of the field
+	Field  string // Field name in eventTopics
+	Type   string // Binding type of the topic values
+	Parse  string // utils function parsing the model values
+	Events string // Events with the indexed argument, for the doc comment
+}
+
+// tmplWrapperArg is a model field or parameter and its conversion from or into the binding type.
+type tmplWrapperArg struct {
+	Name  string // Exported field name in the model
+	Json  string // Json key of the field
+	Type  string // Model type, addresses, big integers and fixed bytes are strings
+	Var   string // Parameter name when the argument is passed as a plain parameter
+	Parse string // Statements converting the model value, empty when it is passed as is
+	Arg   string // Binding argument of an input, or formatted model value of an output
+}
+
 // tmplSource is language to template mapping containing all the supported
 // programming languages the package can generate to.
 var tmplSource = map[Lang]string{
@@ -94,10 +153,10 @@ import (
 
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -232,7 +291,7 @@ var (
 
 	// New{{.Type}} creates a new instance of {{.Type}}, bound to a specific deployed contract.
 	func New{{.Type}}(address common.Address, backend bind.ContractBackend) (*{{.Type}}, error) {
-	  contract, err := bind{{.Type}}(address, backend, backend, backend)
+	  contract, err := bind{{.Type}}(address, false, backend, backend, backend)
 	  if err != nil {
 	    return nil, err
 	  }
@@ -241,7 +300,7 @@ var (
 
 	// New{{.Type}}Caller creates a new read-only instance of {{.Type}}, bound to a specific deployed contract.
 	func New{{.Type}}Caller(address common.Address, caller bind.ContractCaller) (*{{.Type}}Caller, error) {
-	  contract, err := bind{{.Type}}(address, caller, nil, nil)
+	  contract, err := bind{{.Type}}(address, false, caller, nil, nil)
 	  if err != nil {
 	    return nil, err
 	  }
@@ -250,7 +309,7 @@ var (
 
 	// New{{.Type}}Transactor creates a new write-only instance of {{.Type}}, bound to a specific deployed contract.
 	func New{{.Type}}Transactor(address common.Address, transactor bind.ContractTransactor) (*{{.Type}}Transactor, error) {
-	  contract, err := bind{{.Type}}(address, nil, transactor, nil)
+	  contract, err := bind{{.Type}}(address, false, nil, transactor, nil)
 	  if err != nil {
 	    return nil, err
 	  }
@@ -258,8 +317,8 @@ var (
 	}
 
 	// New{{.Type}}Filterer creates a new log filterer instance of {{.Type}}, bound to a specific deployed contract.
- 	func New{{.Type}}Filterer(address common.Address, filterer bind.ContractFilterer) (*{{.Type}}Filterer, error) {
- 	  contract, err := bind{{.Type}}(address, nil, nil, filterer)
+ 	func New{{.Type}}Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*{{.Type}}Filterer, error) {
+ 	  contract, err := bind{{.Type}}(address, fuzzyAddress, nil, nil, filterer)
  	  if err != nil {
  	    return nil, err
  	  }
@@ -267,12 +326,12 @@ var (
  	}
 
 	// bind{{.Type}} binds a generic wrapper to an already deployed contract.
-	func bind{{.Type}}(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	func bind{{.Type}}(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	  parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
 	  if err != nil {
 	    return nil, err
 	  }
-	  return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+	  return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 	}
 
 	// Call invokes the (constant) contract method with params as input values and
@@ -706,3 +765,775 @@ import java.util.*;
 }
 {{end}}
 `
+
+// tmplWrapperGo is the Go source template of the wrapper layer on top of a binding,
+// it follows contracts/erc721/erc721.go.
+const tmplWrapperGo = `
+// Code generated - DO NOT EDIT.
+// This file is a generated wrapper and any manual changes will be lost.
+
+package {{.Package}}
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	{{- if .Hexutil}}
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	{{- end}}
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	"github.com/jason-bateman/go-erc-standard-contract/logger"
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
+	"github.com/jason-bateman/go-erc-standard-contract/tracing"
+	"github.com/jason-bateman/go-erc-standard-contract/utils"
+	{{.Package}} "{{.BindingImport}}"
+	model "{{.ModelImport}}"
+)
+{{if .Skipped}}
+// The wrapper leaves out the methods and events it can not convert, use the binding for:
+{{- range .Skipped}}
+//	{{.}}
+{{- end}}
+{{end}}
+type contractTransactor struct {
+	client     *backend.Backend // client
+	key        *ecdsa.PrivateKey
+	transactor *{{.Package}}.{{.Type}}Transactor // transactor
+}
+
+type contractCaller struct {
+	client *backend.Backend // client
+	caller *{{.Package}}.{{.Type}}Caller // caller
+}
+
+type contractFilterer struct {
+	client             *backend.Backend // client
+	stepNum            uint64 // step num default is 100 block
+	filterFuzzyAddress bool // fuzzy bind contract address(listen for the full number of matching topic events)
+	filterAddresses    []common.Address // contract addresses matched in one query, overrides the bound contract address
+	messageJSON        bool // fill EthereumEventMessage.Message with the json encoded payload
+	events             []model.ContractEvent // events
+	topics             eventTopics // indexed topic filter
+	decoder            *bind.EventDecoder // abi driven decoder of the events without a typed wrapper
+	abiEvents          []string // abi events decoded by the decoder
+	filterer           *{{.Package}}.{{.Type}}Filterer // Filterer
+}
+
+type eventTopics struct {
+	{{- range .Topics}}
+	{{.Field}} []{{.Type}}
+	{{- end}}
+}
+
+type ContractOpts struct {
+	Rpc                string // rpc
+	Rpcs               []string // rpc endpoints in priority order with failover, Rpc is used when empty
+	Backend            *backend.Backend // backend shared by several contracts, overrides Rpc and Rpcs, not closed by ReleaseResource
+	RpcLimiter         *backend.LimiterOpts // client side rate limit and concurrency cap of every rpc endpoint, nil disables it
+	ContractAddr       string // contract address
+	EnableTransactors  bool // enable transactors
+	EnableFilter       bool // enable filter
+	FilterStep         uint64 // the step size of the block interval obtained each time
+	FilterFuzzyAddress bool // fuzzy bind contract address(listen for the full number of matching topic events)
+	FilterAddresses    []string // listen for the events of a set of contract addresses in one query
+	FilterMessageJSON  bool // also fill EthereumEventMessage.Message with the json encoded payload
+	FilterABI          string // abi json of a derived contract to decode custom events, default is the standard abi
+	Metrics            metrics.Metrics // rpc and transaction metrics, default is no-op
+	Logger             logger.Logger // structured logs of the event filters, default is silent
+	Tracer             tracing.Tracer // spans of the transaction options, contract calls, sends and log filters, default is no-op
+	Multicall          string // Multicall3 contract address of the batch reader, empty falls back to json rpc batch requests
+}
+
+type Contract struct {
+	backend           *backend.Backend // backend
+	ownBackend        bool // the backend is created by the contract and closed by ReleaseResource
+	chainId           int64 // chain id
+	contractAddr      common.Address // contract address
+	enableTransactors bool // enable transactors
+	enableFilter      bool // enable filter
+	transactors       map[string]*contractTransactor // transactors
+	caller            *contractCaller // caller
+	filter            *contractFilterer // filter
+	multicall         *multicall.Multicall // batch reader
+	metrics           metrics.Metrics // metrics
+	tracer            tracing.Tracer // tracer
+	logger            logger.Logger // logger
+}
+
+func NewContract(ops *ContractOpts) (*Contract, error) {
+	var caller contractCaller
+	var err error
+
+	con := &Contract{}
+
+	if !common.IsHexAddress(ops.ContractAddr) {
+		return nil, errors.New("invalid address")
+	}
+
+	if ops.EnableFilter && ops.FilterFuzzyAddress && len(ops.FilterAddresses) != 0 {
+		return nil, errors.New("fuzzy address and address set filter can not be enabled together")
+	}
+
+	// 合约地址格式转换
+	contractAddr := common.HexToAddress(ops.ContractAddr)
+
+	// backend 初始化, 配置多个rpc时自动故障切换
+	if ops.Backend != nil {
+		con.backend = ops.Backend
+	} else {
+		rpcs := ops.Rpcs
+		if len(rpcs) == 0 {
+			rpcs = []string{ops.Rpc}
+		}
+		con.backend, err = backend.NewBackend(&backend.BackendOpts{Rpcs: rpcs, Limiter: ops.RpcLimiter, Metrics: ops.Metrics})
+		if err != nil {
+			return nil, err
+		}
+		con.ownBackend = true
+		defer func() {
+			if err != nil {
+				con.backend.Close()
+			}
+		}()
+	}
+
+	// caller 初始化
+	caller.client = con.backend
+
+	chainId, err := caller.client.ChainID(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	caller.caller, err = {{.Package}}.New{{.Type}}Caller(contractAddr, caller.client)
+	if err != nil {
+		return nil, err
+	}
+
+	// 填充返回
+	con.chainId = chainId.Int64()
+	con.contractAddr = contractAddr
+	con.enableTransactors = ops.EnableTransactors
+	con.metrics = metrics.OrNop(ops.Metrics)
+	con.tracer = ops.Tracer
+	con.logger = logger.OrNop(ops.Logger)
+	con.enableFilter = ops.EnableFilter
+	con.caller = &caller
+
+	// 批量读取, 未配置Multicall3合约时使用json rpc batch
+	con.multicall, err = multicall.NewMulticall(con.backend, con.backend, &multicall.MulticallOpts{Address: ops.Multicall})
+	if err != nil {
+		return nil, err
+	}
+
+	if ops.EnableFilter {
+		var filter contractFilterer
+
+		filter.filterAddresses, err = utils.HexToAddresses(ops.FilterAddresses)
+		if err != nil {
+			return nil, err
+		}
+
+		// filter初始化
+		filter.client = con.backend
+
+		filter.filterer, err = {{.Package}}.New{{.Type}}Filterer(contractAddr, ops.FilterFuzzyAddress, filter.client)
+		if err != nil {
+			return nil, err
+		}
+		if ops.FilterStep == 0 {
+			filter.stepNum = chainModel.EVENT_FILTER_STEP_NUM
+		} else {
+			filter.stepNum = ops.FilterStep
+		}
+		metadata := {{.Package}}.{{.Type}}MetaData
+		if ops.FilterABI != "" {
+			metadata = &bind.MetaData{ABI: ops.FilterABI}
+		}
+		filter.decoder, err = bind.NewEventDecoder(contractAddr, ops.FilterFuzzyAddress, metadata, filter.client)
+		if err != nil {
+			return nil, err
+		}
+		filter.filterFuzzyAddress = ops.FilterFuzzyAddress
+		filter.messageJSON = ops.FilterMessageJSON
+		con.filter = &filter
+	}
+
+	if ops.EnableTransactors {
+		con.transactors = make(map[string]*contractTransactor)
+	}
+
+	return con, nil
+}
+
+func (c *Contract) AddTransactors(privateKeys []string) error {
+	var err error
+	if !c.enableTransactors {
+		return errors.New("the transactors is not supported. check the instantiation parameters")
+	}
+	transactors := make(map[string]*contractTransactor)
+
+	for _, k := range privateKeys {
+		var transactor contractTransactor
+
+		transactor.client = c.backend
+
+		transactor.key, err = crypto.HexToECDSA(k)
+		if err != nil {
+			return err
+		}
+
+		transactor.transactor, err = {{.Package}}.New{{.Type}}Transactor(c.contractAddr, transactor.client)
+		if err != nil {
+			return err
+		}
+
+		transactors[crypto.PubkeyToAddress(transactor.key.PublicKey).Hex()] = &transactor
+	}
+
+	c.transactors = transactors
+
+	return nil
+}
+
+func (c *Contract) AddEvents(events []model.ContractEvent) error {
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	for _, e := range events {
+		if c.filter.isEventSupport(e) {
+			c.filter.events = append(c.filter.events, e)
+		} else {
+			errMsg := fmt.Sprintf("unsupported Event:%s", model.SupportEvents[e])
+			return errors.New(errMsg)
+		}
+	}
+
+	return nil
+}
+
+func (c *Contract) AddABIEvents(names []string) error {
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	for _, name := range names {
+		if !c.filter.decoder.HasEvent(name) {
+			return fmt.Errorf("unsupported Event:%s, not found in abi", name)
+		}
+		c.filter.abiEvents = append(c.filter.abiEvents, name)
+	}
+
+	return nil
+}
+
+func (c *Contract) SetEventFilter(filter *model.EventFilter) error {
+	var topics eventTopics
+	{{- if .Topics}}
+	var err error
+	{{- end}}
+
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+	{{if .Topics}}
+	if filter != nil {
+		{{- range .Topics}}
+		if topics.{{.Field}}, err = utils.{{.Parse}}(filter.{{.Name}}); err != nil {
+			return err
+		}
+		{{- end}}
+	}
+	{{end}}
+	c.filter.topics = topics
+
+	return nil
+}
+
+// GetCallerClient returns the client of the preferred rpc endpoint, use GetBackend for requests with failover
+func (c *Contract) GetCallerClient() *ethclient.Client {
+	return c.backend.Client()
+}
+
+func (c *Contract) GetBackend() *backend.Backend {
+	return c.backend
+}
+{{range .Calls}}
+// Read{{.Name}} calls {{.Sig}}
+func (c *Contract) Read{{.Name}}(ctx context.Context, {{if .Param}}{{.Param.Var}} {{.Param.Type}}, {{else if .Inputs}}inputs *model.MethodRead{{.Name}}Inputs, {{end}}blockTag ...chainModel.BlockTag) ({{.Result}}, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return {{.Zero}}, err
+	}
+	{{- if .Parse}}
+
+	// 参数处理
+	{{.Parse}}
+	{{- end}}
+
+	{{.Returns}}, err := c.caller.caller.{{.Name}}(opts{{range .Inputs}}, {{.Arg}}{{end}})
+	if err != nil {
+		return {{.Zero}}, err
+	}
+
+	{{if eq (len .Outputs) 1 -}}
+	return {{(index .Outputs 0).Arg}}, nil
+	{{- else -}}
+	return &model.MethodRead{{.Name}}Outputs{
+		{{- range .Outputs}}
+		{{.Name}}: {{.Arg}},
+		{{- end}}
+	}, nil
+	{{- end}}
+}
+{{end}}
+{{- range .Transacts}}
+// Write{{.Name}} sends {{.Sig}}
+func (c *Contract) Write{{.Name}}(ctx context.Context, senderAddress string, txNonce uint64{{if or .Inputs .Payable}}, inputs *model.MethodWrite{{.Name}}Inputs{{end}}) (string, error) {
+	if !c.enableTransactors {
+		return "", errors.New("the transactors is not supported. check the instantiation parameters")
+	}
+	if !c.isTransactorExist(senderAddress) {
+		return "", errors.New("transactor not exist")
+	}
+
+	// 获取Transactor参数
+	{{- if .Payable}}
+	payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, 0)
+	if err != nil {
+		return "", err
+	}
+	opts, err := c.genTransactorOptions(ctx, senderAddress, txNonce, payableValue)
+	{{- else}}
+	opts, err := c.genTransactorOptions(ctx, senderAddress, txNonce, nil)
+	{{- end}}
+	if err != nil {
+		return "", err
+	}
+	{{- if .Parse}}
+
+	// 参数处理
+	{{.Parse}}
+	{{- end}}
+
+	// 提交交易
+	tx, err := c.transactors[senderAddress].transactor.{{.Name}}(opts{{range .Inputs}}, {{.Arg}}{{end}})
+	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
+		return "", err
+	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "{{.RawName}}")
+
+	return tx.Hash().String(), nil
+}
+{{end}}
+func (c *Contract) FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
+	var events, eventsAll []*chainModel.EthereumEventMessage
+
+	if !c.enableFilter {
+		return nil, errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	latestBlockNum, err := c.backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// 如果请求结束区块大于最新区块，赋值最新区块
+	if latestBlockNum < *stopBlockNum {
+		*stopBlockNum = latestBlockNum
+	}
+	// 起始区块超过最新区块时不发送from > to的查询, 节点会拒绝
+	if startBlockNum > *stopBlockNum {
+		return nil, nil
+	}
+	if *stopBlockNum > startBlockNum+c.filter.stepNum {
+		errMsg := fmt.Sprintf("Max Filter step num is %d, current is %d", c.filter.stepNum, *stopBlockNum-startBlockNum)
+		return nil, errors.New(errMsg)
+	}
+
+	stop := stopBlockNum
+
+	opts := &bind.FilterOpts{
+		Context:   c.traceContext(ctx),
+		Start:     startBlockNum,
+		End:       stop,
+		Addresses: c.filter.filterAddresses,
+	}
+	for _, e := range c.filter.events {
+
+		switch e {
+		{{- range .Events}}
+		case model.Event{{.Name}}:
+			events, err = c.event{{.Name}}(opts)
+			if err != nil {
+				return nil, err
+			}
+		{{end}}
+		default:
+			errMsg := fmt.Sprintf("unsupported Event:%s", model.SupportEvents[e])
+			return nil, errors.New(errMsg)
+		}
+
+		if len(events) != 0 {
+			c.logger.Debug("filter events", logger.F("chain_id", c.chainId), logger.F("event", model.SupportEvents[e]), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
+			eventsAll = utils.MergeEventMessage(eventsAll, events)
+		}
+	}
+	for _, name := range c.filter.abiEvents {
+		events, err = c.eventGeneric(opts, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) != 0 {
+			c.logger.Debug("filter events", logger.F("chain_id", c.chainId), logger.F("event", name), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
+			eventsAll = utils.MergeEventMessage(eventsAll, events)
+		}
+	}
+	if len(eventsAll) != 0 {
+		c.logger.Info("filter events done", logger.F("chain_id", c.chainId), logger.F("count", len(eventsAll)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
+	}
+	return eventsAll, nil
+}
+
+// WaitTransaction waits until the transaction is mined and records it as confirmed or failed by its receipt status
+func (c *Contract) WaitTransaction(ctx context.Context, txId string) (*types.Receipt, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := c.backend.TransactionReceipt(ctx, common.HexToHash(txId))
+		if err == nil {
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				c.metrics.TxConfirmed(c.contractAddr.Hex())
+			} else {
+				c.metrics.TxFailed(c.contractAddr.Hex())
+			}
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Contract) ReleaseResource() {
+
+	//释放backend, 共享的backend由创建者释放
+	if c.ownBackend {
+		c.backend.Close()
+	}
+
+	//释放filter
+	if c.enableFilter {
+		c.filter.events = nil
+	}
+
+	// 释放transactors
+	if c.enableTransactors {
+		for _, v := range c.transactors {
+			v.key = nil
+		}
+		c.transactors = make(map[string]*contractTransactor)
+	}
+}
+
+func (c *Contract) genTransactorOptions(ctx context.Context, providerAddress string, txNonce uint64, payableValue *big.Int) (_ *bind.TransactOpts, err error) {
+	ctx = c.traceContext(ctx)
+	spanCtx, span := tracing.Start(ctx, "genTransactorOptions", tracing.String(tracing.AttrFrom, providerAddress))
+	defer func() { span.End(err) }()
+
+	// 填充TransactOpts结构
+	opts, err := bind.NewKeyedTransactorWithChainID(c.transactors[providerAddress].key, big.NewInt(c.chainId))
+	if err != nil {
+		return nil, err
+	}
+
+	// 自定义nonce配置
+	if txNonce > 0 {
+		opts.Nonce = big.NewInt(int64(txNonce))
+	}
+
+	// 支付Native货币数量
+	if payableValue != nil && payableValue.Sign() > 0 {
+		opts.Value = payableValue
+	}
+
+	//自定义gas limit
+	opts.GasLimit = chainModel.TRANSCATION_MAX_GAS_LIMINT
+
+	// 获取网络手续费, ctx未设置deadline时默认3秒超时
+	gasCtx, cancel := spanCtx, context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok {
+		gasCtx, cancel = context.WithTimeout(spanCtx, time.Duration(3)*time.Second)
+	}
+	defer cancel()
+	gasPrice, err := c.transactors[providerAddress].client.SuggestGasPrice(gasCtx)
+	if err != nil {
+		return nil, err
+	}
+	opts.GasPrice = gasPrice
+	span.SetAttributes(tracing.String(tracing.AttrGasPrice, gasPrice.String()))
+	// bind的Call/transact/FilterLogs从opts.Context取tracer
+	opts.Context = ctx
+
+	return opts, nil
+}
+{{range .Events}}
+func (c *Contract) event{{.Name}}(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
+	var events []*chainModel.EthereumEventMessage
+
+	iter, err := c.filter.filterer.Filter{{.Name}}(opts{{.Topics}})
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = iter.Close()
+	}()
+
+	for {
+		if iter.Next() {
+			message := &model.Event4{{.Name}}{
+				{{- range .Fields}}
+				{{.Name}}: {{.Arg}},
+				{{- end}}
+			}
+			event, err := c.eventMsgCommonFill(iter.Event.Raw, message)
+			if err != nil {
+				return nil, err
+			}
+			c.logEvent(event)
+			events = append(events, event)
+		} else {
+			break
+		}
+	}
+
+	return events, nil
+}
+{{end}}
+func (c *Contract) eventGeneric(opts *bind.FilterOpts, name string) ([]*chainModel.EthereumEventMessage, error) {
+	var events []*chainModel.EthereumEventMessage
+
+	decoded, err := c.filter.decoder.FilterEvent(opts, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range decoded {
+		message := &chainModel.Event4Generic{
+			Name: d.Name,
+			Args: utils.AbiArgs2JSON(d.Args),
+		}
+		event, err := c.eventMsgCommonFill(d.Raw, message)
+		if err != nil {
+			return nil, err
+		}
+		c.logEvent(event)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// logEvent 以debug级别记录过滤到的事件
+func (c *Contract) logEvent(event *chainModel.EthereumEventMessage) {
+	c.logger.Debug("new event", logger.F("chain_id", event.ChainId), logger.F("event", event.Event), logger.F("contract", event.Contract),
+		logger.F("block", event.BlockNumber), logger.F("tx", event.TxId), logger.F("index", event.BlockIndex))
+}
+
+func (c *Contract) eventMsgCommonFill(logs types.Log, payload chainModel.EventPayload) (*chainModel.EthereumEventMessage, error) {
+	commonMsg := &chainModel.EthereumEventMessage{
+		ChainId:     c.chainId,
+		Contract:    logs.Address.Hex(),
+		BlockNumber: logs.BlockNumber,
+		TxId:        logs.TxHash.String(),
+		BlockIndex:  uint64(logs.Index),
+		Event:       payload.EventName(),
+		Standard:    model.Standard,
+		Payload:     payload,
+	}
+
+	// json格式化消息内容(按需开启)
+	if c.filter.messageJSON {
+		messageBytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		commonMsg.Message = string(messageBytes)
+	}
+
+	return commonMsg, nil
+}
+
+// traceContext 为ctx附加tracer及链id和合约地址属性
+func (c *Contract) traceContext(ctx context.Context) context.Context {
+	return tracing.ContextWithTracer(ctx, c.tracer,
+		tracing.Int64(tracing.AttrChainId, c.chainId), tracing.String(tracing.AttrContract, c.contractAddr.Hex()))
+}
+
+// callOpts converts the optional block tag of a Read* method into the call options, the latest state is read without tag
+func (c *Contract) callOpts(ctx context.Context, blockTag []chainModel.BlockTag) (*bind.CallOpts, error) {
+	opts := &bind.CallOpts{Context: c.traceContext(ctx)}
+	if len(blockTag) == 0 {
+		return opts, nil
+	}
+	if len(blockTag) > 1 {
+		return nil, errors.New("invalid parameter, only one block tag is allowed")
+	}
+
+	switch tag := blockTag[0]; tag {
+	case "", chainModel.BlockLatest:
+	case chainModel.BlockPending:
+		opts.Pending = true
+	case chainModel.BlockSafe, chainModel.BlockFinalized:
+		// ethclient不支持safe/finalized, 先查询对应的区块高度
+		number, err := utils.GetBlockNumByTagWithClient(ctx, c.backend, string(tag))
+		if err != nil {
+			return nil, err
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(number)
+	default:
+		number, ok := tag.Number()
+		if !ok {
+			return nil, fmt.Errorf("invalid block tag:%s", tag)
+		}
+		opts.BlockNumber = number
+	}
+
+	return opts, nil
+}
+
+func (c *Contract) isTransactorExist(addr string) bool {
+	_, ok := c.transactors[addr]
+	return ok
+}
+
+func (f *contractFilterer) isEventSupport(event model.ContractEvent) bool {
+	_, ok := model.SupportEvents[event]
+	return ok
+}
+`
+
+// tmplWrapperMethodGo is the Go source template of the inputs and outputs of the wrapper methods.
+const tmplWrapperMethodGo = `
+// Code generated - DO NOT EDIT.
+// This file is a generated wrapper and any manual changes will be lost.
+
+package model
+{{if .Payable}}
+import "math/big"
+{{end}}
+{{- range .Calls}}
+{{- if gt (len .Inputs) 1}}
+// MethodRead{{.Name}}Inputs
+//{{.Sig}}
+type MethodRead{{.Name}}Inputs struct {
+	{{- range .Inputs}}
+	{{.Name}} {{.Type}} {{tag .Json}}
+	{{- end}}
+}
+{{end}}
+{{- if gt (len .Outputs) 1}}
+// MethodRead{{.Name}}Outputs
+//{{.Sig}}
+type MethodRead{{.Name}}Outputs struct {
+	{{- range .Outputs}}
+	{{.Name}} {{.Type}} {{tag .Json}}
+	{{- end}}
+}
+{{end}}
+{{- end}}
+{{- range .Transacts}}
+{{- if or .Inputs .Payable}}
+// MethodWrite{{.Name}}Inputs
+//{{.Sig}}
+type MethodWrite{{.Name}}Inputs struct {
+	{{- if .Payable}}
+	PayableEther string {{tag "payable_ether"}} // decimal native currency amount, e.g. "0.1"
+	PayableWei *big.Int {{tag "payable_wei"}}
+	{{- end}}
+	{{- range .Inputs}}
+	{{.Name}} {{.Type}} {{tag .Json}}
+	{{- end}}
+}
+{{end}}
+{{- end}}
+`
+
+// tmplWrapperEventGo is the Go source template of the events supported by the wrapper.
+const tmplWrapperEventGo = `
+// Code generated - DO NOT EDIT.
+// This file is a generated wrapper and any manual changes will be lost.
+
+package model
+{{if .Events}}
+import chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+{{end}}
+// Standard is the standard of the event messages, it selects the payload types when they are decoded from json
+const Standard = "{{.Package}}"
+
+type ContractEvent int
+{{range .Events}}
+//{{.Sig}}
+{{- end}}
+
+const (
+	{{- range $i, $e := .Events}}
+	Event{{.Name}}{{if eq $i 0}} ContractEvent = iota{{end}}
+	{{- end}}
+)
+{{range .Events}}
+// Event4{{.Name}}
+//{{.Sig}}
+type Event4{{.Name}} struct {
+	{{- range .Fields}}
+	{{.Name}} {{.Type}} {{tag .Json}}
+	{{- end}}
+}
+
+func (e *Event4{{.Name}}) EventName() string { return SupportEvents[Event{{.Name}}] }
+{{end}}
+var SupportEvents = map[ContractEvent]string{
+	{{- range .Events}}
+	Event{{.Name}}: "{{.RawName}}",
+	{{- end}}
+}
+
+// EventFilter narrows the filtered events down by their indexed topics, an empty field matches everything
+type EventFilter struct {
+	{{- range .Topics}}
+	{{.Name}} []string {{tag .Json}} // {{.Events}}
+	{{- end}}
+}
+{{- if .Events}}
+
+// 注册类型化的Payload, EthereumEventMessage反序列化时使用
+func init() {
+	{{- range .Events}}
+	chainModel.RegisterEventPayload(Standard, SupportEvents[Event{{.Name}}], func() chainModel.EventPayload { return &Event4{{.Name}}{} })
+	{{- end}}
+}
+{{- end}}
+`
@@ -0,0 +1,453 @@
+package bind
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// WrapperOpts configures the wrapper layer generated on top of a binding
+type WrapperOpts struct {
+	Type          string // Go type name of the binding, e.g. StandardERC721
+	Package       string // package name of the wrapper and of its binding
+	BindingImport string // import path of the binding package, e.g. .../contracts/erc721/contract
+	ModelImport   string // import path of the wrapper model package, e.g. .../contracts/erc721/model
+}
+
+// Wrapper is the generated wrapper layer of a contract, every field is the source of one file
+type Wrapper struct {
+	Contract string   // the wrapper package: Contract, Read*/Write* methods and event filters
+	Method   string   // model/method.go: inputs and outputs of the Read*/Write* methods
+	Event    string   // model/event.go: ContractEvent, SupportEvents and the event payloads
+	Skipped  []string // methods and events left out because the wrapper can not convert their types
+}
+
+// wrapperLocals are the local names of the generated methods a plain parameter must not shadow
+var wrapperLocals = map[string]bool{
+	"c": true, "ctx": true, "opts": true, "err": true, "out": true, "tx": true, "inputs": true, "blockTag": true,
+}
+
+// BindWrapper generates the wrapper layer of a contract from its abi, the same way
+// erc721.go and erc1155.go wrap their bindings: string friendly Read*/Write* methods, a
+// transactor registry keyed by sender address, typed event decoders and the SupportEvents table.
+func BindWrapper(abiJSON string, aliases map[string]string, opts *WrapperOpts) (*Wrapper, error) {
+	if opts == nil || opts.Type == "" || opts.Package == "" {
+		return nil, errors.New("wrapper type and package are required")
+	}
+	if opts.BindingImport == "" || opts.ModelImport == "" {
+		return nil, errors.New("wrapper binding and model import paths are required")
+	}
+	if aliases == nil {
+		aliases = make(map[string]string)
+	}
+
+	parsed, err := parse([]string{opts.Type}, []string{abiJSON}, []string{""}, nil, opts.Package, LangGo, make(map[string]string), aliases)
+	if err != nil {
+		return nil, err
+	}
+	contract := parsed.Contracts[opts.Type]
+
+	data := &tmplWrapper{
+		Package:       opts.Package,
+		Type:          contract.Type,
+		BindingImport: opts.BindingImport,
+		ModelImport:   opts.ModelImport,
+	}
+	for _, name := range sortedKeys(contract.Calls) {
+		method, err := wrapRead(contract.Calls[name])
+		if err != nil {
+			data.Skipped = append(data.Skipped, fmt.Sprintf("%s: %v", contract.Calls[name].Original.Sig, err))
+			continue
+		}
+		data.Calls = append(data.Calls, method)
+	}
+	for _, name := range sortedKeys(contract.Transacts) {
+		method, err := wrapWrite(contract.Transacts[name])
+		if err != nil {
+			data.Skipped = append(data.Skipped, fmt.Sprintf("%s: %v", contract.Transacts[name].Original.Sig, err))
+			continue
+		}
+		data.Payable = data.Payable || method.Payable
+		data.Transacts = append(data.Transacts, method)
+	}
+	for _, name := range sortedEvents(contract.Events) {
+		event, err := wrapEvent(contract.Events[name], data)
+		if err != nil {
+			data.Skipped = append(data.Skipped, fmt.Sprintf("%s: %v", contract.Events[name].Original.Sig, err))
+			continue
+		}
+		data.Events = append(data.Events, event)
+	}
+	data.Hexutil = strings.Contains(wrapperExpressions(data), "hexutil.")
+
+	// 模板为go原始字符串, struct tag由函数生成
+	funcs := map[string]interface{}{
+		"tag": func(key string) string { return "`json:\"" + key + "\"`" },
+	}
+	wrapper := &Wrapper{Skipped: data.Skipped}
+	if wrapper.Contract, err = render(LangGo, tmplWrapperGo, data, funcs); err != nil {
+		return nil, err
+	}
+	if wrapper.Method, err = render(LangGo, tmplWrapperMethodGo, data, funcs); err != nil {
+		return nil, err
+	}
+	if wrapper.Event, err = render(LangGo, tmplWrapperEventGo, data, funcs); err != nil {
+		return nil, err
+	}
+	return wrapper, nil
+}
+
+func sortedKeys(methods map[string]*tmplMethod) []string {
+	keys := make([]string, 0, len(methods))
+	for name := range methods {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedEvents(events map[string]*tmplEvent) []string {
+	keys := make([]string, 0, len(events))
+	for name := range events {
+		keys = append(keys, name)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// wrapRead converts a constant method, a single input is passed as a plain parameter, more go into an inputs struct
+func wrapRead(method *tmplMethod) (*tmplWrapperMethod, error) {
+	if len(method.Normalized.Outputs) == 0 {
+		return nil, errors.New("no outputs to read")
+	}
+	wrapped := &tmplWrapperMethod{Name: method.Normalized.Name, RawName: method.Original.RawName, Sig: method.Original.String()}
+
+	for i, output := range method.Normalized.Outputs {
+		typ, ok := wrapperType(output.Type)
+		if !ok {
+			return nil, fmt.Errorf("unsupported output type %s", output.Type)
+		}
+		name := capitalise(output.Name)
+		if name == "" {
+			name = fmt.Sprintf("Out%d", i)
+		}
+		value := fmt.Sprintf("out%d", i)
+		switch {
+		case len(method.Normalized.Outputs) == 1:
+			value = "out"
+		case method.Structured:
+			value = "out." + capitalise(output.Name)
+		}
+		wrapped.Outputs = append(wrapped.Outputs, &tmplWrapperArg{Name: name, Json: snakeCase(name), Type: typ, Arg: formatValue(output.Type, value)})
+	}
+	// 匿名或重名的返回值按位置命名
+	if err := uniqueFields(wrapped.Outputs); err != nil {
+		for i, output := range wrapped.Outputs {
+			output.Name = fmt.Sprintf("Out%d", i)
+			output.Json = snakeCase(output.Name)
+		}
+	}
+	switch {
+	case len(wrapped.Outputs) == 1:
+		wrapped.Result, wrapped.Zero = wrapped.Outputs[0].Type, zeroValue(wrapped.Outputs[0].Type)
+		wrapped.Returns = "out"
+	case method.Structured:
+		wrapped.Result, wrapped.Zero = "*model.MethodRead"+wrapped.Name+"Outputs", "nil"
+		wrapped.Returns = "out"
+	default:
+		wrapped.Result, wrapped.Zero = "*model.MethodRead"+wrapped.Name+"Outputs", "nil"
+		returns := make([]string, len(wrapped.Outputs))
+		for i := range returns {
+			returns[i] = fmt.Sprintf("out%d", i)
+		}
+		wrapped.Returns = strings.Join(returns, ", ")
+	}
+
+	inputs := method.Normalized.Inputs
+	for _, input := range inputs {
+		name := capitalise(input.Name)
+		src := "inputs." + name
+		if len(inputs) == 1 {
+			src = paramName(input.Name)
+		}
+		arg, err := wrapInput(input, name, src, wrapped.Zero)
+		if err != nil {
+			return nil, err
+		}
+		wrapped.Inputs = append(wrapped.Inputs, arg)
+		wrapped.Parse += arg.Parse
+	}
+	if len(wrapped.Inputs) == 1 {
+		wrapped.Param = wrapped.Inputs[0]
+		wrapped.Param.Var = paramName(inputs[0].Name)
+	}
+	if err := uniqueFields(wrapped.Inputs); err != nil {
+		return nil, err
+	}
+	return wrapped, nil
+}
+
+// wrapWrite converts a non constant method, the inputs always go into an inputs struct next to the payable value
+func wrapWrite(method *tmplMethod) (*tmplWrapperMethod, error) {
+	wrapped := &tmplWrapperMethod{
+		Name:    method.Normalized.Name,
+		RawName: method.Original.RawName,
+		Sig:     method.Original.String(),
+		Payable: method.Original.Payable || method.Original.StateMutability == "payable",
+		Zero:    `""`,
+	}
+	for _, input := range method.Normalized.Inputs {
+		name := capitalise(input.Name)
+		arg, err := wrapInput(input, name, "inputs."+name, wrapped.Zero)
+		if err != nil {
+			return nil, err
+		}
+		wrapped.Inputs = append(wrapped.Inputs, arg)
+		wrapped.Parse += arg.Parse
+	}
+	fields := wrapped.Inputs
+	if wrapped.Payable {
+		fields = append([]*tmplWrapperArg{{Name: "PayableEther"}, {Name: "PayableWei"}}, fields...)
+	}
+	if err := uniqueFields(fields); err != nil {
+		return nil, err
+	}
+	return wrapped, nil
+}
+
+// wrapEvent converts an event, indexed strings and bytes are only available as their keccak256 hash
+func wrapEvent(event *tmplEvent, data *tmplWrapper) (*tmplWrapperEvent, error) {
+	wrapped := &tmplWrapperEvent{Name: event.Normalized.Name, RawName: event.Original.RawName, Sig: event.Original.String()}
+	for _, input := range event.Normalized.Inputs {
+		name := capitalise(input.Name)
+		value := "iter.Event." + name
+		field := &tmplWrapperArg{Name: name, Json: snakeCase(name)}
+		switch {
+		case input.Indexed && (input.Type.T == abi.StringTy || input.Type.T == abi.BytesTy):
+			field.Type, field.Arg = "string", value+".Hex()"
+		case input.Indexed && (input.Type.T == abi.SliceTy || input.Type.T == abi.ArrayTy || input.Type.T == abi.TupleTy):
+			return nil, fmt.Errorf("unsupported indexed type %s", input.Type)
+		default:
+			typ, ok := wrapperType(input.Type)
+			if !ok {
+				return nil, fmt.Errorf("unsupported type %s", input.Type)
+			}
+			field.Type, field.Arg = typ, formatValue(input.Type, value)
+		}
+		if input.Indexed {
+			wrapped.Topics += ", " + wrapTopic(data, event.Original.RawName, input)
+		}
+		wrapped.Fields = append(wrapped.Fields, field)
+	}
+	if err := uniqueFields(wrapped.Fields); err != nil {
+		return nil, err
+	}
+	return wrapped, nil
+}
+
+// wrapTopic returns the topic filter argument of an indexed event input. Addresses and big integers are filtered
+// by the EventFilter field named after the input, shared by the events with the same input, other types by nil.
+func wrapTopic(data *tmplWrapper, event string, input abi.Argument) string {
+	var typ, parse string
+	switch {
+	case input.Type.T == abi.AddressTy:
+		typ, parse = "common.Address", "HexToAddresses"
+	case (input.Type.T == abi.IntTy || input.Type.T == abi.UintTy) && bindBasicTypeGo(input.Type) == "*big.Int":
+		typ, parse = "*big.Int", parseInt(input.Type)+"s"
+	default:
+		return "nil"
+	}
+
+	name := capitalise(input.Name)
+	for _, topic := range data.Topics {
+		if topic.Name != name {
+			continue
+		}
+		// 同名但类型不同的参数不过滤
+		if topic.Type != typ {
+			return "nil"
+		}
+		topic.Events += ", " + event
+		return "c.filter.topics." + topic.Field
+	}
+	topic := &tmplWrapperTopic{Name: name, Json: snakeCase(name), Field: paramName(input.Name), Type: typ, Parse: parse, Events: "indexed in " + event}
+	data.Topics = append(data.Topics, topic)
+	return "c.filter.topics." + topic.Field
+}
+
+// wrapperType returns the model type of an abi type: addresses, big integers and
+// fixed bytes are hex or decimal strings, ok is false for tuples, nested arrays and functions
+func wrapperType(kind abi.Type) (string, bool) {
+	switch kind.T {
+	case abi.AddressTy, abi.StringTy, abi.FixedBytesTy:
+		return "string", true
+	case abi.IntTy, abi.UintTy:
+		if typ := bindBasicTypeGo(kind); typ != "*big.Int" {
+			return typ, true
+		}
+		return "string", true
+	case abi.BoolTy:
+		return "bool", true
+	case abi.BytesTy:
+		return "[]byte", true
+	case abi.SliceTy, abi.ArrayTy:
+		switch kind.Elem.T {
+		case abi.AddressTy, abi.StringTy, abi.IntTy, abi.UintTy, abi.BoolTy, abi.BytesTy:
+			elem, _ := wrapperType(*kind.Elem)
+			return "[]" + elem, true
+		}
+	}
+	return "", false
+}
+
+// wrapInput fills the statements converting the model value src into the binding argument
+func wrapInput(input abi.Argument, name string, src string, zero string) (*tmplWrapperArg, error) {
+	typ, ok := wrapperType(input.Type)
+	if !ok {
+		return nil, fmt.Errorf("unsupported input type %s", input.Type)
+	}
+	arg := &tmplWrapperArg{Name: name, Json: snakeCase(name), Type: typ, Arg: src}
+	local := "in" + name
+	check := fmt.Sprintf("if err != nil {\n\treturn %s, err\n}\n", zero)
+
+	switch input.Type.T {
+	case abi.AddressTy:
+		arg.Parse, arg.Arg = fmt.Sprintf("%s, err := utils.ParseAddress(%s)\n%s", local, src, check), local
+	case abi.IntTy, abi.UintTy:
+		if typ == "string" {
+			arg.Parse, arg.Arg = fmt.Sprintf("%s, err := utils.%s(%s)\n%s", local, parseInt(input.Type), src, check), local
+		}
+	case abi.FixedBytesTy:
+		arg.Parse = fmt.Sprintf("%sBytes, err := utils.ParseFixedBytes(%s, %d)\n%svar %s [%d]byte\ncopy(%s[:], %sBytes)\n",
+			local, src, input.Type.Size, check, local, input.Type.Size, local, local)
+		arg.Arg = local
+	case abi.SliceTy, abi.ArrayTy:
+		// 固定长度数组先解析为切片, 校验长度后复制
+		slice := local
+		if input.Type.T == abi.ArrayTy {
+			slice = local + "Items"
+		}
+		switch elem := *input.Type.Elem; {
+		case elem.T == abi.AddressTy:
+			arg.Parse = fmt.Sprintf("%s, err := utils.HexToAddresses(%s)\n%s", slice, src, check)
+		case typ == "[]string" && (elem.T == abi.IntTy || elem.T == abi.UintTy):
+			arg.Parse = fmt.Sprintf("%s, err := utils.%ss(%s)\n%s", slice, parseInt(elem), src, check)
+		default:
+			slice = src
+		}
+		if input.Type.T == abi.ArrayTy {
+			arg.Parse += fmt.Sprintf("if len(%s) != %d {\n\treturn %s, errors.New(\"invalid parameter, %s must have %d items\")\n}\nvar %s %s\ncopy(%s[:], %s)\n",
+				slice, input.Type.Size, zero, arg.Json, input.Type.Size, local, bindTypeGo(input.Type, nil), local, slice)
+			slice = local
+		}
+		arg.Arg = slice
+	}
+	return arg, nil
+}
+
+func parseInt(kind abi.Type) string {
+	if kind.T == abi.IntTy {
+		return "ParseInt256"
+	}
+	return "ParseUint256"
+}
+
+// formatValue returns the expression converting a binding value into its model type
+func formatValue(kind abi.Type, value string) string {
+	switch kind.T {
+	case abi.AddressTy:
+		return value + ".Hex()"
+	case abi.IntTy, abi.UintTy:
+		if bindBasicTypeGo(kind) == "*big.Int" {
+			return value + ".String()"
+		}
+	case abi.FixedBytesTy:
+		return "hexutil.Encode(" + value + "[:])"
+	case abi.SliceTy, abi.ArrayTy:
+		if kind.T == abi.ArrayTy {
+			value += "[:]"
+		}
+		switch kind.Elem.T {
+		case abi.AddressTy:
+			return "utils.Addresses2Hex(" + value + ")"
+		case abi.IntTy, abi.UintTy:
+			if bindBasicTypeGo(*kind.Elem) == "*big.Int" {
+				return "utils.BigInts2Strings(" + value + ")"
+			}
+		}
+	}
+	return value
+}
+
+func zeroValue(typ string) string {
+	switch {
+	case typ == "string":
+		return `""`
+	case typ == "bool":
+		return "false"
+	case strings.HasPrefix(typ, "[]"), strings.HasPrefix(typ, "*"):
+		return "nil"
+	}
+	return "0"
+}
+
+// paramName is the parameter name of the only input of a Read* method
+func paramName(name string) string {
+	name = decapitalise(name)
+	if token.IsKeyword(name) || wrapperLocals[name] {
+		name += "Value"
+	}
+	return name
+}
+
+func uniqueFields(fields []*tmplWrapperArg) error {
+	exists := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if field.Name == "" || exists[field.Name] {
+			return fmt.Errorf("duplicated field %q", field.Name)
+		}
+		exists[field.Name] = true
+	}
+	return nil
+}
+
+// wrapperExpressions joins the generated conversions to find out the imports they need
+func wrapperExpressions(data *tmplWrapper) string {
+	var b strings.Builder
+	for _, methods := range [][]*tmplWrapperMethod{data.Calls, data.Transacts} {
+		for _, method := range methods {
+			b.WriteString(method.Parse)
+			for _, output := range method.Outputs {
+				b.WriteString(output.Arg)
+			}
+		}
+	}
+	for _, event := range data.Events {
+		for _, field := range event.Fields {
+			b.WriteString(field.Arg)
+		}
+	}
+	return b.String()
+}
+
+// snakeCase converts a Go field name into the json key of the model, e.g. TokenURI to token_uri
+func snakeCase(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,112 @@
+package bind_test
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+)
+
+const wrapperABI = `[
+{"type":"function","name":"getPair","stateMutability":"view","inputs":[{"name":"key","type":"bytes32"}],"outputs":[{"name":"amount","type":"int256"},{"name":"who","type":"address"}]},
+{"type":"function","name":"info","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"tuple","components":[{"name":"a","type":"uint256"}]}]},
+{"type":"function","name":"mint","stateMutability":"payable","inputs":[{"name":"to","type":"address[2]"},{"name":"small","type":"int64"}],"outputs":[]},
+{"type":"event","name":"Named","anonymous":false,"inputs":[{"name":"label","type":"string","indexed":true},{"name":"ids","type":"uint256[]","indexed":false}]}
+]`
+
+func TestBindWrapper(t *testing.T) {
+	wrapper, err := bind.BindWrapper(wrapperABI, nil, &bind.WrapperOpts{
+		Type:          "Sample",
+		Package:       "sample",
+		BindingImport: "example.com/sample/contract",
+		ModelImport:   "example.com/sample/model",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wrapper.Skipped) != 1 || !strings.HasPrefix(wrapper.Skipped[0], "info()") {
+		t.Errorf("unexpected skipped %v", wrapper.Skipped)
+	}
+
+	for file, code := range map[string]string{"sample.go": wrapper.Contract, "method.go": wrapper.Method, "event.go": wrapper.Event} {
+		if _, err = parser.ParseFile(token.NewFileSet(), file, code, 0); err != nil {
+			t.Fatalf("generated %s does not parse: %v", file, err)
+		}
+	}
+	for code, wants := range map[string][]string{
+		wrapper.Contract: {
+			`func (c *Contract) ReadGetPair(ctx context.Context, key string, blockTag ...chainModel.BlockTag) (*model.MethodReadGetPairOutputs, error)`,
+			`inKeyBytes, err := utils.ParseFixedBytes(key, 32)`,
+			`Amount: out.Amount.String(),`,
+			`payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, 0)`,
+			`return "", errors.New("invalid parameter, to must have 2 items")`,
+			`tx, err := c.transactors[senderAddress].transactor.Mint(opts, inTo, inputs.Small)`,
+			`c.metrics.TxSent(c.contractAddr.Hex(), "mint")`,
+			`iter, err := c.filter.filterer.FilterNamed(opts, nil)`,
+			`Label: iter.Event.Label.Hex(),`,
+			`Ids:   utils.BigInts2Strings(iter.Event.Ids),`,
+		},
+		wrapper.Method: {
+			"type MethodReadGetPairOutputs struct",
+			"type MethodWriteMintInputs struct",
+			"PayableWei   *big.Int `json:\"payable_wei\"`",
+			"Small        int64    `json:\"small\"`",
+		},
+		wrapper.Event: {
+			"EventNamed ContractEvent = iota",
+			"EventNamed: \"Named\",",
+		},
+	} {
+		for _, want := range wants {
+			if !strings.Contains(code, want) {
+				t.Errorf("generated code should contain %s", want)
+			}
+		}
+	}
+	if strings.Contains(wrapper.Method, "MethodReadGetPairInputs") {
+		t.Errorf("a single read input should be a plain parameter")
+	}
+}
+
+func TestBindWrapper_Standard(t *testing.T) {
+	wrapper, err := bind.BindWrapper(erc1155.StandardERC1155MetaData.ABI, nil, &bind.WrapperOpts{
+		Type:          "StandardERC1155",
+		Package:       "erc1155",
+		BindingImport: "example.com/erc1155/contract",
+		ModelImport:   "example.com/erc1155/model",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wrapper.Skipped) != 0 {
+		t.Errorf("unexpected skipped %v", wrapper.Skipped)
+	}
+	for _, want := range []string{
+		"func (c *Contract) ReadBalanceOfBatch(ctx context.Context, inputs *model.MethodReadBalanceOfBatchInputs, blockTag ...chainModel.BlockTag) ([]string, error)",
+		"func (c *Contract) WriteSafeBatchTransferFrom(ctx context.Context, senderAddress string, txNonce uint64, inputs *model.MethodWriteSafeBatchTransferFromInputs) (string, error)",
+		"case model.EventTransferSingle:",
+		"iter, err := c.filter.filterer.FilterTransferSingle(opts, c.filter.topics.operator, c.filter.topics.from, c.filter.topics.to)",
+		"if topics.id, err = utils.ParseUint256s(filter.Id); err != nil {",
+	} {
+		if !strings.Contains(wrapper.Contract, want) {
+			t.Errorf("generated code should contain %s", want)
+		}
+	}
+	for _, want := range []string{
+		"Operator      []string `json:\"operator\"`       // indexed in ApprovalForAll, TransferBatch, TransferSingle",
+		"chainModel.RegisterEventPayload(Standard, SupportEvents[EventURI], func() chainModel.EventPayload { return &Event4URI{} })",
+	} {
+		if !strings.Contains(wrapper.Event, want) {
+			t.Errorf("generated model should contain %s", want)
+		}
+	}
+}
+
+func TestBindWrapper_Invalid(t *testing.T) {
+	if _, err := bind.BindWrapper(wrapperABI, nil, &bind.WrapperOpts{Type: "Sample", Package: "sample"}); err == nil {
+		t.Errorf("missing import paths should fail")
+	}
+}
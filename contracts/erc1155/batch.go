@@ -0,0 +1,133 @@
+package erc1155
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
+	"github.com/jason-bateman/go-erc-standard-contract/utils"
+)
+
+// Batch queues Read* calls of the contract, they are sent in one request by Execute.
+// Every queued method returns a result holding the same value type as the matching Read* method.
+type Batch struct {
+	contract     *Contract        // contract resolving the block tags
+	contractAddr common.Address   // contract address
+	abi          *abi.ABI         // standard abi
+	err          error            // abi parsing error
+	batch        *multicall.Batch // shared batch
+}
+
+// NewBatch creates a batch sent through the Multicall3 contract or json rpc batch requests of the contract options
+func (c *Contract) NewBatch() *Batch {
+	return c.Batch(c.multicall.NewBatch())
+}
+
+// Batch queues the calls of the contract on a shared batch, so that calls of several contracts are aggregated together
+func (c *Contract) Batch(batch *multicall.Batch) *Batch {
+	parsed, err := erc1155.StandardERC1155MetaData.GetAbi()
+	return &Batch{
+		contract:     c,
+		contractAddr: c.contractAddr,
+		abi:          parsed,
+		err:          err,
+		batch:        batch,
+	}
+}
+
+// Execute sends all calls of the underlying batch, every call reads the block of the optional block tag
+func (b *Batch) Execute(ctx context.Context, blockTag ...chainModel.BlockTag) error {
+	opts, err := b.contract.callOpts(ctx, blockTag)
+	if err != nil {
+		return err
+	}
+	return b.batch.ExecuteAt(opts)
+}
+
+func (b *Batch) ReadBalanceOf(inputs *model.MethodReadBalanceOf) *multicall.BatchResult {
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return multicall.Failed(err)
+	}
+	return b.add("balanceOf", unpackBigInt, common.HexToAddress(inputs.Owner), tokenId)
+}
+
+func (b *Batch) ReadBalanceOfBatch(inputs *model.MethodReadBalanceOfBatchInputs) *multicall.BatchResult {
+	if len(inputs.Ids) != len(inputs.Owners) || len(inputs.Ids) == 0 {
+		return multicall.Failed(errors.New("invalid parameter, please check parameter"))
+	}
+
+	owners := make([]common.Address, len(inputs.Owners))
+	for i, v := range inputs.Owners {
+		owners[i] = common.HexToAddress(v)
+	}
+
+	ids, err := utils.ParseUint256s(inputs.Ids)
+	if err != nil {
+		return multicall.Failed(err)
+	}
+
+	return b.add("balanceOfBatch", unpackBigInts, owners, ids)
+}
+
+func (b *Batch) ReadIsApprovedForAll(inputs *model.MethodReadIsApprovedForAllInputs) *multicall.BatchResult {
+	return b.add("isApprovedForAll", unpackBool, common.HexToAddress(inputs.Owner), common.HexToAddress(inputs.Operator))
+}
+
+func (b *Batch) ReadSupportsInterface(interfaceId string) *multicall.BatchResult {
+	idBytes4, err := parseInterfaceId(interfaceId)
+	if err != nil {
+		return multicall.Failed(err)
+	}
+	return b.add("supportsInterface", unpackBool, idBytes4)
+}
+
+func (b *Batch) ReadUri(id string) *multicall.BatchResult {
+	tokenId, err := utils.ParseUint256(id)
+	if err != nil {
+		return multicall.Failed(err)
+	}
+	return b.add("uri", unpackString, tokenId)
+}
+
+func (b *Batch) add(method string, unpack func(out []interface{}) interface{}, args ...interface{}) *multicall.BatchResult {
+	if b.err != nil {
+		return multicall.Failed(b.err)
+	}
+
+	data, err := b.abi.Pack(method, args...)
+	if err != nil {
+		return multicall.Failed(err)
+	}
+
+	call := multicall.Call{Target: b.contractAddr, AllowFailure: true, CallData: data}
+	return b.batch.Add(call, func(data []byte) (interface{}, error) {
+		out, err := b.abi.Unpack(method, data)
+		if err != nil {
+			return nil, err
+		}
+		return unpack(out), nil
+	})
+}
+
+func unpackBigInt(out []interface{}) interface{} {
+	return (*abi.ConvertType(out[0], new(*big.Int)).(**big.Int)).String()
+}
+
+func unpackBigInts(out []interface{}) interface{} {
+	return utils.BigInts2Strings(*abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int))
+}
+
+func unpackString(out []interface{}) interface{} {
+	return *abi.ConvertType(out[0], new(string)).(*string)
+}
+
+func unpackBool(out []interface{}) interface{} {
+	return *abi.ConvertType(out[0], new(bool)).(*bool)
+}
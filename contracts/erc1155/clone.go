@@ -0,0 +1,77 @@
+package erc1155
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/deploy"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/proxy"
+)
+
+// CloneOpts are the optional settings of Clone
+type CloneOpts struct {
+	Owner    string        // owner passed to initialize, default is the signer
+	Salt     common.Hash   // salt of the clone address, zero draws a random salt
+	Factory  string        // clone factory, default is clone.FactoryAddress
+	Contract *ContractOpts // options of the returned Contract, Backend and ContractAddr are set by Clone
+}
+
+// DeployImplementation deploys the embedded StandardERC1155Initializable bytecode, the implementation Clone clones, and
+// returns its address. Its constructor runs the initializer, so initialize of the implementation itself always reverts.
+func DeployImplementation(ctx context.Context, signer *bind.TransactOpts, backend *backend.Backend) (string, error) {
+	address, err := deploy.Deploy(ctx, signer, backend, common.Address{}, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := erc1155.DeployStandardERC1155Initializable(auth, backend)
+		return tx, err
+	})
+	if err != nil {
+		return "", err
+	}
+	return address.Hex(), nil
+}
+
+// Clone creates an EIP-1167 clone of a StandardERC1155Initializable implementation and initializes it with the uri
+// and the owner in the same transaction, the returned Contract is bound to the clone. The Contract shares
+// the backend, ReleaseResource does not close it.
+func Clone(ctx context.Context, signer *bind.TransactOpts, backend *backend.Backend, implementation, uri string, opts ...*CloneOpts) (*Contract, error) {
+	if len(opts) > 1 {
+		return nil, errors.New("invalid parameter, only one clone options is allowed")
+	}
+	ops := &CloneOpts{}
+	if len(opts) == 1 && opts[0] != nil {
+		ops = opts[0]
+	}
+
+	address, err := deploy.Clone(ctx, signer, backend, ops.Factory, implementation, ops.Owner, ops.Salt, func(owner common.Address) ([]byte, error) {
+		parsed, err := erc1155.StandardERC1155InitializableMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		return parsed.Pack("initialize", uri, owner)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindContract(backend, address, ops.Contract)
+}
+
+// ReadImplementation returns the implementation the contract delegates to when it is an EIP-1167 clone or an
+// EIP-1967 proxy, empty when the contract runs its own code
+func (c *Contract) ReadImplementation(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	implementation, err := proxy.ResolveImplementation(opts, c.backend, c.contractAddr)
+	if err != nil || implementation == (common.Address{}) {
+		return "", err
+	}
+	return implementation.Hex(), nil
+}
@@ -0,0 +1,76 @@
+package erc1155
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jason-bateman/go-erc-standard-contract/clone"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+func TestClone(t *testing.T) {
+	chain := testchain.New(t, 2)
+	deployer, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+
+	ctx := context.Background()
+	factory, _ := clone.NewFactory(chain.Backend, nil)
+	if err := factory.Install(ctx, signer); err != nil {
+		t.Fatalf("install factory err:%+v\n", err)
+	}
+
+	implementation, err := DeployImplementation(ctx, signer, chain.Backend)
+	if err != nil {
+		t.Fatalf("deploy implementation err:%+v\n", err)
+	}
+
+	salt := common.HexToHash("0x01")
+	owner := chain.Accounts[1].Address
+	contract, err := Clone(ctx, signer, chain.Backend, implementation, "https://example.com/{id}.json", &CloneOpts{Salt: salt, Owner: owner.Hex()})
+	if err != nil {
+		t.Fatalf("clone err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	if expected := factory.ComputeAddress(deployer, common.HexToAddress(implementation), salt); contract.contractAddr != expected {
+		t.Errorf("clone address:%s want:%s\n", contract.contractAddr.Hex(), expected.Hex())
+	}
+	got, err := contract.ReadImplementation(ctx)
+	if err != nil || got != implementation {
+		t.Errorf("read implementation:%s want:%s err:%+v\n", got, implementation, err)
+	}
+
+	// clone的存储由initialize设置
+	if uri, err := contract.ReadUri(ctx, "1"); err != nil || uri != "https://example.com/{id}.json" {
+		t.Errorf("read uri:%s err:%+v\n", uri, err)
+	}
+	if got, err = contract.ReadOwner(ctx); err != nil || got != owner.Hex() {
+		t.Errorf("read owner:%s want:%s err:%+v\n", got, owner.Hex(), err)
+	}
+
+	// clone和实现合约都不能再次初始化
+	for _, address := range []string{contract.contractAddr.Hex(), implementation} {
+		instance, _ := erc1155.NewStandardERC1155InitializableTransactor(common.HexToAddress(address), chain.Backend)
+		if _, err = instance.Initialize(signer, "https://taken.com/{id}.json", deployer); err == nil || !strings.Contains(err.Error(), "already initialized") {
+			t.Errorf("initialize %s again err:%+v\n", address, err)
+		}
+	}
+	if got, _ = contract.ReadOwner(ctx); got != owner.Hex() {
+		t.Errorf("owner after initialize again:%s\n", got)
+	}
+
+	deployed, err := Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json")
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer deployed.ReleaseResource()
+	if got, err = deployed.ReadImplementation(ctx); err != nil || got != "" {
+		t.Errorf("read implementation of a full contract:%s err:%+v\n", got, err)
+	}
+
+	if _, err = Clone(ctx, signer, chain.Backend, deployer.Hex(), "https://example.com/{id}.json"); err == nil {
+		t.Errorf("clone of an account without code should fail\n")
+	}
+}
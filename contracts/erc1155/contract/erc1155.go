@@ -31,7 +31,7 @@ var (
 // AddressMetaData contains all meta data concerning the Address contract.
 var AddressMetaData = &bind.MetaData{
 	ABI: "[]",
-	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220bee193906374cb430dbfe10577e6da675b934a76601ed9170b4f2e5ecb44595364736f6c63430008000033",
+	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220045f4608d862e0ff28e24be2dd3f4db77c05cbe11d5ff2d00967c8afdaa3dac664736f6c63430008150033",
 }
 
 // AddressABI is the input ABI used to generate the binding from.
@@ -365,7 +365,7 @@ var ERC1155MetaData = &bind.MetaData{
 		"01ffc9a7": "supportsInterface(bytes4)",
 		"0e89341c": "uri(uint256)",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b506040516200171a3803806200171a833981016040819052620000349162000105565b6200003f8162000046565b5062000227565b80516200005b9060029060208401906200005f565b5050565b8280546200006d90620001d4565b90600052602060002090601f016020900481019282620000915760008555620000dc565b82601f10620000ac57805160ff1916838001178555620000dc565b82800160010185558215620000dc579182015b82811115620000dc578251825591602001919060010190620000bf565b50620000ea929150620000ee565b5090565b5b80821115620000ea5760008155600101620000ef565b6000602080838503121562000118578182fd5b82516001600160401b03808211156200012f578384fd5b818501915085601f83011262000143578384fd5b81518181111562000158576200015862000211565b604051601f8201601f19168101850183811182821017156200017e576200017e62000211565b604052818152838201850188101562000195578586fd5b8592505b81831015620001b8578383018501518184018601529184019162000199565b81831115620001c957858583830101525b979650505050505050565b600281046001821680620001e957607f821691505b602082108114156200020b57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b6114e380620002376000396000f3fe608060405234801561001057600080fd5b50600436106100875760003560e01c80634e1273f41161005b5780634e1273f41461010a578063a22cb4651461012a578063e985e9c51461013d578063f242432a1461015057610087565b8062fdd58e1461008c57806301ffc9a7146100b55780630e89341c146100d55780632eb2c2d6146100f5575b600080fd5b61009f61009a366004610d3a565b610163565b6040516100ac91906112ea565b60405180910390f35b6100c86100c3366004610e21565b6101ba565b6040516100ac9190610fe1565b6100e86100e3366004610e60565b610202565b6040516100ac9190610fec565b610108610103366004610bf7565b610296565b005b61011d610118366004610d63565b6102f4565b6040516100ac9190610fa0565b610108610138366004610d00565b610414565b6100c861014b366004610bc5565b6104e2565b61010861015e366004610c9d565b610510565b60006001600160a01b0383166101945760405162461bcd60e51b815260040161018b9061109b565b60405180910390fd5b506000908152602081815260408083206001600160a01b03949094168352929052205490565b60006001600160e01b03198216636cdb3d1360e11b14806101eb57506001600160e01b031982166303a24d0760e21b145b806101fa57506101fa82610567565b90505b919050565b60606002805461021190611367565b80601f016020809104026020016040519081016040528092919081815260200182805461023d90611367565b801561028a5780601f1061025f5761010080835404028352916020019161028a565b820191906000526020600020905b81548152906001019060200180831161026d57829003601f168201915b50505050509050919050565b61029e610580565b6001600160a01b0316856001600160a01b031614806102c457506102c48561014b610580565b6102e05760405162461bcd60e51b815260040161018b90611174565b6102ed8585858585610585565b5050505050565b606081518351146103175760405162461bcd60e51b815260040161018b90611259565b6000835167ffffffffffffffff81111561034157634e487b7160e01b600052604160045260246000fd5b60405190808252806020026020018201604052801561036a578160200160208202803683370190505b50905060005b845181101561040c576103d185828151811061039c57634e487b7160e01b600052603260045260246000fd5b60200260200101518583815181106103c457634e487b7160e01b600052603260045260246000fd5b6020026020010151610163565b8282815181106103f157634e487b7160e01b600052603260045260246000fd5b6020908102919091010152610405816113a2565b9050610370565b509392505050565b816001600160a01b0316610426610580565b6001600160a01b0316141561044d5760405162461bcd60e51b815260040161018b90611210565b806001600061045a610580565b6001600160a01b03908116825260208083019390935260409182016000908120918716808252919093529120805460ff19169215159290921790915561049e610580565b6001600160a01b03167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31836040516104d69190610fe1565b60405180910390a35050565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205460ff1690565b610518610580565b6001600160a01b0316856001600160a01b0316148061053e575061053e8561014b610580565b61055a5760405162461bcd60e51b815260040161018b906110e6565b6102ed8585858585610756565b6001600160e01b031981166301ffc9a760e01b14919050565b335b90565b81518351146105a65760405162461bcd60e51b815260040161018b906112a2565b6001600160a01b0384166105cc5760405162461bcd60e51b815260040161018b9061112f565b60006105d6610580565b90506105e681878787878761074e565b60005b84518110156106e857600085828151811061061457634e487b7160e01b600052603260045260246000fd5b60200260200101519050600085838151811061064057634e487b7160e01b600052603260045260246000fd5b602090810291909101810151600084815280835260408082206001600160a01b038e1683529093529190912054909150818110156106905760405162461bcd60e51b815260040161018b906111c6565b6000838152602081815260408083206001600160a01b038e8116855292528083208585039055908b168252812080548492906106cd90849061134f565b92505081905550505050806106e1906113a2565b90506105e9565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610738929190610fb3565b60405180910390a461074e818787878787610899565b505050505050565b6001600160a01b03841661077c5760405162461bcd60e51b815260040161018b9061112f565b6000610786610580565b90506107a6818787610797886109a7565b6107a0886109a7565b8761074e565b6000848152602081815260408083206001600160a01b038a168452909152902054838110156107e75760405162461bcd60e51b815260040161018b906111c6565b6000858152602081815260408083206001600160a01b038b811685529252808320878503905590881682528120805486929061082490849061134f565b92505081905550856001600160a01b0316876001600160a01b0316836001600160a01b03167fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62888860405161087a9291906112f3565b60405180910390a4610890828888888888610a00565b50505050505050565b6108ab846001600160a01b0316610ad1565b1561074e5760405163bc197c8160e01b81526001600160a01b0385169063bc197c81906108e49089908990889088908890600401610efd565b602060405180830381600087803b1580156108fe57600080fd5b505af192505050801561092e575060408051601f3d908101601f1916820190925261092b91810190610e44565b60015b6109775761093a6113ef565b80610945575061095f565b8060405162461bcd60e51b815260040161018b9190610fec565b60405162461bcd60e51b815260040161018b90610fff565b6001600160e01b0319811663bc197c8160e01b146108905760405162461bcd60e51b815260040161018b90611053565b604080516001808252818301909252606091600091906020808301908036833701905050905082816000815181106109ef57634e487b7160e01b600052603260045260246000fd5b602090810291909101015292915050565b610a12846001600160a01b0316610ad1565b1561074e5760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610a4b9089908990889088908890600401610f5b565b602060405180830381600087803b158015610a6557600080fd5b505af1925050508015610a95575060408051601f3d908101601f19168201909252610a9291810190610e44565b60015b610aa15761093a6113ef565b6001600160e01b0319811663f23a6e6160e01b146108905760405162461bcd60e51b815260040161018b90611053565b3b151590565b80356001600160a01b03811681146101fd57600080fd5b600082601f830112610afe578081fd5b81356020610b13610b0e8361132b565b611301565b8281528181019085830183850287018401881015610b2f578586fd5b855b85811015610b4d57813584529284019290840190600101610b31565b5090979650505050505050565b600082601f830112610b6a578081fd5b813567ffffffffffffffff811115610b8457610b846113d3565b610b97601f8201601f1916602001611301565b818152846020838601011115610bab578283fd5b816020850160208301379081016020019190915292915050565b60008060408385031215610bd7578182fd5b610be083610ad7565b9150610bee60208401610ad7565b90509250929050565b600080600080600060a08688031215610c0e578081fd5b610c1786610ad7565b9450610c2560208701610ad7565b9350604086013567ffffffffffffffff80821115610c41578283fd5b610c4d89838a01610aee565b94506060880135915080821115610c62578283fd5b610c6e89838a01610aee565b93506080880135915080821115610c83578283fd5b50610c9088828901610b5a565b9150509295509295909350565b600080600080600060a08688031215610cb4578081fd5b610cbd86610ad7565b9450610ccb60208701610ad7565b93506040860135925060608601359150608086013567ffffffffffffffff811115610cf4578182fd5b610c9088828901610b5a565b60008060408385031215610d12578182fd5b610d1b83610ad7565b915060208301358015158114610d2f578182fd5b809150509250929050565b60008060408385031215610d4c578182fd5b610d5583610ad7565b946020939093013593505050565b60008060408385031215610d75578182fd5b823567ffffffffffffffff80821115610d8c578384fd5b818501915085601f830112610d9f578384fd5b81356020610daf610b0e8361132b565b82815281810190858301838502870184018b1015610dcb578889fd5b8896505b84871015610df457610de081610ad7565b835260019690960195918301918301610dcf565b5096505086013592505080821115610e0a578283fd5b50610e1785828601610aee565b9150509250929050565b600060208284031215610e32578081fd5b8135610e3d81611494565b9392505050565b600060208284031215610e55578081fd5b8151610e3d81611494565b600060208284031215610e71578081fd5b5035919050565b6000815180845260208085019450808401835b83811015610ea757815187529582019590820190600101610e8b565b509495945050505050565b60008151808452815b81811015610ed757602081850181015186830182015201610ebb565b81811115610ee85782602083870101525b50601f01601f19169290920160200192915050565b6001600160a01b0386811682528516602082015260a060408201819052600090610f2990830186610e78565b8281036060840152610f3b8186610e78565b90508281036080840152610f4f8185610eb2565b98975050505050505050565b6001600160a01b03868116825285166020820152604081018490526060810183905260a060808201819052600090610f9590830184610eb2565b979650505050505050565b600060208252610e3d6020830184610e78565b600060408252610fc66040830185610e78565b8281036020840152610fd88185610e78565b95945050505050565b901515815260200190565b600060208252610e3d6020830184610eb2565b60208082526034908201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356040820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b606082015260800190565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b6020808252602b908201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60408201526a65726f206164647265737360a81b606082015260800190565b60208082526029908201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260408201526808185c1c1c9bdd995960ba1b606082015260800190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b60208082526032908201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206040820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b60208082526029908201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604082015268103337b91039b2b63360b91b606082015260800190565b60208082526029908201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604082015268040dad2e6dac2e8c6d60bb1b606082015260800190565b60208082526028908201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206040820152670dad2e6dac2e8c6d60c31b606082015260800190565b90815260200190565b918252602082015260400190565b60405181810167ffffffffffffffff81118282101715611323576113236113d3565b604052919050565b600067ffffffffffffffff821115611345576113456113d3565b5060209081020190565b60008219821115611362576113626113bd565b500190565b60028104600182168061137b57607f821691505b6020821081141561139c57634e487b7160e01b600052602260045260246000fd5b50919050565b60006000198214156113b6576113b66113bd565b5060010190565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052604160045260246000fd5b60e01c90565b600060443d10156113ff57610582565b600481823e6308c379a061141382516113e9565b1461141d57610582565b6040513d600319016004823e80513d67ffffffffffffffff816024840111818411171561144d5750505050610582565b828401925082519150808211156114675750505050610582565b503d8301602082840101111561147f57505050610582565b601f01601f1916810160200160405291505090565b6001600160e01b0319811681146114aa57600080fd5b5056fea2646970667358221220ad5e1a573fe6c986a9ac9eaf764c1f662c29ee2f35dbf5c82d14eb7b495adc4364736f6c63430008000033",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620016453803806200164583398101604081905262000034916200006e565b6200003f8162000046565b506200029e565b6002620000548282620001d2565b5050565b634e487b7160e01b600052604160045260246000fd5b600060208083850312156200008257600080fd5b82516001600160401b03808211156200009a57600080fd5b818501915085601f830112620000af57600080fd5b815181811115620000c457620000c462000058565b604051601f8201601f19908116603f01168101908382118183101715620000ef57620000ef62000058565b8160405282815288868487010111156200010857600080fd5b600093505b828410156200012c57848401860151818501870152928501926200010d565b600086848301015280965050505050505092915050565b600181811c908216806200015857607f821691505b6020821081036200017957634e487b7160e01b600052602260045260246000fd5b50919050565b601f821115620001cd57600081815260208120601f850160051c81016020861015620001a85750805b601f850160051c820191505b81811015620001c957828155600101620001b4565b5050505b505050565b81516001600160401b03811115620001ee57620001ee62000058565b6200020681620001ff845462000143565b846200017f565b602080601f8311600181146200023e5760008415620002255750858301515b600019600386901b1c1916600185901b178555620001c9565b600085815260208120601f198616915b828110156200026f578886015182559484019460019091019084016200024e565b50858210156200028e5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61139780620002ae6000396000f3fe608060405234801561001057600080fd5b50600436106100875760003560e01c80634e1273f41161005b5780634e1273f41461010a578063a22cb4651461012a578063e985e9c51461013d578063f242432a1461017957600080fd5b8062fdd58e1461008c57806301ffc9a7146100b25780630e89341c146100d55780632eb2c2d6146100f5575b600080fd5b61009f61009a366004610ba8565b61018c565b6040519081526020015b60405180910390f35b6100c56100c0366004610beb565b610226565b60405190151581526020016100a9565b6100e86100e3366004610c0f565b610276565b6040516100a99190610c6e565b610108610103366004610dcd565b61030a565b005b61011d610118366004610e77565b6103a1565b6040516100a99190610f7d565b610108610138366004610f90565b6104cb565b6100c561014b366004610fcc565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205460ff1690565b610108610187366004610fff565b6105a1565b60006001600160a01b0383166101fd5760405162461bcd60e51b815260206004820152602b60248201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60448201526a65726f206164647265737360a81b60648201526084015b60405180910390fd5b506000818152602081815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b148061025757506001600160e01b031982166303a24d0760e21b145b8061022057506301ffc9a760e01b6001600160e01b0319831614610220565b60606002805461028590611064565b80601f01602080910402602001604051908101604052809291908181526020018280546102b190611064565b80156102fe5780601f106102d3576101008083540402835291602001916102fe565b820191906000526020600020905b8154815290600101906020018083116102e157829003601f168201915b50505050509050919050565b6001600160a01b0385163314806103265750610326853361014b565b61038d5760405162461bcd60e51b815260206004820152603260248201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206044820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b60648201526084016101f4565b61039a8585858585610628565b5050505050565b606081518351146104065760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b60648201526084016101f4565b6000835167ffffffffffffffff81111561042257610422610c81565b60405190808252806020026020018201604052801561044b578160200160208202803683370190505b50905060005b84518110156104c35761049685828151811061046f5761046f61109e565b60200260200101518583815181106104895761048961109e565b602002602001015161018c565b8282815181106104a8576104a861109e565b60209081029190910101526104bc816110ca565b9050610451565b509392505050565b6001600160a01b03821633036105355760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b60648201526084016101f4565b3360008181526001602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6001600160a01b0385163314806105bd57506105bd853361014b565b61061b5760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260448201526808185c1c1c9bdd995960ba1b60648201526084016101f4565b61039a8585858585610805565b815183511461068a5760405162461bcd60e51b815260206004820152602860248201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206044820152670dad2e6dac2e8c6d60c31b60648201526084016101f4565b6001600160a01b0384166106b05760405162461bcd60e51b81526004016101f4906110e3565b3360005b84518110156107975760008582815181106106d1576106d161109e565b6020026020010151905060008583815181106106ef576106ef61109e565b602090810291909101810151600084815280835260408082206001600160a01b038e16835290935291909120549091508181101561073f5760405162461bcd60e51b81526004016101f490611128565b6000838152602081815260408083206001600160a01b038e8116855292528083208585039055908b1682528120805484929061077c908490611172565b9250508190555050505080610790906110ca565b90506106b4565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb87876040516107e7929190611185565b60405180910390a46107fd81878787878761092b565b505050505050565b6001600160a01b03841661082b5760405162461bcd60e51b81526004016101f4906110e3565b3361084481878761083b88610a86565b61039a88610a86565b6000848152602081815260408083206001600160a01b038a168452909152902054838110156108855760405162461bcd60e51b81526004016101f490611128565b6000858152602081815260408083206001600160a01b038b81168552925280832087850390559088168252812080548692906108c2908490611172565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a4610922828888888888610ad1565b50505050505050565b6001600160a01b0384163b156107fd5760405163bc197c8160e01b81526001600160a01b0385169063bc197c819061096f90899089908890889088906004016111b3565b6020604051808303816000875af19250505080156109aa575060408051601f3d908101601f191682019092526109a791810190611211565b60015b610a56576109b661122e565b806308c379a0036109ef57506109ca61124a565b806109d557506109f1565b8060405162461bcd60e51b81526004016101f49190610c6e565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b60648201526084016101f4565b6001600160e01b0319811663bc197c8160e01b146109225760405162461bcd60e51b81526004016101f4906112d4565b60408051600180825281830190925260609160009190602080830190803683370190505090508281600081518110610ac057610ac061109e565b602090810291909101015292915050565b6001600160a01b0384163b156107fd5760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610b15908990899088908890889060040161131c565b6020604051808303816000875af1925050508015610b50575060408051601f3d908101601f19168201909252610b4d91810190611211565b60015b610b5c576109b661122e565b6001600160e01b0319811663f23a6e6160e01b146109225760405162461bcd60e51b81526004016101f4906112d4565b80356001600160a01b0381168114610ba357600080fd5b919050565b60008060408385031215610bbb57600080fd5b610bc483610b8c565b946020939093013593505050565b6001600160e01b031981168114610be857600080fd5b50565b600060208284031215610bfd57600080fd5b8135610c0881610bd2565b9392505050565b600060208284031215610c2157600080fd5b5035919050565b6000815180845260005b81811015610c4e57602081850181015186830182015201610c32565b506000602082860101526020601f19601f83011685010191505092915050565b602081526000610c086020830184610c28565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff81118282101715610cbd57610cbd610c81565b6040525050565b600067ffffffffffffffff821115610cde57610cde610c81565b5060051b60200190565b600082601f830112610cf957600080fd5b81356020610d0682610cc4565b604051610d138282610c97565b83815260059390931b8501820192828101915086841115610d3357600080fd5b8286015b84811015610d4e5780358352918301918301610d37565b509695505050505050565b600082601f830112610d6a57600080fd5b813567ffffffffffffffff811115610d8457610d84610c81565b604051610d9b601f8301601f191660200182610c97565b818152846020838601011115610db057600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a08688031215610de557600080fd5b610dee86610b8c565b9450610dfc60208701610b8c565b9350604086013567ffffffffffffffff80821115610e1957600080fd5b610e2589838a01610ce8565b94506060880135915080821115610e3b57600080fd5b610e4789838a01610ce8565b93506080880135915080821115610e5d57600080fd5b50610e6a88828901610d59565b9150509295509295909350565b60008060408385031215610e8a57600080fd5b823567ffffffffffffffff80821115610ea257600080fd5b818501915085601f830112610eb657600080fd5b81356020610ec382610cc4565b604051610ed08282610c97565b83815260059390931b8501820192828101915089841115610ef057600080fd5b948201945b83861015610f1557610f0686610b8c565b82529482019490820190610ef5565b96505086013592505080821115610f2b57600080fd5b50610f3885828601610ce8565b9150509250929050565b600081518084526020808501945080840160005b83811015610f7257815187529582019590820190600101610f56565b509495945050505050565b602081526000610c086020830184610f42565b60008060408385031215610fa357600080fd5b610fac83610b8c565b915060208301358015158114610fc157600080fd5b809150509250929050565b60008060408385031215610fdf57600080fd5b610fe883610b8c565b9150610ff660208401610b8c565b90509250929050565b600080600080600060a0868803121561101757600080fd5b61102086610b8c565b945061102e60208701610b8c565b93506040860135925060608601359150608086013567ffffffffffffffff81111561105857600080fd5b610e6a88828901610d59565b600181811c9082168061107857607f821691505b60208210810361109857634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b6000600182016110dc576110dc6110b4565b5060010190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b80820180821115610220576102206110b4565b6040815260006111986040830185610f42565b82810360208401526111aa8185610f42565b95945050505050565b6001600160a01b0386811682528516602082015260a0604082018190526000906111df90830186610f42565b82810360608401526111f18186610f42565b905082810360808401526112058185610c28565b98975050505050505050565b60006020828403121561122357600080fd5b8151610c0881610bd2565b600060033d11156112475760046000803e5060005160e01c5b90565b600060443d10156112585790565b6040516003193d81016004833e81513d67ffffffffffffffff816024840111818411171561128857505050505090565b82850191508151818111156112a05750505050505090565b843d87010160208285010111156112ba5750505050505090565b6112c960208286010187610c97565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b6001600160a01b03868116825285166020820152604081018490526060810183905260a06080820181905260009061135690830184610c28565b97965050505050505056fea264697066735822122092c0f8899a3f85eb2dfb444597126f6489b098018b922b8f9a0070ad5c7df08864736f6c63430008150033",
 }
 
 // ERC1155ABI is the input ABI used to generate the binding from.
@@ -458,7 +458,7 @@ type ERC1155TransactorRaw struct {
 
 // NewERC1155 creates a new instance of ERC1155, bound to a specific deployed contract.
 func NewERC1155(address common.Address, backend bind.ContractBackend) (*ERC1155, error) {
-	contract, err := bindERC1155(address, backend, backend, backend)
+	contract, err := bindERC1155(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -467,7 +467,7 @@ func NewERC1155(address common.Address, backend bind.ContractBackend) (*ERC1155,
 
 // NewERC1155Caller creates a new read-only instance of ERC1155, bound to a specific deployed contract.
 func NewERC1155Caller(address common.Address, caller bind.ContractCaller) (*ERC1155Caller, error) {
-	contract, err := bindERC1155(address, caller, nil, nil)
+	contract, err := bindERC1155(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -476,7 +476,7 @@ func NewERC1155Caller(address common.Address, caller bind.ContractCaller) (*ERC1
 
 // NewERC1155Transactor creates a new write-only instance of ERC1155, bound to a specific deployed contract.
 func NewERC1155Transactor(address common.Address, transactor bind.ContractTransactor) (*ERC1155Transactor, error) {
-	contract, err := bindERC1155(address, nil, transactor, nil)
+	contract, err := bindERC1155(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -484,8 +484,8 @@ func NewERC1155Transactor(address common.Address, transactor bind.ContractTransa
 }
 
 // NewERC1155Filterer creates a new log filterer instance of ERC1155, bound to a specific deployed contract.
-func NewERC1155Filterer(address common.Address, filterer bind.ContractFilterer) (*ERC1155Filterer, error) {
-	contract, err := bindERC1155(address, nil, nil, filterer)
+func NewERC1155Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*ERC1155Filterer, error) {
+	contract, err := bindERC1155(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -493,12 +493,12 @@ func NewERC1155Filterer(address common.Address, filterer bind.ContractFilterer)
 }
 
 // bindERC1155 binds a generic wrapper to an already deployed contract.
-func bindERC1155(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindERC1155(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(ERC1155ABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -1470,7 +1470,7 @@ type ERC1155BurnableTransactorRaw struct {
 
 // NewERC1155Burnable creates a new instance of ERC1155Burnable, bound to a specific deployed contract.
 func NewERC1155Burnable(address common.Address, backend bind.ContractBackend) (*ERC1155Burnable, error) {
-	contract, err := bindERC1155Burnable(address, backend, backend, backend)
+	contract, err := bindERC1155Burnable(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -1479,7 +1479,7 @@ func NewERC1155Burnable(address common.Address, backend bind.ContractBackend) (*
 
 // NewERC1155BurnableCaller creates a new read-only instance of ERC1155Burnable, bound to a specific deployed contract.
 func NewERC1155BurnableCaller(address common.Address, caller bind.ContractCaller) (*ERC1155BurnableCaller, error) {
-	contract, err := bindERC1155Burnable(address, caller, nil, nil)
+	contract, err := bindERC1155Burnable(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1488,7 +1488,7 @@ func NewERC1155BurnableCaller(address common.Address, caller bind.ContractCaller
 
 // NewERC1155BurnableTransactor creates a new write-only instance of ERC1155Burnable, bound to a specific deployed contract.
 func NewERC1155BurnableTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC1155BurnableTransactor, error) {
-	contract, err := bindERC1155Burnable(address, nil, transactor, nil)
+	contract, err := bindERC1155Burnable(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1496,8 +1496,8 @@ func NewERC1155BurnableTransactor(address common.Address, transactor bind.Contra
 }
 
 // NewERC1155BurnableFilterer creates a new log filterer instance of ERC1155Burnable, bound to a specific deployed contract.
-func NewERC1155BurnableFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC1155BurnableFilterer, error) {
-	contract, err := bindERC1155Burnable(address, nil, nil, filterer)
+func NewERC1155BurnableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*ERC1155BurnableFilterer, error) {
+	contract, err := bindERC1155Burnable(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -1505,12 +1505,12 @@ func NewERC1155BurnableFilterer(address common.Address, filterer bind.ContractFi
 }
 
 // bindERC1155Burnable binds a generic wrapper to an already deployed contract.
-func bindERC1155Burnable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindERC1155Burnable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(ERC1155BurnableABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -2524,7 +2524,7 @@ type ERC1155SupplyTransactorRaw struct {
 
 // NewERC1155Supply creates a new instance of ERC1155Supply, bound to a specific deployed contract.
 func NewERC1155Supply(address common.Address, backend bind.ContractBackend) (*ERC1155Supply, error) {
-	contract, err := bindERC1155Supply(address, backend, backend, backend)
+	contract, err := bindERC1155Supply(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -2533,7 +2533,7 @@ func NewERC1155Supply(address common.Address, backend bind.ContractBackend) (*ER
 
 // NewERC1155SupplyCaller creates a new read-only instance of ERC1155Supply, bound to a specific deployed contract.
 func NewERC1155SupplyCaller(address common.Address, caller bind.ContractCaller) (*ERC1155SupplyCaller, error) {
-	contract, err := bindERC1155Supply(address, caller, nil, nil)
+	contract, err := bindERC1155Supply(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2542,7 +2542,7 @@ func NewERC1155SupplyCaller(address common.Address, caller bind.ContractCaller)
 
 // NewERC1155SupplyTransactor creates a new write-only instance of ERC1155Supply, bound to a specific deployed contract.
 func NewERC1155SupplyTransactor(address common.Address, transactor bind.ContractTransactor) (*ERC1155SupplyTransactor, error) {
-	contract, err := bindERC1155Supply(address, nil, transactor, nil)
+	contract, err := bindERC1155Supply(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -2550,8 +2550,8 @@ func NewERC1155SupplyTransactor(address common.Address, transactor bind.Contract
 }
 
 // NewERC1155SupplyFilterer creates a new log filterer instance of ERC1155Supply, bound to a specific deployed contract.
-func NewERC1155SupplyFilterer(address common.Address, filterer bind.ContractFilterer) (*ERC1155SupplyFilterer, error) {
-	contract, err := bindERC1155Supply(address, nil, nil, filterer)
+func NewERC1155SupplyFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*ERC1155SupplyFilterer, error) {
+	contract, err := bindERC1155Supply(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -2559,12 +2559,12 @@ func NewERC1155SupplyFilterer(address common.Address, filterer bind.ContractFilt
 }
 
 // bindERC1155Supply binds a generic wrapper to an already deployed contract.
-func bindERC1155Supply(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindERC1155Supply(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(ERC1155SupplyABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -3589,7 +3589,7 @@ type ERC165TransactorRaw struct {
 
 // NewERC165 creates a new instance of ERC165, bound to a specific deployed contract.
 func NewERC165(address common.Address, backend bind.ContractBackend) (*ERC165, error) {
-	contract, err := bindERC165(address, backend, backend, backend)
+	contract, err := bindERC165(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -3598,7 +3598,7 @@ func NewERC165(address common.Address, backend bind.ContractBackend) (*ERC165, e
 
 // NewERC165Caller creates a new read-only instance of ERC165, bound to a specific deployed contract.
 func NewERC165Caller(address common.Address, caller bind.ContractCaller) (*ERC165Caller, error) {
-	contract, err := bindERC165(address, caller, nil, nil)
+	contract, err := bindERC165(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3607,7 +3607,7 @@ func NewERC165Caller(address common.Address, caller bind.ContractCaller) (*ERC16
 
 // NewERC165Transactor creates a new write-only instance of ERC165, bound to a specific deployed contract.
 func NewERC165Transactor(address common.Address, transactor bind.ContractTransactor) (*ERC165Transactor, error) {
-	contract, err := bindERC165(address, nil, transactor, nil)
+	contract, err := bindERC165(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3615,8 +3615,8 @@ func NewERC165Transactor(address common.Address, transactor bind.ContractTransac
 }
 
 // NewERC165Filterer creates a new log filterer instance of ERC165, bound to a specific deployed contract.
-func NewERC165Filterer(address common.Address, filterer bind.ContractFilterer) (*ERC165Filterer, error) {
-	contract, err := bindERC165(address, nil, nil, filterer)
+func NewERC165Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*ERC165Filterer, error) {
+	contract, err := bindERC165(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -3624,12 +3624,12 @@ func NewERC165Filterer(address common.Address, filterer bind.ContractFilterer) (
 }
 
 // bindERC165 binds a generic wrapper to an already deployed contract.
-func bindERC165(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindERC165(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(ERC165ABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -3784,7 +3784,7 @@ type IERC1155TransactorRaw struct {
 
 // NewIERC1155 creates a new instance of IERC1155, bound to a specific deployed contract.
 func NewIERC1155(address common.Address, backend bind.ContractBackend) (*IERC1155, error) {
-	contract, err := bindIERC1155(address, backend, backend, backend)
+	contract, err := bindIERC1155(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -3793,7 +3793,7 @@ func NewIERC1155(address common.Address, backend bind.ContractBackend) (*IERC115
 
 // NewIERC1155Caller creates a new read-only instance of IERC1155, bound to a specific deployed contract.
 func NewIERC1155Caller(address common.Address, caller bind.ContractCaller) (*IERC1155Caller, error) {
-	contract, err := bindIERC1155(address, caller, nil, nil)
+	contract, err := bindIERC1155(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3802,7 +3802,7 @@ func NewIERC1155Caller(address common.Address, caller bind.ContractCaller) (*IER
 
 // NewIERC1155Transactor creates a new write-only instance of IERC1155, bound to a specific deployed contract.
 func NewIERC1155Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC1155Transactor, error) {
-	contract, err := bindIERC1155(address, nil, transactor, nil)
+	contract, err := bindIERC1155(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -3810,8 +3810,8 @@ func NewIERC1155Transactor(address common.Address, transactor bind.ContractTrans
 }
 
 // NewIERC1155Filterer creates a new log filterer instance of IERC1155, bound to a specific deployed contract.
-func NewIERC1155Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC1155Filterer, error) {
-	contract, err := bindIERC1155(address, nil, nil, filterer)
+func NewIERC1155Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*IERC1155Filterer, error) {
+	contract, err := bindIERC1155(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -3819,12 +3819,12 @@ func NewIERC1155Filterer(address common.Address, filterer bind.ContractFilterer)
 }
 
 // bindIERC1155 binds a generic wrapper to an already deployed contract.
-func bindIERC1155(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindIERC1155(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(IERC1155ABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -4763,7 +4763,7 @@ type IERC1155MetadataURITransactorRaw struct {
 
 // NewIERC1155MetadataURI creates a new instance of IERC1155MetadataURI, bound to a specific deployed contract.
 func NewIERC1155MetadataURI(address common.Address, backend bind.ContractBackend) (*IERC1155MetadataURI, error) {
-	contract, err := bindIERC1155MetadataURI(address, backend, backend, backend)
+	contract, err := bindIERC1155MetadataURI(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -4772,7 +4772,7 @@ func NewIERC1155MetadataURI(address common.Address, backend bind.ContractBackend
 
 // NewIERC1155MetadataURICaller creates a new read-only instance of IERC1155MetadataURI, bound to a specific deployed contract.
 func NewIERC1155MetadataURICaller(address common.Address, caller bind.ContractCaller) (*IERC1155MetadataURICaller, error) {
-	contract, err := bindIERC1155MetadataURI(address, caller, nil, nil)
+	contract, err := bindIERC1155MetadataURI(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4781,7 +4781,7 @@ func NewIERC1155MetadataURICaller(address common.Address, caller bind.ContractCa
 
 // NewIERC1155MetadataURITransactor creates a new write-only instance of IERC1155MetadataURI, bound to a specific deployed contract.
 func NewIERC1155MetadataURITransactor(address common.Address, transactor bind.ContractTransactor) (*IERC1155MetadataURITransactor, error) {
-	contract, err := bindIERC1155MetadataURI(address, nil, transactor, nil)
+	contract, err := bindIERC1155MetadataURI(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -4789,8 +4789,8 @@ func NewIERC1155MetadataURITransactor(address common.Address, transactor bind.Co
 }
 
 // NewIERC1155MetadataURIFilterer creates a new log filterer instance of IERC1155MetadataURI, bound to a specific deployed contract.
-func NewIERC1155MetadataURIFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC1155MetadataURIFilterer, error) {
-	contract, err := bindIERC1155MetadataURI(address, nil, nil, filterer)
+func NewIERC1155MetadataURIFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*IERC1155MetadataURIFilterer, error) {
+	contract, err := bindIERC1155MetadataURI(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -4798,12 +4798,12 @@ func NewIERC1155MetadataURIFilterer(address common.Address, filterer bind.Contra
 }
 
 // bindIERC1155MetadataURI binds a generic wrapper to an already deployed contract.
-func bindIERC1155MetadataURI(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindIERC1155MetadataURI(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(IERC1155MetadataURIABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -5768,7 +5768,7 @@ type IERC1155ReceiverTransactorRaw struct {
 
 // NewIERC1155Receiver creates a new instance of IERC1155Receiver, bound to a specific deployed contract.
 func NewIERC1155Receiver(address common.Address, backend bind.ContractBackend) (*IERC1155Receiver, error) {
-	contract, err := bindIERC1155Receiver(address, backend, backend, backend)
+	contract, err := bindIERC1155Receiver(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -5777,7 +5777,7 @@ func NewIERC1155Receiver(address common.Address, backend bind.ContractBackend) (
 
 // NewIERC1155ReceiverCaller creates a new read-only instance of IERC1155Receiver, bound to a specific deployed contract.
 func NewIERC1155ReceiverCaller(address common.Address, caller bind.ContractCaller) (*IERC1155ReceiverCaller, error) {
-	contract, err := bindIERC1155Receiver(address, caller, nil, nil)
+	contract, err := bindIERC1155Receiver(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5786,7 +5786,7 @@ func NewIERC1155ReceiverCaller(address common.Address, caller bind.ContractCalle
 
 // NewIERC1155ReceiverTransactor creates a new write-only instance of IERC1155Receiver, bound to a specific deployed contract.
 func NewIERC1155ReceiverTransactor(address common.Address, transactor bind.ContractTransactor) (*IERC1155ReceiverTransactor, error) {
-	contract, err := bindIERC1155Receiver(address, nil, transactor, nil)
+	contract, err := bindIERC1155Receiver(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5794,8 +5794,8 @@ func NewIERC1155ReceiverTransactor(address common.Address, transactor bind.Contr
 }
 
 // NewIERC1155ReceiverFilterer creates a new log filterer instance of IERC1155Receiver, bound to a specific deployed contract.
-func NewIERC1155ReceiverFilterer(address common.Address, filterer bind.ContractFilterer) (*IERC1155ReceiverFilterer, error) {
-	contract, err := bindIERC1155Receiver(address, nil, nil, filterer)
+func NewIERC1155ReceiverFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*IERC1155ReceiverFilterer, error) {
+	contract, err := bindIERC1155Receiver(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -5803,12 +5803,12 @@ func NewIERC1155ReceiverFilterer(address common.Address, filterer bind.ContractF
 }
 
 // bindIERC1155Receiver binds a generic wrapper to an already deployed contract.
-func bindIERC1155Receiver(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindIERC1155Receiver(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(IERC1155ReceiverABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -5999,7 +5999,7 @@ type IERC165TransactorRaw struct {
 
 // NewIERC165 creates a new instance of IERC165, bound to a specific deployed contract.
 func NewIERC165(address common.Address, backend bind.ContractBackend) (*IERC165, error) {
-	contract, err := bindIERC165(address, backend, backend, backend)
+	contract, err := bindIERC165(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -6008,7 +6008,7 @@ func NewIERC165(address common.Address, backend bind.ContractBackend) (*IERC165,
 
 // NewIERC165Caller creates a new read-only instance of IERC165, bound to a specific deployed contract.
 func NewIERC165Caller(address common.Address, caller bind.ContractCaller) (*IERC165Caller, error) {
-	contract, err := bindIERC165(address, caller, nil, nil)
+	contract, err := bindIERC165(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6017,7 +6017,7 @@ func NewIERC165Caller(address common.Address, caller bind.ContractCaller) (*IERC
 
 // NewIERC165Transactor creates a new write-only instance of IERC165, bound to a specific deployed contract.
 func NewIERC165Transactor(address common.Address, transactor bind.ContractTransactor) (*IERC165Transactor, error) {
-	contract, err := bindIERC165(address, nil, transactor, nil)
+	contract, err := bindIERC165(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6025,8 +6025,8 @@ func NewIERC165Transactor(address common.Address, transactor bind.ContractTransa
 }
 
 // NewIERC165Filterer creates a new log filterer instance of IERC165, bound to a specific deployed contract.
-func NewIERC165Filterer(address common.Address, filterer bind.ContractFilterer) (*IERC165Filterer, error) {
-	contract, err := bindIERC165(address, nil, nil, filterer)
+func NewIERC165Filterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*IERC165Filterer, error) {
+	contract, err := bindIERC165(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -6034,12 +6034,12 @@ func NewIERC165Filterer(address common.Address, filterer bind.ContractFilterer)
 }
 
 // bindIERC165 binds a generic wrapper to an already deployed contract.
-func bindIERC165(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindIERC165(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(IERC165ABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -6190,7 +6190,7 @@ type OwnableTransactorRaw struct {
 
 // NewOwnable creates a new instance of Ownable, bound to a specific deployed contract.
 func NewOwnable(address common.Address, backend bind.ContractBackend) (*Ownable, error) {
-	contract, err := bindOwnable(address, backend, backend, backend)
+	contract, err := bindOwnable(address, false, backend, backend, backend)
 	if err != nil {
 		return nil, err
 	}
@@ -6199,7 +6199,7 @@ func NewOwnable(address common.Address, backend bind.ContractBackend) (*Ownable,
 
 // NewOwnableCaller creates a new read-only instance of Ownable, bound to a specific deployed contract.
 func NewOwnableCaller(address common.Address, caller bind.ContractCaller) (*OwnableCaller, error) {
-	contract, err := bindOwnable(address, caller, nil, nil)
+	contract, err := bindOwnable(address, false, caller, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6208,7 +6208,7 @@ func NewOwnableCaller(address common.Address, caller bind.ContractCaller) (*Owna
 
 // NewOwnableTransactor creates a new write-only instance of Ownable, bound to a specific deployed contract.
 func NewOwnableTransactor(address common.Address, transactor bind.ContractTransactor) (*OwnableTransactor, error) {
-	contract, err := bindOwnable(address, nil, transactor, nil)
+	contract, err := bindOwnable(address, false, nil, transactor, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -6216,8 +6216,8 @@ func NewOwnableTransactor(address common.Address, transactor bind.ContractTransa
 }
 
 // NewOwnableFilterer creates a new log filterer instance of Ownable, bound to a specific deployed contract.
-func NewOwnableFilterer(address common.Address, filterer bind.ContractFilterer) (*OwnableFilterer, error) {
-	contract, err := bindOwnable(address, nil, nil, filterer)
+func NewOwnableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*OwnableFilterer, error) {
+	contract, err := bindOwnable(address, fuzzyAddress, nil, nil, filterer)
 	if err != nil {
 		return nil, err
 	}
@@ -6225,12 +6225,12 @@ func NewOwnableFilterer(address common.Address, filterer bind.ContractFilterer)
 }
 
 // bindOwnable binds a generic wrapper to an already deployed contract.
-func bindOwnable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+func bindOwnable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
 	parsed, err := abi.JSON(strings.NewReader(OwnableABI))
 	if err != nil {
 		return nil, err
 	}
-	return bind.NewBoundContract(address, false, parsed, caller, transactor, filterer), nil
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
 }
 
 // Call invokes the (constant) contract method with params as input values and
@@ -6517,7 +6517,7 @@ var StandardERC1155MetaData = &bind.MetaData{
 		"f2fde38b": "transferOwnership(address)",
 		"0e89341c": "uri(uint256)",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b5060405162001ff438038062001ff4833981016040819052620000349162000171565b8062000040816200005c565b50620000556200004f62000075565b62000079565b5062000293565b805162000071906002906020840190620000cb565b5050565b3390565b600480546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b828054620000d99062000240565b90600052602060002090601f016020900481019282620000fd576000855562000148565b82601f106200011857805160ff191683800117855562000148565b8280016001018555821562000148579182015b82811115620001485782518255916020019190600101906200012b565b50620001569291506200015a565b5090565b5b808211156200015657600081556001016200015b565b6000602080838503121562000184578182fd5b82516001600160401b03808211156200019b578384fd5b818501915085601f830112620001af578384fd5b815181811115620001c457620001c46200027d565b604051601f8201601f1916810185018381118282101715620001ea57620001ea6200027d565b604052818152838201850188101562000201578586fd5b8592505b8183101562000224578383018501518184018601529184019162000205565b818311156200023557858583830101525b979650505050505050565b6002810460018216806200025557607f821691505b602082108114156200027757634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b611d5180620002a36000396000f3fe608060405234801561001057600080fd5b50600436106100f45760003560e01c8063715018a611610097578063e985e9c511610066578063e985e9c514610200578063f242432a14610213578063f2fde38b14610226578063f5298aca14610239576100f4565b8063715018a6146101bd5780638da5cb5b146101c5578063a22cb465146101da578063bd85b039146101ed576100f4565b80632eb2c2d6116100d35780632eb2c2d6146101625780634e1273f4146101775780634f558e79146101975780636b20c454146101aa576100f4565b8062fdd58e146100f957806301ffc9a7146101225780630e89341c14610142575b600080fd5b61010c610107366004611453565b61024c565b6040516101199190611b44565b60405180910390f35b61013561013036600461156c565b6102a3565b6040516101199190611739565b6101556101503660046115a4565b6102eb565b6040516101199190611744565b61017561017036600461129f565b61037f565b005b61018a6101853660046114ae565b6103dd565b60405161011991906116f8565b6101356101a53660046115a4565b6104fd565b6101756101b83660046113a8565b610510565b61017561056a565b6101cd6105b5565b6040516101199190611641565b6101756101e8366004611419565b6105c5565b61010c6101fb3660046115a4565b610693565b61013561020e36600461126d565b6106a5565b610175610221366004611345565b6106d3565b61017561023436600461124c565b61072a565b61017561024736600461147c565b61079b565b60006001600160a01b03831661027d5760405162461bcd60e51b8152600401610274906117f3565b60405180910390fd5b506000908152602081815260408083206001600160a01b03949094168352929052205490565b60006001600160e01b03198216636cdb3d1360e11b14806102d457506001600160e01b031982166303a24d0760e21b145b806102e357506102e3826107f0565b90505b919050565b6060600280546102fa90611bd8565b80601f016020809104026020016040519081016040528092919081815260200182805461032690611bd8565b80156103735780601f1061034857610100808354040283529160200191610373565b820191906000526020600020905b81548152906001019060200180831161035657829003601f168201915b50505050509050919050565b610387610809565b6001600160a01b0316856001600160a01b031614806103ad57506103ad8561020e610809565b6103c95760405162461bcd60e51b815260040161027490611956565b6103d6858585858561080d565b5050505050565b606081518351146104005760405162461bcd60e51b815260040161027490611ab3565b6000835167ffffffffffffffff81111561042a57634e487b7160e01b600052604160045260246000fd5b604051908082528060200260200182016040528015610453578160200160208202803683370190505b50905060005b84518110156104f5576104ba85828151811061048557634e487b7160e01b600052603260045260246000fd5b60200260200101518583815181106104ad57634e487b7160e01b600052603260045260246000fd5b602002602001015161024c565b8282815181106104da57634e487b7160e01b600052603260045260246000fd5b60209081029190910101526104ee81611c13565b9050610459565b509392505050565b60008061050983610693565b1192915050565b610518610809565b6001600160a01b0316836001600160a01b0316148061053e575061053e8361020e610809565b61055a5760405162461bcd60e51b8152600401610274906118c8565b6105658383836109de565b505050565b610572610809565b6001600160a01b03166105836105b5565b6001600160a01b0316146105a95760405162461bcd60e51b815260040161027490611a35565b6105b360006109e9565b565b6004546001600160a01b03165b90565b816001600160a01b03166105d7610809565b6001600160a01b031614156105fe5760405162461bcd60e51b815260040161027490611a6a565b806001600061060b610809565b6001600160a01b03908116825260208083019390935260409182016000908120918716808252919093529120805460ff19169215159290921790915561064f610809565b6001600160a01b03167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31836040516106879190611739565b60405180910390a35050565b60009081526003602052604090205490565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205460ff1690565b6106db610809565b6001600160a01b0316856001600160a01b0316148061070157506107018561020e610809565b61071d5760405162461bcd60e51b8152600401610274906118c8565b6103d68585858585610a3b565b610732610809565b6001600160a01b03166107436105b5565b6001600160a01b0316146107695760405162461bcd60e51b815260040161027490611a35565b6001600160a01b03811661078f5760405162461bcd60e51b81526004016102749061183e565b610798816109e9565b50565b6107a3610809565b6001600160a01b0316836001600160a01b031614806107c957506107c98361020e610809565b6107e55760405162461bcd60e51b8152600401610274906118c8565b610565838383610b7e565b6001600160e01b031981166301ffc9a760e01b14919050565b3390565b815183511461082e5760405162461bcd60e51b815260040161027490611afc565b6001600160a01b0384166108545760405162461bcd60e51b815260040161027490611911565b600061085e610809565b905061086e8187878787876109d6565b60005b845181101561097057600085828151811061089c57634e487b7160e01b600052603260045260246000fd5b6020026020010151905060008583815181106108c857634e487b7160e01b600052603260045260246000fd5b602090810291909101810151600084815280835260408082206001600160a01b038e1683529093529190912054909150818110156109185760405162461bcd60e51b8152600401610274906119eb565b6000838152602081815260408083206001600160a01b038e8116855292528083208585039055908b16825281208054849290610955908490611ba9565b925050819055505050508061096990611c13565b9050610871565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb87876040516109c092919061170b565b60405180910390a46109d6818787878787610b89565b505050505050565b610565838383610c97565b600480546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6001600160a01b038416610a615760405162461bcd60e51b815260040161027490611911565b6000610a6b610809565b9050610a8b818787610a7c88610d3b565b610a8588610d3b565b876109d6565b6000848152602081815260408083206001600160a01b038a16845290915290205483811015610acc5760405162461bcd60e51b8152600401610274906119eb565b6000858152602081815260408083206001600160a01b038b8116855292528083208785039055908816825281208054869290610b09908490611ba9565b92505081905550856001600160a01b0316876001600160a01b0316836001600160a01b03167fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f628888604051610b5f929190611b4d565b60405180910390a4610b75828888888888610d94565b50505050505050565b610565838383610e65565b610b9b846001600160a01b0316610e98565b156109d65760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610bd49089908990889088908890600401611655565b602060405180830381600087803b158015610bee57600080fd5b505af1925050508015610c1e575060408051601f3d908101601f19168201909252610c1b91810190611588565b60015b610c6757610c2a611c60565b80610c355750610c4f565b8060405162461bcd60e51b81526004016102749190611744565b60405162461bcd60e51b815260040161027490611757565b6001600160e01b0319811663bc197c8160e01b14610b755760405162461bcd60e51b8152600401610274906117ab565b610ca2838383610e9e565b60005b8251811015610d3557818181518110610cce57634e487b7160e01b600052603260045260246000fd5b602002602001015160036000858481518110610cfa57634e487b7160e01b600052603260045260246000fd5b602002602001015181526020019081526020016000206000828254610d1f9190611bc1565b90915550610d2e905081611c13565b9050610ca5565b50505050565b60408051600180825281830190925260609160009190602080830190803683370190505090508281600081518110610d8357634e487b7160e01b600052603260045260246000fd5b602090810291909101015292915050565b610da6846001600160a01b0316610e98565b156109d65760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610ddf90899089908890889088906004016116b3565b602060405180830381600087803b158015610df957600080fd5b505af1925050508015610e29575060408051601f3d908101601f19168201909252610e2691810190611588565b60015b610e3557610c2a611c60565b6001600160e01b0319811663f23a6e6160e01b14610b755760405162461bcd60e51b8152600401610274906117ab565b610e7083838361104f565b60008281526003602052604081208054839290610e8e908490611bc1565b9091555050505050565b3b151590565b6001600160a01b038316610ec45760405162461bcd60e51b8152600401610274906119a8565b8051825114610ee55760405162461bcd60e51b815260040161027490611afc565b6000610eef610809565b9050610f0f818560008686604051806020016040528060008152506109d6565b60005b8351811015610ff0576000848281518110610f3d57634e487b7160e01b600052603260045260246000fd5b602002602001015190506000848381518110610f6957634e487b7160e01b600052603260045260246000fd5b602090810291909101810151600084815280835260408082206001600160a01b038c168352909352919091205490915081811015610fb95760405162461bcd60e51b815260040161027490611884565b6000928352602083815260408085206001600160a01b038b1686529091529092209103905580610fe881611c13565b915050610f12565b5060006001600160a01b0316846001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb868660405161104192919061170b565b60405180910390a450505050565b6001600160a01b0383166110755760405162461bcd60e51b8152600401610274906119a8565b600061107f610809565b90506110af8185600061109187610d3b565b61109a87610d3b565b604051806020016040528060008152506109d6565b6000838152602081815260408083206001600160a01b0388168452909152902054828110156110f05760405162461bcd60e51b815260040161027490611884565b6000848152602081815260408083206001600160a01b03808a16808652919093528184208786039055905190918516907fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f629061114f9089908990611b4d565b60405180910390a45050505050565b80356001600160a01b03811681146102e657600080fd5b600082601f830112611185578081fd5b8135602061119a61119583611b85565b611b5b565b82815281810190858301838502870184018810156111b6578586fd5b855b858110156111d4578135845292840192908401906001016111b8565b5090979650505050505050565b600082601f8301126111f1578081fd5b813567ffffffffffffffff81111561120b5761120b611c44565b61121e601f8201601f1916602001611b5b565b818152846020838601011115611232578283fd5b816020850160208301379081016020019190915292915050565b60006020828403121561125d578081fd5b6112668261115e565b9392505050565b6000806040838503121561127f578081fd5b6112888361115e565b91506112966020840161115e565b90509250929050565b600080600080600060a086880312156112b6578081fd5b6112bf8661115e565b94506112cd6020870161115e565b9350604086013567ffffffffffffffff808211156112e9578283fd5b6112f589838a01611175565b9450606088013591508082111561130a578283fd5b61131689838a01611175565b9350608088013591508082111561132b578283fd5b50611338888289016111e1565b9150509295509295909350565b600080600080600060a0868803121561135c578081fd5b6113658661115e565b94506113736020870161115e565b93506040860135925060608601359150608086013567ffffffffffffffff81111561139c578182fd5b611338888289016111e1565b6000806000606084860312156113bc578283fd5b6113c58461115e565b9250602084013567ffffffffffffffff808211156113e1578384fd5b6113ed87838801611175565b93506040860135915080821115611402578283fd5b5061140f86828701611175565b9150509250925092565b6000806040838503121561142b578182fd5b6114348361115e565b915060208301358015158114611448578182fd5b809150509250929050565b60008060408385031215611465578182fd5b61146e8361115e565b946020939093013593505050565b600080600060608486031215611490578283fd5b6114998461115e565b95602085013595506040909401359392505050565b600080604083850312156114c0578182fd5b823567ffffffffffffffff808211156114d7578384fd5b818501915085601f8301126114ea578384fd5b813560206114fa61119583611b85565b82815281810190858301838502870184018b1015611516578889fd5b8896505b8487101561153f5761152b8161115e565b83526001969096019591830191830161151a565b5096505086013592505080821115611555578283fd5b5061156285828601611175565b9150509250929050565b60006020828403121561157d578081fd5b813561126681611d05565b600060208284031215611599578081fd5b815161126681611d05565b6000602082840312156115b5578081fd5b5035919050565b6000815180845260208085019450808401835b838110156115eb578151875295820195908201906001016115cf565b509495945050505050565b60008151808452815b8181101561161b576020818501810151868301820152016115ff565b8181111561162c5782602083870101525b50601f01601f19169290920160200192915050565b6001600160a01b0391909116815260200190565b6001600160a01b0386811682528516602082015260a060408201819052600090611681908301866115bc565b828103606084015261169381866115bc565b905082810360808401526116a781856115f6565b98975050505050505050565b6001600160a01b03868116825285166020820152604081018490526060810183905260a0608082018190526000906116ed908301846115f6565b979650505050505050565b60006020825261126660208301846115bc565b60006040825261171e60408301856115bc565b828103602084015261173081856115bc565b95945050505050565b901515815260200190565b60006020825261126660208301846115f6565b60208082526034908201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356040820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b606082015260800190565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b6020808252602b908201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60408201526a65726f206164647265737360a81b606082015260800190565b60208082526026908201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160408201526564647265737360d01b606082015260800190565b60208082526024908201527f455243313135353a206275726e20616d6f756e7420657863656564732062616c604082015263616e636560e01b606082015260800190565b60208082526029908201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260408201526808185c1c1c9bdd995960ba1b606082015260800190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b60208082526032908201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206040820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b606082015260800190565b60208082526023908201527f455243313135353a206275726e2066726f6d20746865207a65726f206164647260408201526265737360e81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526029908201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604082015268103337b91039b2b63360b91b606082015260800190565b60208082526029908201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604082015268040dad2e6dac2e8c6d60bb1b606082015260800190565b60208082526028908201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206040820152670dad2e6dac2e8c6d60c31b606082015260800190565b90815260200190565b918252602082015260400190565b60405181810167ffffffffffffffff81118282101715611b7d57611b7d611c44565b604052919050565b600067ffffffffffffffff821115611b9f57611b9f611c44565b5060209081020190565b60008219821115611bbc57611bbc611c2e565b500190565b600082821015611bd357611bd3611c2e565b500390565b600281046001821680611bec57607f821691505b60208210811415611c0d57634e487b7160e01b600052602260045260246000fd5b50919050565b6000600019821415611c2757611c27611c2e565b5060010190565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052604160045260246000fd5b60e01c90565b600060443d1015611c70576105c2565b600481823e6308c379a0611c848251611c5a565b14611c8e576105c2565b6040513d600319016004823e80513d67ffffffffffffffff8160248401118184111715611cbe57505050506105c2565b82840192508251915080821115611cd857505050506105c2565b503d83016020828401011115611cf0575050506105c2565b601f01601f1916810160200160405291505090565b6001600160e01b03198116811461079857600080fdfea2646970667358221220af81744245c970ac37b20a166c09a59bf009344dcf3bb2891b66a36f99f2e33664736f6c63430008000033",
+	Bin: "0x60806040523480156200001157600080fd5b5060405162001e7338038062001e738339810160408190526200003491620000cd565b80620000408162000053565b506200004c3362000065565b50620002fd565b600262000061828262000231565b5050565b600480546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b634e487b7160e01b600052604160045260246000fd5b60006020808385031215620000e157600080fd5b82516001600160401b0380821115620000f957600080fd5b818501915085601f8301126200010e57600080fd5b815181811115620001235762000123620000b7565b604051601f8201601f19908116603f011681019083821181831017156200014e576200014e620000b7565b8160405282815288868487010111156200016757600080fd5b600093505b828410156200018b57848401860151818501870152928501926200016c565b600086848301015280965050505050505092915050565b600181811c90821680620001b757607f821691505b602082108103620001d857634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200022c57600081815260208120601f850160051c81016020861015620002075750805b601f850160051c820191505b81811015620002285782815560010162000213565b5050505b505050565b81516001600160401b038111156200024d576200024d620000b7565b62000265816200025e8454620001a2565b84620001de565b602080601f8311600181146200029d5760008415620002845750858301515b600019600386901b1c1916600185901b17855562000228565b600085815260208120601f198616915b82811015620002ce57888601518255948401946001909101908401620002ad565b5085821015620002ed5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b611b66806200030d6000396000f3fe608060405234801561001057600080fd5b50600436106100f45760003560e01c8063715018a611610097578063e985e9c511610066578063e985e9c514610222578063f242432a1461025e578063f2fde38b14610271578063f5298aca1461028457600080fd5b8063715018a6146101cc5780638da5cb5b146101d4578063a22cb465146101ef578063bd85b0391461020257600080fd5b80632eb2c2d6116100d35780632eb2c2d6146101625780634e1273f4146101775780634f558e79146101975780636b20c454146101b957600080fd5b8062fdd58e146100f957806301ffc9a71461011f5780630e89341c14610142575b600080fd5b61010c61010736600461118d565b610297565b6040519081526020015b60405180910390f35b61013261012d3660046111cd565b610331565b6040519015158152602001610116565b6101556101503660046111f1565b610381565b6040516101169190611250565b6101756101703660046113af565b610415565b005b61018a610185366004611459565b6104ac565b604051610116919061155f565b6101326101a53660046111f1565b600090815260036020526040902054151590565b6101756101c7366004611572565b6105d6565b61017561061e565b6004546040516001600160a01b039091168152602001610116565b6101756101fd3660046115e6565b610684565b61010c6102103660046111f1565b60009081526003602052604090205490565b610132610230366004611622565b6001600160a01b03918216600090815260016020908152604080832093909416825291909152205460ff1690565b61017561026c366004611655565b61075a565b61017561027f3660046116ba565b61079f565b6101756102923660046116d5565b61086a565b60006001600160a01b0383166103085760405162461bcd60e51b815260206004820152602b60248201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60448201526a65726f206164647265737360a81b60648201526084015b60405180910390fd5b506000818152602081815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b148061036257506001600160e01b031982166303a24d0760e21b145b8061032b57506301ffc9a760e01b6001600160e01b031983161461032b565b60606002805461039090611708565b80601f01602080910402602001604051908101604052809291908181526020018280546103bc90611708565b80156104095780601f106103de57610100808354040283529160200191610409565b820191906000526020600020905b8154815290600101906020018083116103ec57829003601f168201915b50505050509050919050565b6001600160a01b03851633148061043157506104318533610230565b6104985760405162461bcd60e51b815260206004820152603260248201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206044820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b60648201526084016102ff565b6104a585858585856108ad565b5050505050565b606081518351146105115760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b60648201526084016102ff565b6000835167ffffffffffffffff81111561052d5761052d611263565b604051908082528060200260200182016040528015610556578160200160208202803683370190505b50905060005b84518110156105ce576105a185828151811061057a5761057a611742565b602002602001015185838151811061059457610594611742565b6020026020010151610297565b8282815181106105b3576105b3611742565b60209081029190910101526105c78161176e565b905061055c565b509392505050565b6001600160a01b0383163314806105f257506105f28333610230565b61060e5760405162461bcd60e51b81526004016102ff90611787565b610619838383610a49565b505050565b6004546001600160a01b031633146106785760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016102ff565b6106826000610a54565b565b6001600160a01b03821633036106ee5760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b60648201526084016102ff565b3360008181526001602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6001600160a01b03851633148061077657506107768533610230565b6107925760405162461bcd60e51b81526004016102ff90611787565b6104a58585858585610aa6565b6004546001600160a01b031633146107f95760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016102ff565b6001600160a01b03811661085e5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016102ff565b61086781610a54565b50565b6001600160a01b03831633148061088657506108868333610230565b6108a25760405162461bcd60e51b81526004016102ff90611787565b610619838383610bcc565b81518351146108ce5760405162461bcd60e51b81526004016102ff906117d0565b6001600160a01b0384166108f45760405162461bcd60e51b81526004016102ff90611818565b3360005b84518110156109db57600085828151811061091557610915611742565b60200260200101519050600085838151811061093357610933611742565b602090810291909101810151600084815280835260408082206001600160a01b038e1683529093529190912054909150818110156109835760405162461bcd60e51b81526004016102ff9061185d565b6000838152602081815260408083206001600160a01b038e8116855292528083208585039055908b168252812080548492906109c09084906118a7565b92505081905550505050806109d49061176e565b90506108f8565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610a2b9291906118ba565b60405180910390a4610a41818787878787610bd7565b505050505050565b610619838383610d32565b600480546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b6001600160a01b038416610acc5760405162461bcd60e51b81526004016102ff90611818565b33610ae5818787610adc88610dba565b6104a588610dba565b6000848152602081815260408083206001600160a01b038a16845290915290205483811015610b265760405162461bcd60e51b81526004016102ff9061185d565b6000858152602081815260408083206001600160a01b038b8116855292528083208785039055908816825281208054869290610b639084906118a7565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a4610bc3828888888888610e05565b50505050505050565b610619838383610ec0565b6001600160a01b0384163b15610a415760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610c1b90899089908890889088906004016118e8565b6020604051808303816000875af1925050508015610c56575060408051601f3d908101601f19168201909252610c5391810190611946565b60015b610d0257610c62611963565b806308c379a003610c9b5750610c7661197f565b80610c815750610c9d565b8060405162461bcd60e51b81526004016102ff9190611250565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b60648201526084016102ff565b6001600160e01b0319811663bc197c8160e01b14610bc35760405162461bcd60e51b81526004016102ff90611a09565b610d3d838383610ef3565b60005b8251811015610db457818181518110610d5b57610d5b611742565b602002602001015160036000858481518110610d7957610d79611742565b602002602001015181526020019081526020016000206000828254610d9e9190611a51565b90915550610dad90508161176e565b9050610d40565b50505050565b60408051600180825281830190925260609160009190602080830190803683370190505090508281600081518110610df457610df4611742565b602090810291909101015292915050565b6001600160a01b0384163b15610a415760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e6190610e499089908990889088908890600401611a64565b6020604051808303816000875af1925050508015610e84575060408051601f3d908101601f19168201909252610e8191810190611946565b60015b610e9057610c62611963565b6001600160e01b0319811663f23a6e6160e01b14610bc35760405162461bcd60e51b81526004016102ff90611a09565b610ecb83838361106f565b60008281526003602052604081208054839290610ee9908490611a51565b9091555050505050565b6001600160a01b038316610f195760405162461bcd60e51b81526004016102ff90611aa9565b8051825114610f3a5760405162461bcd60e51b81526004016102ff906117d0565b604080516020810190915260009081905233905b8351811015611010576000848281518110610f6b57610f6b611742565b602002602001015190506000848381518110610f8957610f89611742565b602090810291909101810151600084815280835260408082206001600160a01b038c168352909352919091205490915081811015610fd95760405162461bcd60e51b81526004016102ff90611aec565b6000928352602083815260408085206001600160a01b038b16865290915290922091039055806110088161176e565b915050610f4e565b5060006001600160a01b0316846001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb86866040516110619291906118ba565b60405180910390a450505050565b6001600160a01b0383166110955760405162461bcd60e51b81526004016102ff90611aa9565b336110c5818560006110a687610dba565b6110af87610dba565b5050604080516020810190915260009052505050565b6000838152602081815260408083206001600160a01b0388168452909152902054828110156111065760405162461bcd60e51b81526004016102ff90611aec565b6000848152602081815260408083206001600160a01b03898116808652918452828520888703905582518981529384018890529092908616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a45050505050565b80356001600160a01b038116811461118857600080fd5b919050565b600080604083850312156111a057600080fd5b6111a983611171565b946020939093013593505050565b6001600160e01b03198116811461086757600080fd5b6000602082840312156111df57600080fd5b81356111ea816111b7565b9392505050565b60006020828403121561120357600080fd5b5035919050565b6000815180845260005b8181101561123057602081850181015186830182015201611214565b506000602082860101526020601f19601f83011685010191505092915050565b6020815260006111ea602083018461120a565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff8111828210171561129f5761129f611263565b6040525050565b600067ffffffffffffffff8211156112c0576112c0611263565b5060051b60200190565b600082601f8301126112db57600080fd5b813560206112e8826112a6565b6040516112f58282611279565b83815260059390931b850182019282810191508684111561131557600080fd5b8286015b848110156113305780358352918301918301611319565b509695505050505050565b600082601f83011261134c57600080fd5b813567ffffffffffffffff81111561136657611366611263565b60405161137d601f8301601f191660200182611279565b81815284602083860101111561139257600080fd5b816020850160208301376000918101602001919091529392505050565b600080600080600060a086880312156113c757600080fd5b6113d086611171565b94506113de60208701611171565b9350604086013567ffffffffffffffff808211156113fb57600080fd5b61140789838a016112ca565b9450606088013591508082111561141d57600080fd5b61142989838a016112ca565b9350608088013591508082111561143f57600080fd5b5061144c8882890161133b565b9150509295509295909350565b6000806040838503121561146c57600080fd5b823567ffffffffffffffff8082111561148457600080fd5b818501915085601f83011261149857600080fd5b813560206114a5826112a6565b6040516114b28282611279565b83815260059390931b85018201928281019150898411156114d257600080fd5b948201945b838610156114f7576114e886611171565b825294820194908201906114d7565b9650508601359250508082111561150d57600080fd5b5061151a858286016112ca565b9150509250929050565b600081518084526020808501945080840160005b8381101561155457815187529582019590820190600101611538565b509495945050505050565b6020815260006111ea6020830184611524565b60008060006060848603121561158757600080fd5b61159084611171565b9250602084013567ffffffffffffffff808211156115ad57600080fd5b6115b9878388016112ca565b935060408601359150808211156115cf57600080fd5b506115dc868287016112ca565b9150509250925092565b600080604083850312156115f957600080fd5b61160283611171565b91506020830135801515811461161757600080fd5b809150509250929050565b6000806040838503121561163557600080fd5b61163e83611171565b915061164c60208401611171565b90509250929050565b600080600080600060a0868803121561166d57600080fd5b61167686611171565b945061168460208701611171565b93506040860135925060608601359150608086013567ffffffffffffffff8111156116ae57600080fd5b61144c8882890161133b565b6000602082840312156116cc57600080fd5b6111ea82611171565b6000806000606084860312156116ea57600080fd5b6116f384611171565b95602085013595506040909401359392505050565b600181811c9082168061171c57607f821691505b60208210810361173c57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161178057611780611758565b5060010190565b60208082526029908201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260408201526808185c1c1c9bdd995960ba1b606082015260800190565b60208082526028908201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206040820152670dad2e6dac2e8c6d60c31b606082015260800190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b8082018082111561032b5761032b611758565b6040815260006118cd6040830185611524565b82810360208401526118df8185611524565b95945050505050565b6001600160a01b0386811682528516602082015260a06040820181905260009061191490830186611524565b82810360608401526119268186611524565b9050828103608084015261193a818561120a565b98975050505050505050565b60006020828403121561195857600080fd5b81516111ea816111b7565b600060033d111561197c5760046000803e5060005160e01c5b90565b600060443d101561198d5790565b6040516003193d81016004833e81513d67ffffffffffffffff81602484011181841117156119bd57505050505090565b82850191508151818111156119d55750505050505090565b843d87010160208285010111156119ef5750505050505090565b6119fe60208286010187611279565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b8181038181111561032b5761032b611758565b6001600160a01b03868116825285166020820152604081018490526060810183905260a060808201819052600090611a9e9083018461120a565b979650505050505050565b60208082526023908201527f455243313135353a206275726e2066726f6d20746865207a65726f206164647260408201526265737360e81b606082015260800190565b60208082526024908201527f455243313135353a206275726e20616d6f756e7420657863656564732062616c604082015263616e636560e01b60608201526080019056fea2646970667358221220c1553bb1aa911fa08d1c7b2085f18b6e59db657699b560587439bb4cee8d47d964736f6c63430008150033",
 }
 
 // StandardERC1155ABI is the input ABI used to generate the binding from.
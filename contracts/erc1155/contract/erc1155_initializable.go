@@ -0,0 +1,3278 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package erc1155
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// StandardERC1155InitializableMetaData contains all meta data concerning the StandardERC1155Initializable contract.
+var StandardERC1155InitializableMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"URI\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"}],\"name\":\"balanceOfBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"burnBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"uri\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"00fdd58e": "balanceOf(address,uint256)",
+		"4e1273f4": "balanceOfBatch(address[],uint256[])",
+		"f5298aca": "burn(address,uint256,uint256)",
+		"6b20c454": "burnBatch(address,uint256[],uint256[])",
+		"4f558e79": "exists(uint256)",
+		"7ab4339d": "initialize(string,address)",
+		"e985e9c5": "isApprovedForAll(address,address)",
+		"8da5cb5b": "owner()",
+		"715018a6": "renounceOwnership()",
+		"2eb2c2d6": "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+		"f242432a": "safeTransferFrom(address,address,uint256,uint256,bytes)",
+		"a22cb465": "setApprovalForAll(address,bool)",
+		"01ffc9a7": "supportsInterface(bytes4)",
+		"bd85b039": "totalSupply(uint256)",
+		"f2fde38b": "transferOwnership(address)",
+		"0e89341c": "uri(uint256)",
+	},
+	Bin: "0x608060405234801561001057600080fd5b50600054610100900460ff168061002a575060005460ff16155b6100915760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b606482015260840160405180910390fd5b600054610100900460ff161580156100b3576000805461ffff19166101011790555b80156100c5576000805461ff00191690555b50611fa1806100d56000396000f3fe608060405234801561001057600080fd5b50600436106100ff5760003560e01c80637ab4339d11610097578063e985e9c511610066578063e985e9c514610240578063f242432a1461027c578063f2fde38b1461028f578063f5298aca146102a257600080fd5b80637ab4339d146101df5780638da5cb5b146101f2578063a22cb4651461020d578063bd85b0391461022057600080fd5b80634e1273f4116100d35780634e1273f4146101825780634f558e79146101a25780636b20c454146101c4578063715018a6146101d757600080fd5b8062fdd58e1461010457806301ffc9a71461012a5780630e89341c1461014d5780632eb2c2d61461016d575b600080fd5b610117610112366004611411565b6102b5565b6040519081526020015b60405180910390f35b61013d610138366004611451565b610351565b6040519015158152602001610121565b61016061015b366004611475565b6103a1565b60405161012191906114d4565b61018061017b36600461163d565b610435565b005b6101956101903660046116e7565b6104cc565b60405161012191906117ed565b61013d6101b0366004611475565b600090815260c96020526040902054151590565b6101806101d2366004611800565b6105f6565b61018061063e565b6101806101ed366004611874565b6106a4565b60fb546040516001600160a01b039091168152602001610121565b61018061021b3660046118d6565b61071b565b61011761022e366004611475565b600090815260c9602052604090205490565b61013d61024e366004611912565b6001600160a01b03918216600090815260666020908152604080832093909416825291909152205460ff1690565b61018061028a36600461193c565b6107f1565b61018061029d3660046119a1565b610836565b6101806102b03660046119bc565b610901565b60006001600160a01b0383166103265760405162461bcd60e51b815260206004820152602b60248201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60448201526a65726f206164647265737360a81b60648201526084015b60405180910390fd5b5060008181526065602090815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b148061038257506001600160e01b031982166303a24d0760e21b145b8061034b57506301ffc9a760e01b6001600160e01b031983161461034b565b6060606780546103b0906119ef565b80601f01602080910402602001604051908101604052809291908181526020018280546103dc906119ef565b80156104295780601f106103fe57610100808354040283529160200191610429565b820191906000526020600020905b81548152906001019060200180831161040c57829003601f168201915b50505050509050919050565b6001600160a01b0385163314806104515750610451853361024e565b6104b85760405162461bcd60e51b815260206004820152603260248201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206044820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b606482015260840161031d565b6104c58585858585610944565b5050505050565b606081518351146105315760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b606482015260840161031d565b6000835167ffffffffffffffff81111561054d5761054d6114e7565b604051908082528060200260200182016040528015610576578160200160208202803683370190505b50905060005b84518110156105ee576105c185828151811061059a5761059a611a29565b60200260200101518583815181106105b4576105b4611a29565b60200260200101516102b5565b8282815181106105d3576105d3611a29565b60209081029190910101526105e781611a55565b905061057c565b509392505050565b6001600160a01b0383163314806106125750610612833361024e565b61062e5760405162461bcd60e51b815260040161031d90611a6e565b610639838383610ae3565b505050565b60fb546001600160a01b031633146106985760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640161031d565b6106a26000610aee565b565b600054610100900460ff16806106bd575060005460ff16155b6106d95760405162461bcd60e51b815260040161031d90611ab7565b600054610100900460ff161580156106fb576000805461ffff19166101011790555b6107058383610b40565b8015610639576000805461ff0019169055505050565b6001600160a01b03821633036107855760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b606482015260840161031d565b3360008181526066602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6001600160a01b03851633148061080d575061080d853361024e565b6108295760405162461bcd60e51b815260040161031d90611a6e565b6104c58585858585610c32565b60fb546001600160a01b031633146108905760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604482015260640161031d565b6001600160a01b0381166108f55760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b606482015260840161031d565b6108fe81610aee565b50565b6001600160a01b03831633148061091d575061091d833361024e565b6109395760405162461bcd60e51b815260040161031d90611a6e565b610639838383610d5c565b81518351146109655760405162461bcd60e51b815260040161031d90611b05565b6001600160a01b03841661098b5760405162461bcd60e51b815260040161031d90611b4d565b3360005b8451811015610a755760008582815181106109ac576109ac611a29565b6020026020010151905060008583815181106109ca576109ca611a29565b60209081029190910181015160008481526065835260408082206001600160a01b038e168352909352919091205490915081811015610a1b5760405162461bcd60e51b815260040161031d90611b92565b60008381526065602090815260408083206001600160a01b038e8116855292528083208585039055908b16825281208054849290610a5a908490611bdc565b9250508190555050505080610a6e90611a55565b905061098f565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610ac5929190611bef565b60405180910390a4610adb818787878787610d67565b505050505050565b610639838383610ec2565b60fb80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600054610100900460ff1680610b59575060005460ff16155b610b755760405162461bcd60e51b815260040161031d90611ab7565b600054610100900460ff16158015610b97576000805461ffff19166101011790555b6001600160a01b038216610c005760405162461bcd60e51b815260206004820152602a60248201527f5374616e64617264455243313135353a206f776e657220697320746865207a65604482015269726f206164647265737360b01b606482015260840161031d565b610c08610f4a565b610c10610f4a565b610c1983610fb5565b610c21610f4a565b610c29610f4a565b61070582610aee565b6001600160a01b038416610c585760405162461bcd60e51b815260040161031d90611b4d565b33610c71818787610c688861102b565b6104c58861102b565b60008481526065602090815260408083206001600160a01b038a16845290915290205483811015610cb45760405162461bcd60e51b815260040161031d90611b92565b60008581526065602090815260408083206001600160a01b038b8116855292528083208785039055908816825281208054869290610cf3908490611bdc565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a4610d53828888888888611076565b50505050505050565b610639838383611131565b6001600160a01b0384163b15610adb5760405163bc197c8160e01b81526001600160a01b0385169063bc197c8190610dab9089908990889088908890600401611c1d565b6020604051808303816000875af1925050508015610de6575060408051601f3d908101601f19168201909252610de391810190611c7b565b60015b610e9257610df2611c98565b806308c379a003610e2b5750610e06611cb4565b80610e115750610e2d565b8060405162461bcd60e51b815260040161031d91906114d4565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b606482015260840161031d565b6001600160e01b0319811663bc197c8160e01b14610d535760405162461bcd60e51b815260040161031d90611d3e565b610ecd838383611164565b60005b8251811015610f4457818181518110610eeb57610eeb611a29565b602002602001015160c96000858481518110610f0957610f09611a29565b602002602001015181526020019081526020016000206000828254610f2e9190611d86565b90915550610f3d905081611a55565b9050610ed0565b50505050565b600054610100900460ff1680610f63575060005460ff16155b610f7f5760405162461bcd60e51b815260040161031d90611ab7565b600054610100900460ff16158015610fa1576000805461ffff19166101011790555b80156108fe576000805461ff001916905550565b600054610100900460ff1680610fce575060005460ff16155b610fea5760405162461bcd60e51b815260040161031d90611ab7565b600054610100900460ff1615801561100c576000805461ffff19166101011790555b611015826112e3565b8015611027576000805461ff00191690555b5050565b6040805160018082528183019092526060916000919060208083019080368337019050509050828160008151811061106557611065611a29565b602090810291909101015292915050565b6001600160a01b0384163b15610adb5760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e61906110ba9089908990889088908890600401611d99565b6020604051808303816000875af19250505080156110f5575060408051601f3d908101601f191682019092526110f291810190611c7b565b60015b61110157610df2611c98565b6001600160e01b0319811663f23a6e6160e01b14610d535760405162461bcd60e51b815260040161031d90611d3e565b61113c8383836112ef565b600082815260c960205260408120805483929061115a908490611d86565b9091555050505050565b6001600160a01b03831661118a5760405162461bcd60e51b815260040161031d90611dde565b80518251146111ab5760405162461bcd60e51b815260040161031d90611b05565b604080516020810190915260009081905233905b83518110156112845760008482815181106111dc576111dc611a29565b6020026020010151905060008483815181106111fa576111fa611a29565b60209081029190910181015160008481526065835260408082206001600160a01b038c16835290935291909120549091508181101561124b5760405162461bcd60e51b815260040161031d90611e21565b60009283526065602090815260408085206001600160a01b038b168652909152909220910390558061127c81611a55565b9150506111bf565b5060006001600160a01b0316846001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb86866040516112d5929190611bef565b60405180910390a450505050565b60676110278282611eab565b6001600160a01b0383166113155760405162461bcd60e51b815260040161031d90611dde565b33611345818560006113268761102b565b61132f8761102b565b5050604080516020810190915260009052505050565b60008381526065602090815260408083206001600160a01b0388168452909152902054828110156113885760405162461bcd60e51b815260040161031d90611e21565b60008481526065602090815260408083206001600160a01b03898116808652918452828520888703905582518981529384018890529092908616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a45050505050565b80356001600160a01b038116811461140c57600080fd5b919050565b6000806040838503121561142457600080fd5b61142d836113f5565b946020939093013593505050565b6001600160e01b0319811681146108fe57600080fd5b60006020828403121561146357600080fd5b813561146e8161143b565b9392505050565b60006020828403121561148757600080fd5b5035919050565b6000815180845260005b818110156114b457602081850181015186830182015201611498565b506000602082860101526020601f19601f83011685010191505092915050565b60208152600061146e602083018461148e565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff81118282101715611523576115236114e7565b6040525050565b600067ffffffffffffffff821115611544576115446114e7565b5060051b60200190565b600082601f83011261155f57600080fd5b8135602061156c8261152a565b60405161157982826114fd565b83815260059390931b850182019282810191508684111561159957600080fd5b8286015b848110156115b4578035835291830191830161159d565b509695505050505050565b600067ffffffffffffffff8311156115d9576115d96114e7565b6040516115f0601f8501601f1916602001826114fd565b80915083815284848401111561160557600080fd5b83836020830137600060208583010152509392505050565b600082601f83011261162e57600080fd5b61146e838335602085016115bf565b600080600080600060a0868803121561165557600080fd5b61165e866113f5565b945061166c602087016113f5565b9350604086013567ffffffffffffffff8082111561168957600080fd5b61169589838a0161154e565b945060608801359150808211156116ab57600080fd5b6116b789838a0161154e565b935060808801359150808211156116cd57600080fd5b506116da8882890161161d565b9150509295509295909350565b600080604083850312156116fa57600080fd5b823567ffffffffffffffff8082111561171257600080fd5b818501915085601f83011261172657600080fd5b813560206117338261152a565b60405161174082826114fd565b83815260059390931b850182019282810191508984111561176057600080fd5b948201945b8386101561178557611776866113f5565b82529482019490820190611765565b9650508601359250508082111561179b57600080fd5b506117a88582860161154e565b9150509250929050565b600081518084526020808501945080840160005b838110156117e2578151875295820195908201906001016117c6565b509495945050505050565b60208152600061146e60208301846117b2565b60008060006060848603121561181557600080fd5b61181e846113f5565b9250602084013567ffffffffffffffff8082111561183b57600080fd5b6118478783880161154e565b9350604086013591508082111561185d57600080fd5b5061186a8682870161154e565b9150509250925092565b6000806040838503121561188757600080fd5b823567ffffffffffffffff81111561189e57600080fd5b8301601f810185136118af57600080fd5b6118be858235602084016115bf565b9250506118cd602084016113f5565b90509250929050565b600080604083850312156118e957600080fd5b6118f2836113f5565b91506020830135801515811461190757600080fd5b809150509250929050565b6000806040838503121561192557600080fd5b61192e836113f5565b91506118cd602084016113f5565b600080600080600060a0868803121561195457600080fd5b61195d866113f5565b945061196b602087016113f5565b93506040860135925060608601359150608086013567ffffffffffffffff81111561199557600080fd5b6116da8882890161161d565b6000602082840312156119b357600080fd5b61146e826113f5565b6000806000606084860312156119d157600080fd5b6119da846113f5565b95602085013595506040909401359392505050565b600181811c90821680611a0357607f821691505b602082108103611a2357634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b600060018201611a6757611a67611a3f565b5060010190565b60208082526029908201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260408201526808185c1c1c9bdd995960ba1b606082015260800190565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b60208082526028908201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206040820152670dad2e6dac2e8c6d60c31b606082015260800190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b8082018082111561034b5761034b611a3f565b604081526000611c0260408301856117b2565b8281036020840152611c1481856117b2565b95945050505050565b6001600160a01b0386811682528516602082015260a060408201819052600090611c49908301866117b2565b8281036060840152611c5b81866117b2565b90508281036080840152611c6f818561148e565b98975050505050505050565b600060208284031215611c8d57600080fd5b815161146e8161143b565b600060033d1115611cb15760046000803e5060005160e01c5b90565b600060443d1015611cc25790565b6040516003193d81016004833e81513d67ffffffffffffffff8160248401118184111715611cf257505050505090565b8285019150815181811115611d0a5750505050505090565b843d8701016020828501011115611d245750505050505090565b611d33602082860101876114fd565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b8181038181111561034b5761034b611a3f565b6001600160a01b03868116825285166020820152604081018490526060810183905260a060808201819052600090611dd39083018461148e565b979650505050505050565b60208082526023908201527f455243313135353a206275726e2066726f6d20746865207a65726f206164647260408201526265737360e81b606082015260800190565b60208082526024908201527f455243313135353a206275726e20616d6f756e7420657863656564732062616c604082015263616e636560e01b606082015260800190565b601f82111561063957600081815260208120601f850160051c81016020861015611e8c5750805b601f850160051c820191505b81811015610adb57828155600101611e98565b815167ffffffffffffffff811115611ec557611ec56114e7565b611ed981611ed384546119ef565b84611e65565b602080601f831160018114611f0e5760008415611ef65750858301515b600019600386901b1c1916600185901b178555610adb565b600085815260208120601f198616915b82811015611f3d57888601518255948401946001909101908401611f1e565b5085821015611f5b5787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fea2646970667358221220ac92b8dabd6e9f6f20eb7e779ed27dfc20e5e589cab35f348c2a185e449cf33064736f6c63430008150033",
+}
+
+// StandardERC1155InitializableABI is the input ABI used to generate the binding from.
+// Deprecated: Use StandardERC1155InitializableMetaData.ABI instead.
+var StandardERC1155InitializableABI = StandardERC1155InitializableMetaData.ABI
+
+// Deprecated: Use StandardERC1155InitializableMetaData.Sigs instead.
+// StandardERC1155InitializableFuncSigs maps the 4-byte function signature to its string representation.
+var StandardERC1155InitializableFuncSigs = StandardERC1155InitializableMetaData.Sigs
+
+// StandardERC1155InitializableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StandardERC1155InitializableMetaData.Bin instead.
+var StandardERC1155InitializableBin = StandardERC1155InitializableMetaData.Bin
+
+// DeployStandardERC1155Initializable deploys a new Ethereum contract, binding an instance of StandardERC1155Initializable to it.
+func DeployStandardERC1155Initializable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StandardERC1155Initializable, error) {
+	parsed, err := StandardERC1155InitializableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StandardERC1155InitializableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &StandardERC1155Initializable{StandardERC1155InitializableCaller: StandardERC1155InitializableCaller{contract: contract}, StandardERC1155InitializableTransactor: StandardERC1155InitializableTransactor{contract: contract}, StandardERC1155InitializableFilterer: StandardERC1155InitializableFilterer{contract: contract}}, nil
+}
+
+// StandardERC1155Initializable is an auto generated Go binding around an Ethereum contract.
+type StandardERC1155Initializable struct {
+	StandardERC1155InitializableCaller     // Read-only binding to the contract
+	StandardERC1155InitializableTransactor // Write-only binding to the contract
+	StandardERC1155InitializableFilterer   // Log filterer for contract events
+}
+
+// StandardERC1155InitializableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StandardERC1155InitializableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155InitializableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StandardERC1155InitializableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155InitializableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StandardERC1155InitializableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155InitializableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StandardERC1155InitializableSession struct {
+	Contract     *StandardERC1155Initializable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                 // Call options to use throughout this session
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// StandardERC1155InitializableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StandardERC1155InitializableCallerSession struct {
+	Contract *StandardERC1155InitializableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                       // Call options to use throughout this session
+}
+
+// StandardERC1155InitializableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StandardERC1155InitializableTransactorSession struct {
+	Contract     *StandardERC1155InitializableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                       // Transaction auth options to use throughout this session
+}
+
+// StandardERC1155InitializableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StandardERC1155InitializableRaw struct {
+	Contract *StandardERC1155Initializable // Generic contract binding to access the raw methods on
+}
+
+// StandardERC1155InitializableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StandardERC1155InitializableCallerRaw struct {
+	Contract *StandardERC1155InitializableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StandardERC1155InitializableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StandardERC1155InitializableTransactorRaw struct {
+	Contract *StandardERC1155InitializableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStandardERC1155Initializable creates a new instance of StandardERC1155Initializable, bound to a specific deployed contract.
+func NewStandardERC1155Initializable(address common.Address, backend bind.ContractBackend) (*StandardERC1155Initializable, error) {
+	contract, err := bindStandardERC1155Initializable(address, false, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155Initializable{StandardERC1155InitializableCaller: StandardERC1155InitializableCaller{contract: contract}, StandardERC1155InitializableTransactor: StandardERC1155InitializableTransactor{contract: contract}, StandardERC1155InitializableFilterer: StandardERC1155InitializableFilterer{contract: contract}}, nil
+}
+
+// NewStandardERC1155InitializableCaller creates a new read-only instance of StandardERC1155Initializable, bound to a specific deployed contract.
+func NewStandardERC1155InitializableCaller(address common.Address, caller bind.ContractCaller) (*StandardERC1155InitializableCaller, error) {
+	contract, err := bindStandardERC1155Initializable(address, false, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableCaller{contract: contract}, nil
+}
+
+// NewStandardERC1155InitializableTransactor creates a new write-only instance of StandardERC1155Initializable, bound to a specific deployed contract.
+func NewStandardERC1155InitializableTransactor(address common.Address, transactor bind.ContractTransactor) (*StandardERC1155InitializableTransactor, error) {
+	contract, err := bindStandardERC1155Initializable(address, false, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableTransactor{contract: contract}, nil
+}
+
+// NewStandardERC1155InitializableFilterer creates a new log filterer instance of StandardERC1155Initializable, bound to a specific deployed contract.
+func NewStandardERC1155InitializableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*StandardERC1155InitializableFilterer, error) {
+	contract, err := bindStandardERC1155Initializable(address, fuzzyAddress, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableFilterer{contract: contract}, nil
+}
+
+// bindStandardERC1155Initializable binds a generic wrapper to an already deployed contract.
+func bindStandardERC1155Initializable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StandardERC1155InitializableABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC1155Initializable *StandardERC1155InitializableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC1155Initializable.Contract.StandardERC1155InitializableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC1155Initializable *StandardERC1155InitializableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.StandardERC1155InitializableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC1155Initializable *StandardERC1155InitializableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.StandardERC1155InitializableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC1155Initializable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "balanceOf", account, id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.BalanceOf(&_StandardERC1155Initializable.CallOpts, account, id)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.BalanceOf(&_StandardERC1155Initializable.CallOpts, account, id)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "balanceOfBatch", accounts, ids)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.BalanceOfBatch(&_StandardERC1155Initializable.CallOpts, accounts, ids)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.BalanceOfBatch(&_StandardERC1155Initializable.CallOpts, accounts, ids)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) Exists(opts *bind.CallOpts, id *big.Int) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "exists", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) Exists(id *big.Int) (bool, error) {
+	return _StandardERC1155Initializable.Contract.Exists(&_StandardERC1155Initializable.CallOpts, id)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) Exists(id *big.Int) (bool, error) {
+	return _StandardERC1155Initializable.Contract.Exists(&_StandardERC1155Initializable.CallOpts, id)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "isApprovedForAll", account, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _StandardERC1155Initializable.Contract.IsApprovedForAll(&_StandardERC1155Initializable.CallOpts, account, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _StandardERC1155Initializable.Contract.IsApprovedForAll(&_StandardERC1155Initializable.CallOpts, account, operator)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) Owner() (common.Address, error) {
+	return _StandardERC1155Initializable.Contract.Owner(&_StandardERC1155Initializable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) Owner() (common.Address, error) {
+	return _StandardERC1155Initializable.Contract.Owner(&_StandardERC1155Initializable.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC1155Initializable.Contract.SupportsInterface(&_StandardERC1155Initializable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC1155Initializable.Contract.SupportsInterface(&_StandardERC1155Initializable.CallOpts, interfaceId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) TotalSupply(opts *bind.CallOpts, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "totalSupply", id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) TotalSupply(id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.TotalSupply(&_StandardERC1155Initializable.CallOpts, id)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) TotalSupply(id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Initializable.Contract.TotalSupply(&_StandardERC1155Initializable.CallOpts, id)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCaller) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var out []interface{}
+	err := _StandardERC1155Initializable.contract.Call(opts, &out, "uri", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) Uri(arg0 *big.Int) (string, error) {
+	return _StandardERC1155Initializable.Contract.Uri(&_StandardERC1155Initializable.CallOpts, arg0)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Initializable *StandardERC1155InitializableCallerSession) Uri(arg0 *big.Int) (string, error) {
+	return _StandardERC1155Initializable.Contract.Uri(&_StandardERC1155Initializable.CallOpts, arg0)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) Burn(opts *bind.TransactOpts, account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "burn", account, id, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) Burn(account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.Burn(&_StandardERC1155Initializable.TransactOpts, account, id, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) Burn(account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.Burn(&_StandardERC1155Initializable.TransactOpts, account, id, value)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) BurnBatch(opts *bind.TransactOpts, account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "burnBatch", account, ids, values)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) BurnBatch(account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.BurnBatch(&_StandardERC1155Initializable.TransactOpts, account, ids, values)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) BurnBatch(account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.BurnBatch(&_StandardERC1155Initializable.TransactOpts, account, ids, values)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) Initialize(opts *bind.TransactOpts, uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "initialize", uri, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) Initialize(uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.Initialize(&_StandardERC1155Initializable.TransactOpts, uri, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) Initialize(uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.Initialize(&_StandardERC1155Initializable.TransactOpts, uri, owner_)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.RenounceOwnership(&_StandardERC1155Initializable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.RenounceOwnership(&_StandardERC1155Initializable.TransactOpts)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SafeBatchTransferFrom(&_StandardERC1155Initializable.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SafeBatchTransferFrom(&_StandardERC1155Initializable.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SafeTransferFrom(&_StandardERC1155Initializable.TransactOpts, from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SafeTransferFrom(&_StandardERC1155Initializable.TransactOpts, from, to, id, amount, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SetApprovalForAll(&_StandardERC1155Initializable.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.SetApprovalForAll(&_StandardERC1155Initializable.TransactOpts, operator, approved)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.TransferOwnership(&_StandardERC1155Initializable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Initializable *StandardERC1155InitializableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Initializable.Contract.TransferOwnership(&_StandardERC1155Initializable.TransactOpts, newOwner)
+}
+
+// StandardERC1155InitializableApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableApprovalForAllIterator struct {
+	Event *StandardERC1155InitializableApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155InitializableApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155InitializableApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155InitializableApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155InitializableApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155InitializableApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155InitializableApprovalForAll represents a ApprovalForAll event raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableApprovalForAll struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) FilterApprovalForAll(opts *bind.FilterOpts, account []common.Address, operator []common.Address) (*StandardERC1155InitializableApprovalForAllIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.FilterLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableApprovalForAllIterator{contract: _StandardERC1155Initializable.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *StandardERC1155InitializableApprovalForAll, account []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.WatchLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155InitializableApprovalForAll)
+				if err := _StandardERC1155Initializable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) ParseApprovalForAll(log types.Log) (*StandardERC1155InitializableApprovalForAll, error) {
+	event := new(StandardERC1155InitializableApprovalForAll)
+	if err := _StandardERC1155Initializable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155InitializableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableOwnershipTransferredIterator struct {
+	Event *StandardERC1155InitializableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155InitializableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155InitializableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155InitializableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155InitializableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155InitializableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155InitializableOwnershipTransferred represents a OwnershipTransferred event raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StandardERC1155InitializableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableOwnershipTransferredIterator{contract: _StandardERC1155Initializable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StandardERC1155InitializableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155InitializableOwnershipTransferred)
+				if err := _StandardERC1155Initializable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) ParseOwnershipTransferred(log types.Log) (*StandardERC1155InitializableOwnershipTransferred, error) {
+	event := new(StandardERC1155InitializableOwnershipTransferred)
+	if err := _StandardERC1155Initializable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155InitializableTransferBatchIterator is returned from FilterTransferBatch and is used to iterate over the raw logs and unpacked data for TransferBatch events raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableTransferBatchIterator struct {
+	Event *StandardERC1155InitializableTransferBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155InitializableTransferBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155InitializableTransferBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155InitializableTransferBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155InitializableTransferBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155InitializableTransferBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155InitializableTransferBatch represents a TransferBatch event raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableTransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferBatch is a free log retrieval operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) FilterTransferBatch(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*StandardERC1155InitializableTransferBatchIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.FilterLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableTransferBatchIterator{contract: _StandardERC1155Initializable.contract, event: "TransferBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBatch is a free log subscription operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) WatchTransferBatch(opts *bind.WatchOpts, sink chan<- *StandardERC1155InitializableTransferBatch, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.WatchLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155InitializableTransferBatch)
+				if err := _StandardERC1155Initializable.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferBatch is a log parse operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) ParseTransferBatch(log types.Log) (*StandardERC1155InitializableTransferBatch, error) {
+	event := new(StandardERC1155InitializableTransferBatch)
+	if err := _StandardERC1155Initializable.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155InitializableTransferSingleIterator is returned from FilterTransferSingle and is used to iterate over the raw logs and unpacked data for TransferSingle events raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableTransferSingleIterator struct {
+	Event *StandardERC1155InitializableTransferSingle // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155InitializableTransferSingleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155InitializableTransferSingle)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155InitializableTransferSingle)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155InitializableTransferSingleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155InitializableTransferSingleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155InitializableTransferSingle represents a TransferSingle event raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableTransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferSingle is a free log retrieval operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) FilterTransferSingle(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*StandardERC1155InitializableTransferSingleIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.FilterLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableTransferSingleIterator{contract: _StandardERC1155Initializable.contract, event: "TransferSingle", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferSingle is a free log subscription operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) WatchTransferSingle(opts *bind.WatchOpts, sink chan<- *StandardERC1155InitializableTransferSingle, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.WatchLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155InitializableTransferSingle)
+				if err := _StandardERC1155Initializable.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferSingle is a log parse operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) ParseTransferSingle(log types.Log) (*StandardERC1155InitializableTransferSingle, error) {
+	event := new(StandardERC1155InitializableTransferSingle)
+	if err := _StandardERC1155Initializable.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155InitializableURIIterator is returned from FilterURI and is used to iterate over the raw logs and unpacked data for URI events raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableURIIterator struct {
+	Event *StandardERC1155InitializableURI // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155InitializableURIIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155InitializableURI)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155InitializableURI)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155InitializableURIIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155InitializableURIIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155InitializableURI represents a URI event raised by the StandardERC1155Initializable contract.
+type StandardERC1155InitializableURI struct {
+	Value string
+	Id    *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterURI is a free log retrieval operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) FilterURI(opts *bind.FilterOpts, id []*big.Int) (*StandardERC1155InitializableURIIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.FilterLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155InitializableURIIterator{contract: _StandardERC1155Initializable.contract, event: "URI", logs: logs, sub: sub}, nil
+}
+
+// WatchURI is a free log subscription operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) WatchURI(opts *bind.WatchOpts, sink chan<- *StandardERC1155InitializableURI, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _StandardERC1155Initializable.contract.WatchLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155InitializableURI)
+				if err := _StandardERC1155Initializable.contract.UnpackLog(event, "URI", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseURI is a log parse operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Initializable *StandardERC1155InitializableFilterer) ParseURI(log types.Log) (*StandardERC1155InitializableURI, error) {
+	event := new(StandardERC1155InitializableURI)
+	if err := _StandardERC1155Initializable.contract.UnpackLog(event, "URI", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableMetaData contains all meta data concerning the StandardERC1155Upgradeable contract.
+var StandardERC1155UpgradeableMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"TransferBatch\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"TransferSingle\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"value\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"URI\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address[]\",\"name\":\"accounts\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"}],\"name\":\"balanceOfBatch\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"value\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"values\",\"type\":\"uint256[]\"}],\"name\":\"burnBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"exists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"uri\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256[]\",\"name\":\"ids\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256[]\",\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeBatchTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"id\",\"type\":\"uint256\"}],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"name\":\"uri\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"00fdd58e": "balanceOf(address,uint256)",
+		"4e1273f4": "balanceOfBatch(address[],uint256[])",
+		"f5298aca": "burn(address,uint256,uint256)",
+		"6b20c454": "burnBatch(address,uint256[],uint256[])",
+		"4f558e79": "exists(uint256)",
+		"7ab4339d": "initialize(string,address)",
+		"e985e9c5": "isApprovedForAll(address,address)",
+		"8da5cb5b": "owner()",
+		"715018a6": "renounceOwnership()",
+		"2eb2c2d6": "safeBatchTransferFrom(address,address,uint256[],uint256[],bytes)",
+		"f242432a": "safeTransferFrom(address,address,uint256,uint256,bytes)",
+		"a22cb465": "setApprovalForAll(address,bool)",
+		"01ffc9a7": "supportsInterface(bytes4)",
+		"bd85b039": "totalSupply(uint256)",
+		"f2fde38b": "transferOwnership(address)",
+		"3659cfe6": "upgradeTo(address)",
+		"4f1ef286": "upgradeToAndCall(address,bytes)",
+		"0e89341c": "uri(uint256)",
+	},
+	Bin: "0x60a06040523060805234801561001457600080fd5b50600054610100900460ff168061002e575060005460ff16155b6100955760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b606482015260840160405180910390fd5b600054610100900460ff161580156100b7576000805461ffff19166101011790555b80156100c9576000805461ff00191690555b506080516126d3620000fb600039600081816105e201528181610622015281816107d3015261081301526126d36000f3fe6080604052600436106101085760003560e01c8063715018a611610095578063bd85b03911610064578063bd85b039146102eb578063e985e9c514610318578063f242432a14610361578063f2fde38b14610381578063f5298aca146103a157600080fd5b8063715018a61461026e5780637ab4339d146102835780638da5cb5b146102a3578063a22cb465146102cb57600080fd5b80633659cfe6116100dc5780633659cfe6146101bf5780634e1273f4146101df5780634f1ef2861461020c5780634f558e791461021f5780636b20c4541461024e57600080fd5b8062fdd58e1461010d57806301ffc9a7146101405780630e89341c146101705780632eb2c2d61461019d575b600080fd5b34801561011957600080fd5b5061012d6101283660046119f5565b6103c1565b6040519081526020015b60405180910390f35b34801561014c57600080fd5b5061016061015b366004611a35565b61045d565b6040519015158152602001610137565b34801561017c57600080fd5b5061019061018b366004611a52565b6104ad565b6040516101379190611abb565b3480156101a957600080fd5b506101bd6101b8366004611c24565b610541565b005b3480156101cb57600080fd5b506101bd6101da366004611cce565b6105d8565b3480156101eb57600080fd5b506101ff6101fa366004611ce9565b61069f565b6040516101379190611def565b6101bd61021a366004611e02565b6107c9565b34801561022b57600080fd5b5061016061023a366004611a52565b600090815260c96020526040902054151590565b34801561025a57600080fd5b506101bd610269366004611e46565b610882565b34801561027a57600080fd5b506101bd6108ca565b34801561028f57600080fd5b506101bd61029e366004611eba565b610900565b3480156102af57600080fd5b5060fb546040516001600160a01b039091168152602001610137565b3480156102d757600080fd5b506101bd6102e6366004611f1c565b610987565b3480156102f757600080fd5b5061012d610306366004611a52565b600090815260c9602052604090205490565b34801561032457600080fd5b50610160610333366004611f58565b6001600160a01b03918216600090815260666020908152604080832093909416825291909152205460ff1690565b34801561036d57600080fd5b506101bd61037c366004611f82565b610a5d565b34801561038d57600080fd5b506101bd61039c366004611cce565b610aa2565b3480156103ad57600080fd5b506101bd6103bc366004611fe7565b610b3a565b60006001600160a01b0383166104325760405162461bcd60e51b815260206004820152602b60248201527f455243313135353a2062616c616e636520717565727920666f7220746865207a60448201526a65726f206164647265737360a81b60648201526084015b60405180910390fd5b5060008181526065602090815260408083206001600160a01b03861684529091529020545b92915050565b60006001600160e01b03198216636cdb3d1360e11b148061048e57506001600160e01b031982166303a24d0760e21b145b8061045757506301ffc9a760e01b6001600160e01b0319831614610457565b6060606780546104bc9061201a565b80601f01602080910402602001604051908101604052809291908181526020018280546104e89061201a565b80156105355780601f1061050a57610100808354040283529160200191610535565b820191906000526020600020905b81548152906001019060200180831161051857829003601f168201915b50505050509050919050565b6001600160a01b03851633148061055d575061055d8533610333565b6105c45760405162461bcd60e51b815260206004820152603260248201527f455243313135353a207472616e736665722063616c6c6572206973206e6f74206044820152711bdddb995c881b9bdc88185c1c1c9bdd995960721b6064820152608401610429565b6105d18585858585610b7d565b5050505050565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036106205760405162461bcd60e51b815260040161042990612054565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316610652610d1c565b6001600160a01b0316146106785760405162461bcd60e51b8152600401610429906120a0565b61068181610d4a565b61069c81604051806020016040528060008152506000610d74565b50565b606081518351146107045760405162461bcd60e51b815260206004820152602960248201527f455243313135353a206163636f756e747320616e6420696473206c656e677468604482015268040dad2e6dac2e8c6d60bb1b6064820152608401610429565b6000835167ffffffffffffffff81111561072057610720611ace565b604051908082528060200260200182016040528015610749578160200160208202803683370190505b50905060005b84518110156107c15761079485828151811061076d5761076d6120ec565b6020026020010151858381518110610787576107876120ec565b60200260200101516103c1565b8282815181106107a6576107a66120ec565b60209081029190910101526107ba81612118565b905061074f565b509392505050565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036108115760405162461bcd60e51b815260040161042990612054565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316610843610d1c565b6001600160a01b0316146108695760405162461bcd60e51b8152600401610429906120a0565b61087282610d4a565b61087e82826001610d74565b5050565b6001600160a01b03831633148061089e575061089e8333610333565b6108ba5760405162461bcd60e51b815260040161042990612131565b6108c5838383610eb8565b505050565b60fb546001600160a01b031633146108f45760405162461bcd60e51b81526004016104299061217a565b6108fe6000610ec3565b565b600054610100900460ff1680610919575060005460ff16155b6109355760405162461bcd60e51b8152600401610429906121af565b600054610100900460ff16158015610957576000805461ffff19166101011790555b6109618383610f15565b610969611007565b610971611007565b80156108c5576000805461ff0019169055505050565b6001600160a01b03821633036109f15760405162461bcd60e51b815260206004820152602960248201527f455243313135353a2073657474696e6720617070726f76616c20737461747573604482015268103337b91039b2b63360b91b6064820152608401610429565b3360008181526066602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6001600160a01b038516331480610a795750610a798533610333565b610a955760405162461bcd60e51b815260040161042990612131565b6105d18585858585611072565b60fb546001600160a01b03163314610acc5760405162461bcd60e51b81526004016104299061217a565b6001600160a01b038116610b315760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610429565b61069c81610ec3565b6001600160a01b038316331480610b565750610b568333610333565b610b725760405162461bcd60e51b815260040161042990612131565b6108c583838361119c565b8151835114610b9e5760405162461bcd60e51b8152600401610429906121fd565b6001600160a01b038416610bc45760405162461bcd60e51b815260040161042990612245565b3360005b8451811015610cae576000858281518110610be557610be56120ec565b602002602001015190506000858381518110610c0357610c036120ec565b60209081029190910181015160008481526065835260408082206001600160a01b038e168352909352919091205490915081811015610c545760405162461bcd60e51b81526004016104299061228a565b60008381526065602090815260408083206001600160a01b038e8116855292528083208585039055908b16825281208054849290610c939084906122d4565b9250508190555050505080610ca790612118565b9050610bc8565b50846001600160a01b0316866001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb8787604051610cfe9291906122e7565b60405180910390a4610d148187878787876111a7565b505050505050565b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc546001600160a01b031690565b60fb546001600160a01b0316331461069c5760405162461bcd60e51b81526004016104299061217a565b6000610d7e610d1c565b9050610d8984611302565b600083511180610d965750815b15610da757610da584846113a7565b505b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd9143805460ff166105d157805460ff191660011781556040516001600160a01b0383166024820152610e2690869060440160408051601f198184030181529190526020810180516001600160e01b0316631b2ce7f360e11b1790526113a7565b50805460ff19168155610e37610d1c565b6001600160a01b0316826001600160a01b031614610eaf5760405162461bcd60e51b815260206004820152602f60248201527f45524331393637557067726164653a207570677261646520627265616b73206660448201526e75727468657220757067726164657360881b6064820152608401610429565b6105d185611492565b6108c58383836114d2565b60fb80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b600054610100900460ff1680610f2e575060005460ff16155b610f4a5760405162461bcd60e51b8152600401610429906121af565b600054610100900460ff16158015610f6c576000805461ffff19166101011790555b6001600160a01b038216610fd55760405162461bcd60e51b815260206004820152602a60248201527f5374616e64617264455243313135353a206f776e657220697320746865207a65604482015269726f206164647265737360b01b6064820152608401610429565b610fdd611007565b610fe5611007565b610fee8361155a565b610ff6611007565b610ffe611007565b61097182610ec3565b600054610100900460ff1680611020575060005460ff16155b61103c5760405162461bcd60e51b8152600401610429906121af565b600054610100900460ff1615801561105e576000805461ffff19166101011790555b801561069c576000805461ff001916905550565b6001600160a01b0384166110985760405162461bcd60e51b815260040161042990612245565b336110b18187876110a8886115cf565b6105d1886115cf565b60008481526065602090815260408083206001600160a01b038a168452909152902054838110156110f45760405162461bcd60e51b81526004016104299061228a565b60008581526065602090815260408083206001600160a01b038b81168552925280832087850390559088168252812080548692906111339084906122d4565b909155505060408051868152602081018690526001600160a01b03808916928a821692918616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a461119382888888888861161a565b50505050505050565b6108c58383836116d5565b6001600160a01b0384163b15610d145760405163bc197c8160e01b81526001600160a01b0385169063bc197c81906111eb908990899088908890889060040161230c565b6020604051808303816000875af1925050508015611226575060408051601f3d908101601f191682019092526112239181019061236a565b60015b6112d257611232612387565b806308c379a00361126b57506112466123a3565b80611251575061126d565b8060405162461bcd60e51b81526004016104299190611abb565b505b60405162461bcd60e51b815260206004820152603460248201527f455243313135353a207472616e7366657220746f206e6f6e20455243313135356044820152732932b1b2b4bb32b91034b6b83632b6b2b73a32b960611b6064820152608401610429565b6001600160e01b0319811663bc197c8160e01b146111935760405162461bcd60e51b81526004016104299061242d565b803b6113665760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610429565b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc80546001600160a01b0319166001600160a01b0392909216919091179055565b6060823b6114065760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b6064820152608401610429565b600080846001600160a01b0316846040516114219190612475565b600060405180830381855af49150503d806000811461145c576040519150601f19603f3d011682016040523d82523d6000602084013e611461565b606091505b5091509150611489828260405180606001604052806027815260200161267760279139611708565b95945050505050565b61149b81611302565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b6114dd838383611748565b60005b8251811015611554578181815181106114fb576114fb6120ec565b602002602001015160c96000858481518110611519576115196120ec565b60200260200101518152602001908152602001600020600082825461153e9190612491565b9091555061154d905081612118565b90506114e0565b50505050565b600054610100900460ff1680611573575060005460ff16155b61158f5760405162461bcd60e51b8152600401610429906121af565b600054610100900460ff161580156115b1576000805461ffff19166101011790555b6115ba826118c7565b801561087e576000805461ff00191690555050565b60408051600180825281830190925260609160009190602080830190803683370190505090508281600081518110611609576116096120ec565b602090810291909101015292915050565b6001600160a01b0384163b15610d145760405163f23a6e6160e01b81526001600160a01b0385169063f23a6e619061165e90899089908890889088906004016124a4565b6020604051808303816000875af1925050508015611699575060408051601f3d908101601f191682019092526116969181019061236a565b60015b6116a557611232612387565b6001600160e01b0319811663f23a6e6160e01b146111935760405162461bcd60e51b81526004016104299061242d565b6116e08383836118d3565b600082815260c96020526040812080548392906116fe908490612491565b9091555050505050565b60608315611717575081611741565b8251156117275782518084602001fd5b8160405162461bcd60e51b81526004016104299190611abb565b9392505050565b6001600160a01b03831661176e5760405162461bcd60e51b8152600401610429906124e9565b805182511461178f5760405162461bcd60e51b8152600401610429906121fd565b604080516020810190915260009081905233905b83518110156118685760008482815181106117c0576117c06120ec565b6020026020010151905060008483815181106117de576117de6120ec565b60209081029190910181015160008481526065835260408082206001600160a01b038c16835290935291909120549091508181101561182f5760405162461bcd60e51b81526004016104299061252c565b60009283526065602090815260408085206001600160a01b038b168652909152909220910390558061186081612118565b9150506117a3565b5060006001600160a01b0316846001600160a01b0316826001600160a01b03167f4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb86866040516118b99291906122e7565b60405180910390a450505050565b606761087e82826125b6565b6001600160a01b0383166118f95760405162461bcd60e51b8152600401610429906124e9565b336119298185600061190a876115cf565b611913876115cf565b5050604080516020810190915260009052505050565b60008381526065602090815260408083206001600160a01b03881684529091529020548281101561196c5760405162461bcd60e51b81526004016104299061252c565b60008481526065602090815260408083206001600160a01b03898116808652918452828520888703905582518981529384018890529092908616917fc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62910160405180910390a45050505050565b80356001600160a01b03811681146119f057600080fd5b919050565b60008060408385031215611a0857600080fd5b611a11836119d9565b946020939093013593505050565b6001600160e01b03198116811461069c57600080fd5b600060208284031215611a4757600080fd5b813561174181611a1f565b600060208284031215611a6457600080fd5b5035919050565b60005b83811015611a86578181015183820152602001611a6e565b50506000910152565b60008151808452611aa7816020860160208601611a6b565b601f01601f19169290920160200192915050565b6020815260006117416020830184611a8f565b634e487b7160e01b600052604160045260246000fd5b601f8201601f1916810167ffffffffffffffff81118282101715611b0a57611b0a611ace565b6040525050565b600067ffffffffffffffff821115611b2b57611b2b611ace565b5060051b60200190565b600082601f830112611b4657600080fd5b81356020611b5382611b11565b604051611b608282611ae4565b83815260059390931b8501820192828101915086841115611b8057600080fd5b8286015b84811015611b9b5780358352918301918301611b84565b509695505050505050565b600067ffffffffffffffff831115611bc057611bc0611ace565b604051611bd7601f8501601f191660200182611ae4565b809150838152848484011115611bec57600080fd5b83836020830137600060208583010152509392505050565b600082601f830112611c1557600080fd5b61174183833560208501611ba6565b600080600080600060a08688031215611c3c57600080fd5b611c45866119d9565b9450611c53602087016119d9565b9350604086013567ffffffffffffffff80821115611c7057600080fd5b611c7c89838a01611b35565b94506060880135915080821115611c9257600080fd5b611c9e89838a01611b35565b93506080880135915080821115611cb457600080fd5b50611cc188828901611c04565b9150509295509295909350565b600060208284031215611ce057600080fd5b611741826119d9565b60008060408385031215611cfc57600080fd5b823567ffffffffffffffff80821115611d1457600080fd5b818501915085601f830112611d2857600080fd5b81356020611d3582611b11565b604051611d428282611ae4565b83815260059390931b8501820192828101915089841115611d6257600080fd5b948201945b83861015611d8757611d78866119d9565b82529482019490820190611d67565b96505086013592505080821115611d9d57600080fd5b50611daa85828601611b35565b9150509250929050565b600081518084526020808501945080840160005b83811015611de457815187529582019590820190600101611dc8565b509495945050505050565b6020815260006117416020830184611db4565b60008060408385031215611e1557600080fd5b611e1e836119d9565b9150602083013567ffffffffffffffff811115611e3a57600080fd5b611daa85828601611c04565b600080600060608486031215611e5b57600080fd5b611e64846119d9565b9250602084013567ffffffffffffffff80821115611e8157600080fd5b611e8d87838801611b35565b93506040860135915080821115611ea357600080fd5b50611eb086828701611b35565b9150509250925092565b60008060408385031215611ecd57600080fd5b823567ffffffffffffffff811115611ee457600080fd5b8301601f81018513611ef557600080fd5b611f0485823560208401611ba6565b925050611f13602084016119d9565b90509250929050565b60008060408385031215611f2f57600080fd5b611f38836119d9565b915060208301358015158114611f4d57600080fd5b809150509250929050565b60008060408385031215611f6b57600080fd5b611f74836119d9565b9150611f13602084016119d9565b600080600080600060a08688031215611f9a57600080fd5b611fa3866119d9565b9450611fb1602087016119d9565b93506040860135925060608601359150608086013567ffffffffffffffff811115611fdb57600080fd5b611cc188828901611c04565b600080600060608486031215611ffc57600080fd5b612005846119d9565b95602085013595506040909401359392505050565b600181811c9082168061202e57607f821691505b60208210810361204e57634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b60006001820161212a5761212a612102565b5060010190565b60208082526029908201527f455243313135353a2063616c6c6572206973206e6f74206f776e6572206e6f7260408201526808185c1c1c9bdd995960ba1b606082015260800190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b60208082526028908201527f455243313135353a2069647320616e6420616d6f756e7473206c656e677468206040820152670dad2e6dac2e8c6d60c31b606082015260800190565b60208082526025908201527f455243313135353a207472616e7366657220746f20746865207a65726f206164604082015264647265737360d81b606082015260800190565b6020808252602a908201527f455243313135353a20696e73756666696369656e742062616c616e636520666f60408201526939103a3930b739b332b960b11b606082015260800190565b8082018082111561045757610457612102565b6040815260006122fa6040830185611db4565b82810360208401526114898185611db4565b6001600160a01b0386811682528516602082015260a06040820181905260009061233890830186611db4565b828103606084015261234a8186611db4565b9050828103608084015261235e8185611a8f565b98975050505050505050565b60006020828403121561237c57600080fd5b815161174181611a1f565b600060033d11156123a05760046000803e5060005160e01c5b90565b600060443d10156123b15790565b6040516003193d81016004833e81513d67ffffffffffffffff81602484011181841117156123e157505050505090565b82850191508151818111156123f95750505050505090565b843d87010160208285010111156124135750505050505090565b61242260208286010187611ae4565b509095945050505050565b60208082526028908201527f455243313135353a204552433131353552656365697665722072656a656374656040820152676420746f6b656e7360c01b606082015260800190565b60008251612487818460208701611a6b565b9190910192915050565b8181038181111561045757610457612102565b6001600160a01b03868116825285166020820152604081018490526060810183905260a0608082018190526000906124de90830184611a8f565b979650505050505050565b60208082526023908201527f455243313135353a206275726e2066726f6d20746865207a65726f206164647260408201526265737360e81b606082015260800190565b60208082526024908201527f455243313135353a206275726e20616d6f756e7420657863656564732062616c604082015263616e636560e01b606082015260800190565b601f8211156108c557600081815260208120601f850160051c810160208610156125975750805b601f850160051c820191505b81811015610d14578281556001016125a3565b815167ffffffffffffffff8111156125d0576125d0611ace565b6125e4816125de845461201a565b84612570565b602080601f83116001811461261957600084156126015750858301515b600019600386901b1c1916600185901b178555610d14565b600085815260208120601f198616915b8281101561264857888601518255948401946001909101908401612629565b50858210156126665787850151600019600388901b60f8161c191681555b5050505050600190811b0190555056fe416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a264697066735822122050e6d2ba1863bd82df864733fc4df3e2d660c8b8a8512a011f47f5e2adc8a42264736f6c63430008150033",
+}
+
+// StandardERC1155UpgradeableABI is the input ABI used to generate the binding from.
+// Deprecated: Use StandardERC1155UpgradeableMetaData.ABI instead.
+var StandardERC1155UpgradeableABI = StandardERC1155UpgradeableMetaData.ABI
+
+// Deprecated: Use StandardERC1155UpgradeableMetaData.Sigs instead.
+// StandardERC1155UpgradeableFuncSigs maps the 4-byte function signature to its string representation.
+var StandardERC1155UpgradeableFuncSigs = StandardERC1155UpgradeableMetaData.Sigs
+
+// StandardERC1155UpgradeableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StandardERC1155UpgradeableMetaData.Bin instead.
+var StandardERC1155UpgradeableBin = StandardERC1155UpgradeableMetaData.Bin
+
+// DeployStandardERC1155Upgradeable deploys a new Ethereum contract, binding an instance of StandardERC1155Upgradeable to it.
+func DeployStandardERC1155Upgradeable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StandardERC1155Upgradeable, error) {
+	parsed, err := StandardERC1155UpgradeableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StandardERC1155UpgradeableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &StandardERC1155Upgradeable{StandardERC1155UpgradeableCaller: StandardERC1155UpgradeableCaller{contract: contract}, StandardERC1155UpgradeableTransactor: StandardERC1155UpgradeableTransactor{contract: contract}, StandardERC1155UpgradeableFilterer: StandardERC1155UpgradeableFilterer{contract: contract}}, nil
+}
+
+// StandardERC1155Upgradeable is an auto generated Go binding around an Ethereum contract.
+type StandardERC1155Upgradeable struct {
+	StandardERC1155UpgradeableCaller     // Read-only binding to the contract
+	StandardERC1155UpgradeableTransactor // Write-only binding to the contract
+	StandardERC1155UpgradeableFilterer   // Log filterer for contract events
+}
+
+// StandardERC1155UpgradeableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StandardERC1155UpgradeableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155UpgradeableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StandardERC1155UpgradeableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155UpgradeableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StandardERC1155UpgradeableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC1155UpgradeableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StandardERC1155UpgradeableSession struct {
+	Contract     *StandardERC1155Upgradeable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts               // Call options to use throughout this session
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// StandardERC1155UpgradeableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StandardERC1155UpgradeableCallerSession struct {
+	Contract *StandardERC1155UpgradeableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                     // Call options to use throughout this session
+}
+
+// StandardERC1155UpgradeableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StandardERC1155UpgradeableTransactorSession struct {
+	Contract     *StandardERC1155UpgradeableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                     // Transaction auth options to use throughout this session
+}
+
+// StandardERC1155UpgradeableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StandardERC1155UpgradeableRaw struct {
+	Contract *StandardERC1155Upgradeable // Generic contract binding to access the raw methods on
+}
+
+// StandardERC1155UpgradeableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StandardERC1155UpgradeableCallerRaw struct {
+	Contract *StandardERC1155UpgradeableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StandardERC1155UpgradeableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StandardERC1155UpgradeableTransactorRaw struct {
+	Contract *StandardERC1155UpgradeableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStandardERC1155Upgradeable creates a new instance of StandardERC1155Upgradeable, bound to a specific deployed contract.
+func NewStandardERC1155Upgradeable(address common.Address, backend bind.ContractBackend) (*StandardERC1155Upgradeable, error) {
+	contract, err := bindStandardERC1155Upgradeable(address, false, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155Upgradeable{StandardERC1155UpgradeableCaller: StandardERC1155UpgradeableCaller{contract: contract}, StandardERC1155UpgradeableTransactor: StandardERC1155UpgradeableTransactor{contract: contract}, StandardERC1155UpgradeableFilterer: StandardERC1155UpgradeableFilterer{contract: contract}}, nil
+}
+
+// NewStandardERC1155UpgradeableCaller creates a new read-only instance of StandardERC1155Upgradeable, bound to a specific deployed contract.
+func NewStandardERC1155UpgradeableCaller(address common.Address, caller bind.ContractCaller) (*StandardERC1155UpgradeableCaller, error) {
+	contract, err := bindStandardERC1155Upgradeable(address, false, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableCaller{contract: contract}, nil
+}
+
+// NewStandardERC1155UpgradeableTransactor creates a new write-only instance of StandardERC1155Upgradeable, bound to a specific deployed contract.
+func NewStandardERC1155UpgradeableTransactor(address common.Address, transactor bind.ContractTransactor) (*StandardERC1155UpgradeableTransactor, error) {
+	contract, err := bindStandardERC1155Upgradeable(address, false, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableTransactor{contract: contract}, nil
+}
+
+// NewStandardERC1155UpgradeableFilterer creates a new log filterer instance of StandardERC1155Upgradeable, bound to a specific deployed contract.
+func NewStandardERC1155UpgradeableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*StandardERC1155UpgradeableFilterer, error) {
+	contract, err := bindStandardERC1155Upgradeable(address, fuzzyAddress, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableFilterer{contract: contract}, nil
+}
+
+// bindStandardERC1155Upgradeable binds a generic wrapper to an already deployed contract.
+func bindStandardERC1155Upgradeable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StandardERC1155UpgradeableABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC1155Upgradeable.Contract.StandardERC1155UpgradeableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.StandardERC1155UpgradeableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.StandardERC1155UpgradeableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC1155Upgradeable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) BalanceOf(opts *bind.CallOpts, account common.Address, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "balanceOf", account, id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.BalanceOf(&_StandardERC1155Upgradeable.CallOpts, account, id)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x00fdd58e.
+//
+// Solidity: function balanceOf(address account, uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) BalanceOf(account common.Address, id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.BalanceOf(&_StandardERC1155Upgradeable.CallOpts, account, id)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) BalanceOfBatch(opts *bind.CallOpts, accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "balanceOfBatch", accounts, ids)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.BalanceOfBatch(&_StandardERC1155Upgradeable.CallOpts, accounts, ids)
+}
+
+// BalanceOfBatch is a free data retrieval call binding the contract method 0x4e1273f4.
+//
+// Solidity: function balanceOfBatch(address[] accounts, uint256[] ids) view returns(uint256[])
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) BalanceOfBatch(accounts []common.Address, ids []*big.Int) ([]*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.BalanceOfBatch(&_StandardERC1155Upgradeable.CallOpts, accounts, ids)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) Exists(opts *bind.CallOpts, id *big.Int) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "exists", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) Exists(id *big.Int) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.Exists(&_StandardERC1155Upgradeable.CallOpts, id)
+}
+
+// Exists is a free data retrieval call binding the contract method 0x4f558e79.
+//
+// Solidity: function exists(uint256 id) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) Exists(id *big.Int) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.Exists(&_StandardERC1155Upgradeable.CallOpts, id)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) IsApprovedForAll(opts *bind.CallOpts, account common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "isApprovedForAll", account, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.IsApprovedForAll(&_StandardERC1155Upgradeable.CallOpts, account, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address account, address operator) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) IsApprovedForAll(account common.Address, operator common.Address) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.IsApprovedForAll(&_StandardERC1155Upgradeable.CallOpts, account, operator)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) Owner() (common.Address, error) {
+	return _StandardERC1155Upgradeable.Contract.Owner(&_StandardERC1155Upgradeable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) Owner() (common.Address, error) {
+	return _StandardERC1155Upgradeable.Contract.Owner(&_StandardERC1155Upgradeable.CallOpts)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.SupportsInterface(&_StandardERC1155Upgradeable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC1155Upgradeable.Contract.SupportsInterface(&_StandardERC1155Upgradeable.CallOpts, interfaceId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) TotalSupply(opts *bind.CallOpts, id *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "totalSupply", id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) TotalSupply(id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.TotalSupply(&_StandardERC1155Upgradeable.CallOpts, id)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0xbd85b039.
+//
+// Solidity: function totalSupply(uint256 id) view returns(uint256)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) TotalSupply(id *big.Int) (*big.Int, error) {
+	return _StandardERC1155Upgradeable.Contract.TotalSupply(&_StandardERC1155Upgradeable.CallOpts, id)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCaller) Uri(opts *bind.CallOpts, arg0 *big.Int) (string, error) {
+	var out []interface{}
+	err := _StandardERC1155Upgradeable.contract.Call(opts, &out, "uri", arg0)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) Uri(arg0 *big.Int) (string, error) {
+	return _StandardERC1155Upgradeable.Contract.Uri(&_StandardERC1155Upgradeable.CallOpts, arg0)
+}
+
+// Uri is a free data retrieval call binding the contract method 0x0e89341c.
+//
+// Solidity: function uri(uint256 ) view returns(string)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableCallerSession) Uri(arg0 *big.Int) (string, error) {
+	return _StandardERC1155Upgradeable.Contract.Uri(&_StandardERC1155Upgradeable.CallOpts, arg0)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) Burn(opts *bind.TransactOpts, account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "burn", account, id, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) Burn(account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.Burn(&_StandardERC1155Upgradeable.TransactOpts, account, id, value)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0xf5298aca.
+//
+// Solidity: function burn(address account, uint256 id, uint256 value) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) Burn(account common.Address, id *big.Int, value *big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.Burn(&_StandardERC1155Upgradeable.TransactOpts, account, id, value)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) BurnBatch(opts *bind.TransactOpts, account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "burnBatch", account, ids, values)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) BurnBatch(account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.BurnBatch(&_StandardERC1155Upgradeable.TransactOpts, account, ids, values)
+}
+
+// BurnBatch is a paid mutator transaction binding the contract method 0x6b20c454.
+//
+// Solidity: function burnBatch(address account, uint256[] ids, uint256[] values) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) BurnBatch(account common.Address, ids []*big.Int, values []*big.Int) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.BurnBatch(&_StandardERC1155Upgradeable.TransactOpts, account, ids, values)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) Initialize(opts *bind.TransactOpts, uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "initialize", uri, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) Initialize(uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.Initialize(&_StandardERC1155Upgradeable.TransactOpts, uri, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x7ab4339d.
+//
+// Solidity: function initialize(string uri, address owner_) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) Initialize(uri string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.Initialize(&_StandardERC1155Upgradeable.TransactOpts, uri, owner_)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.RenounceOwnership(&_StandardERC1155Upgradeable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.RenounceOwnership(&_StandardERC1155Upgradeable.TransactOpts)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) SafeBatchTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "safeBatchTransferFrom", from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SafeBatchTransferFrom(&_StandardERC1155Upgradeable.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeBatchTransferFrom is a paid mutator transaction binding the contract method 0x2eb2c2d6.
+//
+// Solidity: function safeBatchTransferFrom(address from, address to, uint256[] ids, uint256[] amounts, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) SafeBatchTransferFrom(from common.Address, to common.Address, ids []*big.Int, amounts []*big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SafeBatchTransferFrom(&_StandardERC1155Upgradeable.TransactOpts, from, to, ids, amounts, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "safeTransferFrom", from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SafeTransferFrom(&_StandardERC1155Upgradeable.TransactOpts, from, to, id, amount, data)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0xf242432a.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 id, uint256 amount, bytes data) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) SafeTransferFrom(from common.Address, to common.Address, id *big.Int, amount *big.Int, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SafeTransferFrom(&_StandardERC1155Upgradeable.TransactOpts, from, to, id, amount, data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SetApprovalForAll(&_StandardERC1155Upgradeable.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.SetApprovalForAll(&_StandardERC1155Upgradeable.TransactOpts, operator, approved)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.TransferOwnership(&_StandardERC1155Upgradeable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.TransferOwnership(&_StandardERC1155Upgradeable.TransactOpts, newOwner)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.UpgradeTo(&_StandardERC1155Upgradeable.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.UpgradeTo(&_StandardERC1155Upgradeable.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.UpgradeToAndCall(&_StandardERC1155Upgradeable.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC1155Upgradeable.Contract.UpgradeToAndCall(&_StandardERC1155Upgradeable.TransactOpts, newImplementation, data)
+}
+
+// StandardERC1155UpgradeableAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableAdminChangedIterator struct {
+	Event *StandardERC1155UpgradeableAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableAdminChanged represents a AdminChanged event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*StandardERC1155UpgradeableAdminChangedIterator, error) {
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableAdminChangedIterator{contract: _StandardERC1155Upgradeable.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableAdminChanged)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseAdminChanged(log types.Log) (*StandardERC1155UpgradeableAdminChanged, error) {
+	event := new(StandardERC1155UpgradeableAdminChanged)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableApprovalForAllIterator struct {
+	Event *StandardERC1155UpgradeableApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableApprovalForAll represents a ApprovalForAll event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableApprovalForAll struct {
+	Account  common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterApprovalForAll(opts *bind.FilterOpts, account []common.Address, operator []common.Address) (*StandardERC1155UpgradeableApprovalForAllIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableApprovalForAllIterator{contract: _StandardERC1155Upgradeable.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableApprovalForAll, account []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "ApprovalForAll", accountRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableApprovalForAll)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed account, address indexed operator, bool approved)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseApprovalForAll(log types.Log) (*StandardERC1155UpgradeableApprovalForAll, error) {
+	event := new(StandardERC1155UpgradeableApprovalForAll)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableBeaconUpgradedIterator struct {
+	Event *StandardERC1155UpgradeableBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableBeaconUpgraded represents a BeaconUpgraded event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*StandardERC1155UpgradeableBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableBeaconUpgradedIterator{contract: _StandardERC1155Upgradeable.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableBeaconUpgraded)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseBeaconUpgraded(log types.Log) (*StandardERC1155UpgradeableBeaconUpgraded, error) {
+	event := new(StandardERC1155UpgradeableBeaconUpgraded)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableOwnershipTransferredIterator struct {
+	Event *StandardERC1155UpgradeableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableOwnershipTransferred represents a OwnershipTransferred event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StandardERC1155UpgradeableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableOwnershipTransferredIterator{contract: _StandardERC1155Upgradeable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableOwnershipTransferred)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseOwnershipTransferred(log types.Log) (*StandardERC1155UpgradeableOwnershipTransferred, error) {
+	event := new(StandardERC1155UpgradeableOwnershipTransferred)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableTransferBatchIterator is returned from FilterTransferBatch and is used to iterate over the raw logs and unpacked data for TransferBatch events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableTransferBatchIterator struct {
+	Event *StandardERC1155UpgradeableTransferBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableTransferBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableTransferBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableTransferBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableTransferBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableTransferBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableTransferBatch represents a TransferBatch event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableTransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferBatch is a free log retrieval operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterTransferBatch(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*StandardERC1155UpgradeableTransferBatchIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableTransferBatchIterator{contract: _StandardERC1155Upgradeable.contract, event: "TransferBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferBatch is a free log subscription operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchTransferBatch(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableTransferBatch, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "TransferBatch", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableTransferBatch)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferBatch is a log parse operation binding the contract event 0x4a39dc06d4c0dbc64b70af90fd698a233a518aa5d07e595d983b8c0526c8f7fb.
+//
+// Solidity: event TransferBatch(address indexed operator, address indexed from, address indexed to, uint256[] ids, uint256[] values)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseTransferBatch(log types.Log) (*StandardERC1155UpgradeableTransferBatch, error) {
+	event := new(StandardERC1155UpgradeableTransferBatch)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "TransferBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableTransferSingleIterator is returned from FilterTransferSingle and is used to iterate over the raw logs and unpacked data for TransferSingle events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableTransferSingleIterator struct {
+	Event *StandardERC1155UpgradeableTransferSingle // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableTransferSingleIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableTransferSingle)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableTransferSingle)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableTransferSingleIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableTransferSingleIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableTransferSingle represents a TransferSingle event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableTransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransferSingle is a free log retrieval operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterTransferSingle(opts *bind.FilterOpts, operator []common.Address, from []common.Address, to []common.Address) (*StandardERC1155UpgradeableTransferSingleIterator, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableTransferSingleIterator{contract: _StandardERC1155Upgradeable.contract, event: "TransferSingle", logs: logs, sub: sub}, nil
+}
+
+// WatchTransferSingle is a free log subscription operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchTransferSingle(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableTransferSingle, operator []common.Address, from []common.Address, to []common.Address) (event.Subscription, error) {
+
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "TransferSingle", operatorRule, fromRule, toRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableTransferSingle)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransferSingle is a log parse operation binding the contract event 0xc3d58168c5ae7397731d063d5bbf3d657854427343f4c083240f7aacaa2d0f62.
+//
+// Solidity: event TransferSingle(address indexed operator, address indexed from, address indexed to, uint256 id, uint256 value)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseTransferSingle(log types.Log) (*StandardERC1155UpgradeableTransferSingle, error) {
+	event := new(StandardERC1155UpgradeableTransferSingle)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "TransferSingle", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableURIIterator is returned from FilterURI and is used to iterate over the raw logs and unpacked data for URI events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableURIIterator struct {
+	Event *StandardERC1155UpgradeableURI // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableURIIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableURI)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableURI)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableURIIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableURIIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableURI represents a URI event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableURI struct {
+	Value string
+	Id    *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterURI is a free log retrieval operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterURI(opts *bind.FilterOpts, id []*big.Int) (*StandardERC1155UpgradeableURIIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableURIIterator{contract: _StandardERC1155Upgradeable.contract, event: "URI", logs: logs, sub: sub}, nil
+}
+
+// WatchURI is a free log subscription operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchURI(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableURI, id []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "URI", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableURI)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "URI", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseURI is a log parse operation binding the contract event 0x6bb7ff708619ba0610cba295a58592e0451dee2622938c8755667688daf3529b.
+//
+// Solidity: event URI(string value, uint256 indexed id)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseURI(log types.Log) (*StandardERC1155UpgradeableURI, error) {
+	event := new(StandardERC1155UpgradeableURI)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "URI", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC1155UpgradeableUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableUpgradedIterator struct {
+	Event *StandardERC1155UpgradeableUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC1155UpgradeableUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC1155UpgradeableUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC1155UpgradeableUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC1155UpgradeableUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC1155UpgradeableUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC1155UpgradeableUpgraded represents a Upgraded event raised by the StandardERC1155Upgradeable contract.
+type StandardERC1155UpgradeableUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*StandardERC1155UpgradeableUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC1155UpgradeableUpgradedIterator{contract: _StandardERC1155Upgradeable.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *StandardERC1155UpgradeableUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StandardERC1155Upgradeable.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC1155UpgradeableUpgraded)
+				if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC1155Upgradeable *StandardERC1155UpgradeableFilterer) ParseUpgraded(log types.Log) (*StandardERC1155UpgradeableUpgraded, error) {
+	event := new(StandardERC1155UpgradeableUpgraded)
+	if err := _StandardERC1155Upgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,88 @@
+package erc1155
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/deploy"
+)
+
+// DeployOpts are the optional settings of Deploy and DeployCreate2
+type DeployOpts struct {
+	Owner    string        // transfer the ownership to this address once deployed, empty keeps the deployer as owner
+	Contract *ContractOpts // options of the returned Contract, Backend and ContractAddr are set by Deploy
+	Factory  string        // CREATE2 factory of DeployCreate2, default is create2.FactoryAddress
+}
+
+// Deploy deploys a StandardERC1155 signed by signer, waits until its code is on chain and returns the
+// Contract bound to it. The Contract shares the backend, ReleaseResource does not close it.
+func Deploy(ctx context.Context, signer *bind.TransactOpts, backend *backend.Backend, uri string, opts ...*DeployOpts) (*Contract, error) {
+	ops, owner, err := deployOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := deploy.Deploy(ctx, signer, backend, owner, func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := erc1155.DeployStandardERC1155(auth, backend, uri)
+		return tx, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindContract(backend, address, ops.Contract)
+}
+
+// InitCode returns the bytecode with the constructor arguments of a StandardERC1155, the init code CREATE2
+// addresses are computed from
+func InitCode(uri string) ([]byte, error) {
+	return deploy.InitCode(erc1155.StandardERC1155MetaData, uri)
+}
+
+// DeployCreate2 deploys a StandardERC1155 through the CREATE2 factory, the same signer, salt and uri
+// give the same address on every chain. When the address already has code nothing is deployed and the
+// Contract is bound to it, Owner is still applied if the signer owns the contract.
+func DeployCreate2(ctx context.Context, signer *bind.TransactOpts, backend *backend.Backend, salt common.Hash, uri string, opts ...*DeployOpts) (*Contract, error) {
+	ops, owner, err := deployOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	initCode, err := InitCode(uri)
+	if err != nil {
+		return nil, err
+	}
+	address, err := deploy.Create2(ctx, signer, backend, ops.Factory, salt, initCode, owner)
+	if err != nil {
+		return nil, err
+	}
+	return bindContract(backend, address, ops.Contract)
+}
+
+func deployOptions(opts []*DeployOpts) (*DeployOpts, common.Address, error) {
+	if len(opts) > 1 {
+		return nil, common.Address{}, errors.New("invalid parameter, only one deploy options is allowed")
+	}
+	ops := &DeployOpts{}
+	if len(opts) == 1 && opts[0] != nil {
+		ops = opts[0]
+	}
+	owner, err := deploy.ParseOwner(ops.Owner)
+	return ops, owner, err
+}
+
+// bindContract returns the Contract of the deployed address sharing the backend
+func bindContract(backend *backend.Backend, address common.Address, ops *ContractOpts) (*Contract, error) {
+	contractOpts := ContractOpts{}
+	if ops != nil {
+		contractOpts = *ops
+	}
+	contractOpts.Backend = backend
+	contractOpts.ContractAddr = address.Hex()
+
+	return NewContract(&contractOpts)
+}
@@ -0,0 +1,137 @@
+package erc1155
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jason-bateman/go-erc-standard-contract/create2"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+func TestDeploy(t *testing.T) {
+	chain := testchain.New(t, 1)
+	deployer, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+	owner := "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"
+
+	ctx := context.Background()
+	contract, err := Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json")
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	uri, err := contract.ReadUri(ctx, "1")
+	if err != nil || uri != "https://example.com/{id}.json" {
+		t.Errorf("read uri:%s err:%+v\n", uri, err)
+	}
+	got, err := contract.ReadOwner(ctx)
+	if err != nil || got != deployer.Hex() {
+		t.Errorf("read owner:%s want:%s err:%+v\n", got, deployer.Hex(), err)
+	}
+
+	transferred, err := Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json", &DeployOpts{Owner: owner})
+	if err != nil {
+		t.Fatalf("deploy with owner err:%+v\n", err)
+	}
+	defer transferred.ReleaseResource()
+
+	got, err = transferred.ReadOwner(ctx)
+	if err != nil || got != owner {
+		t.Errorf("read owner:%s want:%s err:%+v\n", got, owner, err)
+	}
+
+	if _, err = Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json", &DeployOpts{Owner: "0x1"}); err == nil {
+		t.Errorf("invalid owner should fail\n")
+	}
+}
+
+func TestDeployCreate2(t *testing.T) {
+	chain := testchain.New(t, 1)
+	deployer, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+	owner := "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"
+
+	factory, _ := create2.NewFactory(chain.Backend, nil)
+
+	ctx := context.Background()
+	if err := factory.Install(ctx, signer); err != nil {
+		t.Fatalf("install factory err:%+v\n", err)
+	}
+
+	salt := common.HexToHash("0x01")
+	initCode, err := InitCode("https://example.com/{id}.json")
+	if err != nil {
+		t.Fatalf("init code err:%+v\n", err)
+	}
+	expected := factory.ComputeAddress(deployer, salt, initCode)
+
+	// 重复部署得到同一个地址
+	for i := 0; i < 2; i++ {
+		contract, err := DeployCreate2(ctx, signer, chain.Backend, salt, "https://example.com/{id}.json", &DeployOpts{Owner: owner})
+		if err != nil {
+			t.Fatalf("deploy create2 err:%+v\n", err)
+		}
+		if contract.contractAddr != expected {
+			t.Errorf("address:%s want:%s\n", contract.contractAddr.Hex(), expected.Hex())
+		}
+		got, err := contract.ReadOwner(ctx)
+		if err != nil || got != owner {
+			t.Errorf("read owner:%s want:%s err:%+v\n", got, owner, err)
+		}
+		contract.ReleaseResource()
+	}
+}
+
+func TestContract_FilterEventsAheadOfHead(t *testing.T) {
+	chain := testchain.New(t, 1)
+	signer := chain.Accounts[0].Signer
+
+	ctx := context.Background()
+	contract, err := Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json", &DeployOpts{Contract: &ContractOpts{EnableFilter: true}})
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	latest, _ := chain.Backend.BlockNumber(ctx)
+	if _, err = contract.FilterEvents(ctx, 1, &latest); err != nil {
+		t.Errorf("filter events err:%+v\n", err)
+	}
+
+	// 模拟节点拒绝from > to, 超过链头的区间不应发出查询
+	stop := latest + 10
+	events, err := contract.FilterEvents(ctx, latest+1, &stop)
+	if err != nil || len(events) != 0 || stop != latest {
+		t.Errorf("filter ahead of head events:%d stop:%d err:%+v\n", len(events), stop, err)
+	}
+}
+
+func TestBatch_ExecuteAtBlock(t *testing.T) {
+	chain := testchain.New(t, 1)
+	signer := chain.Accounts[0].Signer
+
+	ctx := context.Background()
+	contract, err := Deploy(ctx, signer, chain.Backend, "https://example.com/{id}.json")
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	batch := contract.NewBatch()
+	result := batch.ReadUri("1")
+	latest, _ := chain.Backend.BlockNumber(ctx)
+	for _, tag := range []chainModel.BlockTag{chainModel.BlockLatest, chainModel.BlockPending, chainModel.BlockNumber(latest)} {
+		if err = batch.Execute(ctx, tag); err != nil || !result.Success || result.Value != "https://example.com/{id}.json" {
+			t.Errorf("execute at %s result:%+v err:%+v\n", tag, result, err)
+		}
+	}
+
+	// 模拟链只保留最新区块的状态, 历史区块的调用失败说明区块参数传到了节点
+	if err = batch.Execute(ctx, chainModel.BlockNumber(0)); err != nil || result.Success {
+		t.Errorf("execute at the genesis block result:%+v err:%+v\n", result, err)
+	}
+	if err = batch.Execute(ctx, "earliest"); err == nil {
+		t.Errorf("invalid block tag should fail\n")
+	}
+}
@@ -7,53 +7,81 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
 	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+	"github.com/jason-bateman/go-erc-standard-contract/logger"
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
 	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
+	"github.com/jason-bateman/go-erc-standard-contract/tracing"
 	"github.com/jason-bateman/go-erc-standard-contract/utils"
-	"log"
-	"math"
 	"math/big"
 	"strings"
 	"time"
 )
 
 type contractTransactor struct {
-	client     *ethclient.Client // client
+	client     *backend.Backend // client
 	key        *ecdsa.PrivateKey
 	transactor *erc1155.StandardERC1155Transactor // transactor
 }
 
 type contractCaller struct {
-	client *ethclient.Client              // client
+	client *backend.Backend               // client
 	caller *erc1155.StandardERC1155Caller // caller
 }
 
 type contractFilterer struct {
-	client             *ethclient.Client                // client
+	client             *backend.Backend                 // client
 	stepNum            uint64                           // step num default is 100 block
 	filterFuzzyAddress bool                             // fuzzy bind contract address(listen for the full number of matching topic events)
+	filterAddresses    []common.Address                 // contract addresses matched in one query, overrides the bound contract address
+	messageJSON        bool                             // fill EthereumEventMessage.Message with the json encoded payload
 	events             []model.ContractEvent            // events
+	topics             eventTopics                      // indexed topic filter
+	decoder            *bind.EventDecoder               // abi driven decoder of the events without a typed wrapper
+	abiEvents          []string                         // abi events decoded by the decoder
 	filterer           *erc1155.StandardERC1155Filterer // Filterer
 }
 
+type eventTopics struct {
+	operator []common.Address
+	from     []common.Address
+	to       []common.Address
+	account  []common.Address
+	uriIds   []*big.Int
+}
+
 type ContractOpts struct {
-	Rpc                string // rpc
-	ContractAddr       string // contract address
-	EnableTransactors  bool   // enable transactors
-	EnableFilter       bool   // enable filter
-	FilterStep         uint64 // the step size of the block interval obtained each time
-	FilterFuzzyAddress bool   // fuzzy bind contract address(listen for the full number of matching topic events)
+	Rpc                string               // rpc
+	Rpcs               []string             // rpc endpoints in priority order with failover, Rpc is used when empty
+	Backend            *backend.Backend     // backend shared by several contracts, overrides Rpc and Rpcs, not closed by ReleaseResource
+	RpcLimiter         *backend.LimiterOpts // client side rate limit and concurrency cap of every rpc endpoint, nil disables it
+	ContractAddr       string               // contract address
+	EnableTransactors  bool                 // enable transactors
+	EnableFilter       bool                 // enable filter
+	FilterStep         uint64               // the step size of the block interval obtained each time
+	FilterFuzzyAddress bool                 // fuzzy bind contract address(listen for the full number of matching topic events)
+	FilterAddresses    []string             // listen for the events of a set of contract addresses in one query
+	FilterMessageJSON  bool                 // also fill EthereumEventMessage.Message with the json encoded payload
+	FilterABI          string               // abi json of a derived contract to decode custom events, default is the standard abi
+	Metrics            metrics.Metrics      // rpc and transaction metrics, default is no-op
+	Logger             logger.Logger        // structured logs of the event filters, default is silent
+	Tracer             tracing.Tracer       // spans of the transaction options, contract calls, sends and log filters, default is no-op
+	Multicall          string               // Multicall3 contract address of the batch reader, empty falls back to json rpc batch requests
 }
 
 type Contract struct {
-	rpc               string                         // rpc
+	backend           *backend.Backend               // backend
+	ownBackend        bool                           // the backend is created by the contract and closed by ReleaseResource
 	chainId           int64                          // chain id
 	contractAddr      common.Address                 // contract address
 	enableTransactors bool                           // enable transactors
@@ -61,6 +89,10 @@ type Contract struct {
 	transactors       map[string]*contractTransactor // transactors
 	caller            *contractCaller                // caller
 	filter            *contractFilterer              // filter
+	multicall         *multicall.Multicall           // batch reader
+	metrics           metrics.Metrics                // metrics
+	tracer            tracing.Tracer                 // tracer
+	logger            logger.Logger                  // logger
 }
 
 func NewContract(ops *ContractOpts) (*Contract, error) {
@@ -73,15 +105,36 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 		return nil, errors.New("invalid address")
 	}
 
+	if ops.EnableFilter && ops.FilterFuzzyAddress && len(ops.FilterAddresses) != 0 {
+		return nil, errors.New("fuzzy address and address set filter can not be enabled together")
+	}
+
 	// 合约地址格式转换
 	contractAddr := common.HexToAddress(ops.ContractAddr)
 
-	// caller 初始化
-	caller.client, err = ethclient.Dial(ops.Rpc)
-	if err != nil {
-		return nil, err
+	// backend 初始化, 配置多个rpc时自动故障切换
+	if ops.Backend != nil {
+		con.backend = ops.Backend
+	} else {
+		rpcs := ops.Rpcs
+		if len(rpcs) == 0 {
+			rpcs = []string{ops.Rpc}
+		}
+		con.backend, err = backend.NewBackend(&backend.BackendOpts{Rpcs: rpcs, Limiter: ops.RpcLimiter, Metrics: ops.Metrics})
+		if err != nil {
+			return nil, err
+		}
+		con.ownBackend = true
+		defer func() {
+			if err != nil {
+				con.backend.Close()
+			}
+		}()
 	}
 
+	// caller 初始化
+	caller.client = con.backend
+
 	chainId, err := caller.client.ChainID(context.Background())
 	if err != nil {
 		return nil, err
@@ -93,21 +146,32 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 	}
 
 	// 填充返回
-	con.rpc = ops.Rpc
 	con.chainId = chainId.Int64()
 	con.contractAddr = contractAddr
 	con.enableTransactors = ops.EnableTransactors
+	con.metrics = metrics.OrNop(ops.Metrics)
+	con.tracer = ops.Tracer
+	con.logger = logger.OrNop(ops.Logger)
 	con.enableFilter = ops.EnableFilter
 	con.caller = &caller
 
+	// 批量读取, 未配置Multicall3合约时使用json rpc batch
+	con.multicall, err = multicall.NewMulticall(con.backend, con.backend, &multicall.MulticallOpts{Address: ops.Multicall})
+	if err != nil {
+		return nil, err
+	}
+
 	if ops.EnableFilter {
 		var filter contractFilterer
-		// filter初始化
-		filter.client, err = ethclient.Dial(ops.Rpc)
+
+		filter.filterAddresses, err = utils.HexToAddresses(ops.FilterAddresses)
 		if err != nil {
 			return nil, err
 		}
 
+		// filter初始化
+		filter.client = con.backend
+
 		filter.filterer, err = erc1155.NewStandardERC1155Filterer(contractAddr, ops.FilterFuzzyAddress, filter.client)
 		if err != nil {
 			return nil, err
@@ -117,7 +181,16 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 		} else {
 			filter.stepNum = ops.FilterStep
 		}
+		metadata := erc1155.StandardERC1155MetaData
+		if ops.FilterABI != "" {
+			metadata = &bind.MetaData{ABI: ops.FilterABI}
+		}
+		filter.decoder, err = bind.NewEventDecoder(contractAddr, ops.FilterFuzzyAddress, metadata, filter.client)
+		if err != nil {
+			return nil, err
+		}
 		filter.filterFuzzyAddress = ops.FilterFuzzyAddress
+		filter.messageJSON = ops.FilterMessageJSON
 		con.filter = &filter
 	}
 
@@ -138,10 +211,7 @@ func (c *Contract) AddTransactors(privateKeys []string) error {
 	for _, k := range privateKeys {
 		var transactor contractTransactor
 
-		transactor.client, err = ethclient.Dial(c.rpc)
-		if err != nil {
-			return err
-		}
+		transactor.client = c.backend
 
 		transactor.key, err = crypto.HexToECDSA(k)
 		if err != nil {
@@ -178,57 +248,146 @@ func (c *Contract) AddEvents(events []model.ContractEvent) error {
 	return nil
 }
 
+func (c *Contract) AddABIEvents(names []string) error {
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	for _, name := range names {
+		if !c.filter.decoder.HasEvent(name) {
+			return fmt.Errorf("unsupported Event:%s, not found in abi", name)
+		}
+		c.filter.abiEvents = append(c.filter.abiEvents, name)
+	}
+
+	return nil
+}
+
+func (c *Contract) SetEventFilter(filter *model.EventFilter) error {
+	var topics eventTopics
+	var err error
+
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	if filter != nil {
+		if topics.operator, err = utils.HexToAddresses(filter.Operator); err != nil {
+			return err
+		}
+		if topics.from, err = utils.HexToAddresses(filter.From); err != nil {
+			return err
+		}
+		if topics.to, err = utils.HexToAddresses(filter.To); err != nil {
+			return err
+		}
+		if topics.account, err = utils.HexToAddresses(filter.Account); err != nil {
+			return err
+		}
+		if topics.uriIds, err = utils.ParseUint256s(filter.UriIds); err != nil {
+			return err
+		}
+	}
+
+	c.filter.topics = topics
+
+	return nil
+}
+
+// GetCallerClient returns the client of the preferred rpc endpoint, use GetBackend for requests with failover
 func (c *Contract) GetCallerClient() *ethclient.Client {
-	return c.caller.client
+	return c.backend.Client()
+}
+
+func (c *Contract) GetBackend() *backend.Backend {
+	return c.backend
 }
 
-func (c *Contract) ReadBalanceOf(inputs *model.MethodReadBalanceOf) (uint64, error) {
+func (c *Contract) ReadBalanceOf(ctx context.Context, inputs *model.MethodReadBalanceOf, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
 
-	balance, err := c.caller.caller.BalanceOf(&bind.CallOpts{}, common.HexToAddress(inputs.Owner), tokenId)
+	balance, err := c.caller.caller.BalanceOf(opts, common.HexToAddress(inputs.Owner), tokenId)
+	if err != nil {
+		return "", err
+	}
+
+	return balance.String(), nil
+}
+
+// ReadBalanceOfUint64 is a compatibility shim for callers of the former uint64 ReadBalanceOf, it fails on overflow
+func (c *Contract) ReadBalanceOfUint64(ctx context.Context, inputs *model.MethodReadBalanceOf, blockTag ...chainModel.BlockTag) (uint64, error) {
+	balance, err := c.ReadBalanceOf(ctx, inputs, blockTag...)
 	if err != nil {
 		return 0, err
 	}
 
-	return balance.Uint64(), nil
+	return utils.String2Uint64(balance)
 }
 
-func (c *Contract) ReadBalanceOfBatch(inputs *model.MethodReadBalanceOfBatchInputs) (*[]uint64, error) {
+func (c *Contract) ReadBalanceOfBatch(ctx context.Context, inputs *model.MethodReadBalanceOfBatchInputs, blockTag ...chainModel.BlockTag) ([]string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return nil, err
+	}
 
 	if len(inputs.Ids) != len(inputs.Owners) || len(inputs.Ids) == 0 {
 		return nil, errors.New("invalid parameter, please check parameter")
 	}
 
 	owners := make([]common.Address, len(inputs.Owners))
-	var ids []*big.Int
-
 	for i, v := range inputs.Owners {
 		owners[i] = common.HexToAddress(v)
 	}
 
-	for _, v := range inputs.Ids {
-		tokenId := utils.String2BigInt(v)
-		ids = append(ids, tokenId)
+	ids, err := utils.ParseUint256s(inputs.Ids)
+	if err != nil {
+		return nil, err
 	}
 
-	balances, err := c.caller.caller.BalanceOfBatch(&bind.CallOpts{}, owners, ids)
+	balances, err := c.caller.caller.BalanceOfBatch(opts, owners, ids)
 	if err != nil {
 		return nil, err
 	}
-	bBalances := make([]uint64, len(balances))
 
+	return utils.BigInts2Strings(balances), nil
+}
+
+// ReadBalanceOfBatchUint64 is a compatibility shim for callers of the former uint64 ReadBalanceOfBatch, it fails on overflow
+func (c *Contract) ReadBalanceOfBatchUint64(ctx context.Context, inputs *model.MethodReadBalanceOfBatchInputs, blockTag ...chainModel.BlockTag) (*[]uint64, error) {
+	balances, err := c.ReadBalanceOfBatch(ctx, inputs, blockTag...)
+	if err != nil {
+		return nil, err
+	}
+
+	bBalances := make([]uint64, len(balances))
 	for i, v := range balances {
-		bBalances[i] = v.Uint64()
+		if bBalances[i], err = utils.String2Uint64(v); err != nil {
+			return nil, err
+		}
 	}
 
 	return &bBalances, nil
 }
 
-func (c *Contract) ReadIsApprovedForAll(inputs *model.MethodReadIsApprovedForAllInputs) (bool, error) {
+func (c *Contract) ReadIsApprovedForAll(ctx context.Context, inputs *model.MethodReadIsApprovedForAllInputs, blockTag ...chainModel.BlockTag) (bool, error) {
 
-	approved, err := c.caller.caller.IsApprovedForAll(&bind.CallOpts{}, common.HexToAddress(inputs.Owner), common.HexToAddress(inputs.Operator))
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return false, err
+	}
+
+	approved, err := c.caller.caller.IsApprovedForAll(opts, common.HexToAddress(inputs.Owner), common.HexToAddress(inputs.Operator))
 	if err != nil {
 		return false, err
 	}
@@ -236,20 +395,20 @@ func (c *Contract) ReadIsApprovedForAll(inputs *model.MethodReadIsApprovedForAll
 	return approved, nil
 }
 
-func (c *Contract) ReadSupportsInterface(interfaceId string) (bool, error) {
-	var idBytes4 [4]byte
+func (c *Contract) ReadSupportsInterface(ctx context.Context, interfaceId string, blockTag ...chainModel.BlockTag) (bool, error) {
 
-	if !strings.HasPrefix(interfaceId, "0x") && len(interfaceId) != 10 {
-		return false, errors.New("invalid parameter, please check parameter, must with hex prefix")
-	}
-	idBytes, err := hexutil.Decode(interfaceId)
+	opts, err := c.callOpts(ctx, blockTag)
 	if err != nil {
 		return false, err
 	}
 
-	copy(idBytes4[:], idBytes[:4])
+	// 参数处理
+	idBytes4, err := parseInterfaceId(interfaceId)
+	if err != nil {
+		return false, err
+	}
 
-	supported, err := c.caller.caller.SupportsInterface(&bind.CallOpts{}, idBytes4)
+	supported, err := c.caller.caller.SupportsInterface(opts, idBytes4)
 	if err != nil {
 		return false, err
 	}
@@ -257,12 +416,36 @@ func (c *Contract) ReadSupportsInterface(interfaceId string) (bool, error) {
 	return supported, nil
 }
 
-func (c *Contract) ReadUri(id string) (string, error) {
+// ReadOwner returns the Ownable owner of the contract
+func (c *Contract) ReadOwner(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := c.caller.caller.Owner(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return owner.Hex(), nil
+}
+
+func (c *Contract) ReadUri(ctx context.Context, id string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(id)
+	tokenId, err := utils.ParseUint256(id)
+	if err != nil {
+		return "", err
+	}
 
-	uri, err := c.caller.caller.Uri(&bind.CallOpts{}, tokenId)
+	uri, err := c.caller.caller.Uri(opts, tokenId)
 	if err != nil {
 		return "", err
 	}
@@ -270,7 +453,7 @@ func (c *Contract) ReadUri(id string) (string, error) {
 	return uri, nil
 }
 
-func (c *Contract) WriteSafeTransferFrom(txNonce uint64, inputs *model.MethodWriteSafeTransferFromInputs) (string, error) {
+func (c *Contract) WriteSafeTransferFrom(ctx context.Context, txNonce uint64, inputs *model.MethodWriteSafeTransferFromInputs) (string, error) {
 
 	if !c.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
@@ -281,24 +464,33 @@ func (c *Contract) WriteSafeTransferFrom(txNonce uint64, inputs *model.MethodWri
 	}
 
 	// 获取Transactor参数
-	opts, err := c.genTransactorOptions(inputs.From, txNonce, 0)
+	opts, err := c.genTransactorOptions(ctx, inputs.From, txNonce, nil)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
+	amount, err := utils.ParseUint256(inputs.Amount)
+	if err != nil {
+		return "", err
+	}
 
 	// 提交交易
-	tx, err := c.transactors[inputs.From].transactor.SafeTransferFrom(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), tokenId, big.NewInt(inputs.Amount), inputs.Data)
+	tx, err := c.transactors[inputs.From].transactor.SafeTransferFrom(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), tokenId, amount, inputs.Data)
 	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
 		return "", err
 	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "safeTransferFrom")
 
 	return tx.Hash().String(), nil
 }
 
-func (c *Contract) WriteSafeBatchTransferFrom(txNonce uint64, inputs *model.MethodWriteSafeBatchTransferFromInputs) (string, error) {
+func (c *Contract) WriteSafeBatchTransferFrom(ctx context.Context, txNonce uint64, inputs *model.MethodWriteSafeBatchTransferFromInputs) (string, error) {
 	if !c.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
 	}
@@ -308,32 +500,33 @@ func (c *Contract) WriteSafeBatchTransferFrom(txNonce uint64, inputs *model.Meth
 	}
 
 	// 获取Transactor参数
-	opts, err := c.genTransactorOptions(inputs.From, txNonce, 0)
+	opts, err := c.genTransactorOptions(ctx, inputs.From, txNonce, nil)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	var ids []*big.Int
-	for _, v := range inputs.Ids {
-		tokenId := utils.String2BigInt(v)
-		ids = append(ids, tokenId)
+	ids, err := utils.ParseUint256s(inputs.Ids)
+	if err != nil {
+		return "", err
 	}
 
-	var amounts []*big.Int
-	for _, a := range inputs.Amounts {
-		amounts = append(amounts, big.NewInt(a))
+	amounts, err := utils.ParseUint256s(inputs.Amounts)
+	if err != nil {
+		return "", err
 	}
 
 	tx, err := c.transactors[inputs.From].transactor.SafeBatchTransferFrom(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), ids, amounts, inputs.Data)
 	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
 		return "", err
 	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "safeBatchTransferFrom")
 
 	return tx.Hash().String(), nil
 }
 
-func (c *Contract) WriteSetApprovalForAll(senderAddress string, txNonce uint64, inputs *model.MethodWriteSetApprovalForAllInputs) (string, error) {
+func (c *Contract) WriteSetApprovalForAll(ctx context.Context, senderAddress string, txNonce uint64, inputs *model.MethodWriteSetApprovalForAllInputs) (string, error) {
 	if !c.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
 	}
@@ -342,27 +535,29 @@ func (c *Contract) WriteSetApprovalForAll(senderAddress string, txNonce uint64,
 	}
 
 	// 获取Transactor参数
-	opts, err := c.genTransactorOptions(senderAddress, txNonce, 0)
+	opts, err := c.genTransactorOptions(ctx, senderAddress, txNonce, nil)
 	if err != nil {
 		return "", err
 	}
 
 	tx, err := c.transactors[senderAddress].transactor.SetApprovalForAll(opts, common.HexToAddress(inputs.Operator), inputs.Approved)
 	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
 		return "", err
 	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "setApprovalForAll")
 
 	return tx.Hash().String(), nil
 }
 
-func (c *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
+func (c *Contract) FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
 	var events, eventsAll []*chainModel.EthereumEventMessage
 
 	if !c.enableFilter {
 		return nil, errors.New("the filter is not supported. check the instantiation parameters")
 	}
 
-	latestBlockNum, err := utils.GetLatestBlockNumWithClient(c.caller.client)
+	latestBlockNum, err := c.backend.BlockNumber(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -370,6 +565,10 @@ func (c *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint64) ([]*
 	if latestBlockNum < *stopBlockNum {
 		*stopBlockNum = latestBlockNum
 	}
+	// 起始区块超过最新区块时不发送from > to的查询, 节点会拒绝
+	if startBlockNum > *stopBlockNum {
+		return nil, nil
+	}
 	if *stopBlockNum > startBlockNum+c.filter.stepNum {
 		errMsg := fmt.Sprintf("Max Filter step num is %d, current is %d", c.filter.stepNum, *stopBlockNum-startBlockNum)
 		return nil, errors.New(errMsg)
@@ -378,8 +577,10 @@ func (c *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint64) ([]*
 	stop := stopBlockNum
 
 	opts := &bind.FilterOpts{
-		Start: startBlockNum,
-		End:   stop,
+		Context:   c.traceContext(ctx),
+		Start:     startBlockNum,
+		End:       stop,
+		Addresses: c.filter.filterAddresses,
 	}
 	for _, e := range c.filter.events {
 
@@ -408,30 +609,75 @@ func (c *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint64) ([]*
 				return nil, err
 			}
 
+		case model.EventOwnershipTransferred:
+			events, err = c.eventGeneric(opts, model.SupportEvents[e])
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			errMsg := fmt.Sprintf("unsupported Event:%s", model.SupportEvents[e])
 			return nil, errors.New(errMsg)
 		}
 
 		if len(events) != 0 {
-			log.Printf("Filter for %d out %d \"%s\" messages, from %d -- %d ", c.chainId, len(events), model.SupportEvents[e], startBlockNum, *stopBlockNum)
+			c.logger.Debug("filter events", logger.F("chain_id", c.chainId), logger.F("event", model.SupportEvents[e]), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
+			eventsAll = utils.MergeEventMessage(eventsAll, events)
+		}
+	}
+	for _, name := range c.filter.abiEvents {
+		events, err = c.eventGeneric(opts, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) != 0 {
+			c.logger.Debug("filter events", logger.F("chain_id", c.chainId), logger.F("event", name), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
 			eventsAll = utils.MergeEventMessage(eventsAll, events)
 		}
 	}
 	if len(eventsAll) != 0 {
-		log.Printf("Filter for %d out total %d messages, from %d -- %d ", c.chainId, len(eventsAll), startBlockNum, *stopBlockNum)
+		c.logger.Info("filter events done", logger.F("chain_id", c.chainId), logger.F("count", len(eventsAll)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
 	}
 	return eventsAll, nil
 }
 
+// WaitTransaction waits until the transaction is mined and records it as confirmed or failed by its receipt status
+func (c *Contract) WaitTransaction(ctx context.Context, txId string) (*types.Receipt, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := c.backend.TransactionReceipt(ctx, common.HexToHash(txId))
+		if err == nil {
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				c.metrics.TxConfirmed(c.contractAddr.Hex())
+			} else {
+				c.metrics.TxFailed(c.contractAddr.Hex())
+			}
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 func (c *Contract) ReleaseResource() {
 
-	//释放caller
-	c.caller.client.Close()
+	//释放backend, 共享的backend由创建者释放
+	if c.ownBackend {
+		c.backend.Close()
+	}
 
 	//释放filter
 	if c.enableFilter {
-		c.filter.client.Close()
 		c.filter.events = nil
 	}
 
@@ -439,14 +685,17 @@ func (c *Contract) ReleaseResource() {
 
 	if c.enableTransactors {
 		for _, v := range c.transactors {
-			v.client.Close()
 			v.key = nil
 		}
 		c.transactors = make(map[string]*contractTransactor)
 	}
 }
 
-func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce uint64, payableValue float64) (*bind.TransactOpts, error) {
+func (_Contract *Contract) genTransactorOptions(ctx context.Context, providerAddress string, txNonce uint64, payableValue *big.Int) (_ *bind.TransactOpts, err error) {
+	ctx = _Contract.traceContext(ctx)
+	spanCtx, span := tracing.Start(ctx, "genTransactorOptions", tracing.String(tracing.AttrFrom, providerAddress))
+	defer func() { span.End(err) }()
+
 	// 填充TransactOpts结构
 	opts, err := bind.NewKeyedTransactorWithChainID(_Contract.transactors[providerAddress].key, big.NewInt(_Contract.chainId))
 	if err != nil {
@@ -459,23 +708,27 @@ func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce
 	}
 
 	// 支付Native货币数量
-	if payableValue > 0 {
-		decimal := big.NewFloat(math.Pow(10, 18))
-		convertPayableValue, _ := new(big.Float).Mul(decimal, big.NewFloat(payableValue)).Int(&big.Int{})
-		opts.Value = convertPayableValue
+	if payableValue != nil && payableValue.Sign() > 0 {
+		opts.Value = payableValue
 	}
 
 	//自定义gas limit
 	opts.GasLimit = chainModel.TRANSCATION_MAX_GAS_LIMINT
 
-	// 获取网络手续费
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(3)*time.Second)
+	// 获取网络手续费, ctx未设置deadline时默认3秒超时
+	gasCtx, cancel := spanCtx, context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok {
+		gasCtx, cancel = context.WithTimeout(spanCtx, time.Duration(3)*time.Second)
+	}
 	defer cancel()
-	gasPrice, err := _Contract.transactors[providerAddress].client.SuggestGasPrice(ctx)
+	gasPrice, err := _Contract.transactors[providerAddress].client.SuggestGasPrice(gasCtx)
 	if err != nil {
 		return nil, err
 	}
 	opts.GasPrice = gasPrice
+	span.SetAttributes(tracing.String(tracing.AttrGasPrice, gasPrice.String()))
+	// bind的Call/transact/FilterLogs从opts.Context取tracer
+	opts.Context = ctx
 
 	return opts, nil
 }
@@ -483,7 +736,7 @@ func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce
 func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterApprovalForAll(opts, []common.Address{}, []common.Address{})
+	iter, err := _Contract.filter.filterer.FilterApprovalForAll(opts, _Contract.filter.topics.account, _Contract.filter.topics.operator)
 	if err != nil {
 		return nil, err
 	}
@@ -499,12 +752,11 @@ func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainM
 				Operator: iter.Event.Operator.Hex(),
 				Approved: iter.Event.Approved,
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-			event := _Contract.eventMsgCommonFill(model.EventApprovalForAll, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: ApprovalForAll get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -517,7 +769,7 @@ func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainM
 func (_Contract *Contract) eventURI(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterURI(opts, []*big.Int{})
+	iter, err := _Contract.filter.filterer.FilterURI(opts, _Contract.filter.topics.uriIds)
 	if err != nil {
 		return nil, err
 	}
@@ -531,12 +783,11 @@ func (_Contract *Contract) eventURI(opts *bind.FilterOpts) ([]*chainModel.Ethere
 				Value: iter.Event.Value,
 				Id:    iter.Event.Id.String(),
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-			event := _Contract.eventMsgCommonFill(model.EventURI, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: URI get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -548,7 +799,7 @@ func (_Contract *Contract) eventURI(opts *bind.FilterOpts) ([]*chainModel.Ethere
 func (_Contract *Contract) eventTransferSingle(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterTransferSingle(opts, []common.Address{}, []common.Address{}, []common.Address{})
+	iter, err := _Contract.filter.filterer.FilterTransferSingle(opts, _Contract.filter.topics.operator, _Contract.filter.topics.from, _Contract.filter.topics.to)
 	if err != nil {
 		return nil, err
 	}
@@ -564,15 +815,13 @@ func (_Contract *Contract) eventTransferSingle(opts *bind.FilterOpts) ([]*chainM
 				From:  iter.Event.From.Hex(),
 				To:    iter.Event.To.Hex(),
 				Id:    iter.Event.Id.String(),
-				Value: iter.Event.Value.Uint64(),
+				Value: iter.Event.Value.String(),
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-
-			event := _Contract.eventMsgCommonFill(model.EventTransferSingle, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: TransferSingle get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -585,7 +834,7 @@ func (_Contract *Contract) eventTransferSingle(opts *bind.FilterOpts) ([]*chainM
 func (_Contract *Contract) eventTransferBatch(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterTransferBatch(opts, []common.Address{}, []common.Address{}, []common.Address{})
+	iter, err := _Contract.filter.filterer.FilterTransferBatch(opts, _Contract.filter.topics.operator, _Contract.filter.topics.from, _Contract.filter.topics.to)
 	if err != nil {
 		return nil, err
 	}
@@ -595,27 +844,18 @@ func (_Contract *Contract) eventTransferBatch(opts *bind.FilterOpts) ([]*chainMo
 
 	for {
 		if iter.Next() {
-			var ids []string
-			var values []uint64
-			for _, i := range iter.Event.Ids {
-				ids = append(ids, i.String())
-			}
-			for _, v := range iter.Event.Values {
-				values = append(values, v.Uint64())
-			}
 			message := &model.Event4TransferBatch{
 				Operator: iter.Event.Operator.Hex(),
 				From:     iter.Event.From.Hex(),
 				To:       iter.Event.To.Hex(),
-				Ids:      ids,
-				Values:   values,
+				Ids:      utils.BigInts2Strings(iter.Event.Ids),
+				Values:   utils.BigInts2Strings(iter.Event.Values),
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-			event := _Contract.eventMsgCommonFill(model.EventTransferBatch, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: TransferBatch get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -625,18 +865,115 @@ func (_Contract *Contract) eventTransferBatch(opts *bind.FilterOpts) ([]*chainMo
 	return events, nil
 }
 
-func (_Contract *Contract) eventMsgCommonFill(event model.ContractEvent, logs types.Log, msg string) *chainModel.EthereumEventMessage {
+func (_Contract *Contract) eventGeneric(opts *bind.FilterOpts, name string) ([]*chainModel.EthereumEventMessage, error) {
+	var events []*chainModel.EthereumEventMessage
+
+	decoded, err := _Contract.filter.decoder.FilterEvent(opts, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range decoded {
+		message := &chainModel.Event4Generic{
+			Name: d.Name,
+			Args: utils.AbiArgs2JSON(d.Args),
+		}
+		event, err := _Contract.eventMsgCommonFill(d.Raw, message)
+		if err != nil {
+			return nil, err
+		}
+		_Contract.logEvent(event)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// logEvent 以debug级别记录过滤到的事件
+func (_Contract *Contract) logEvent(event *chainModel.EthereumEventMessage) {
+	_Contract.logger.Debug("new event", logger.F("chain_id", event.ChainId), logger.F("event", event.Event), logger.F("contract", event.Contract),
+		logger.F("block", event.BlockNumber), logger.F("tx", event.TxId), logger.F("index", event.BlockIndex))
+}
+
+func (_Contract *Contract) eventMsgCommonFill(logs types.Log, payload chainModel.EventPayload) (*chainModel.EthereumEventMessage, error) {
 	commonMsg := &chainModel.EthereumEventMessage{
 		ChainId:     _Contract.chainId,
-		Contract:    _Contract.contractAddr.Hex(),
+		Contract:    logs.Address.Hex(),
 		BlockNumber: logs.BlockNumber,
 		TxId:        logs.TxHash.String(),
 		BlockIndex:  uint64(logs.Index),
-		Event:       model.SupportEvents[event],
-		Message:     msg,
+		Event:       payload.EventName(),
+		Standard:    model.Standard,
+		Payload:     payload,
 	}
 
-	return commonMsg
+	// json格式化消息内容(按需开启)
+	if _Contract.filter.messageJSON {
+		messageBytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		commonMsg.Message = string(messageBytes)
+	}
+
+	return commonMsg, nil
+}
+
+// traceContext 为ctx附加tracer及链id和合约地址属性
+func (_Contract *Contract) traceContext(ctx context.Context) context.Context {
+	return tracing.ContextWithTracer(ctx, _Contract.tracer,
+		tracing.Int64(tracing.AttrChainId, _Contract.chainId), tracing.String(tracing.AttrContract, _Contract.contractAddr.Hex()))
+}
+
+// callOpts converts the optional block tag of a Read* method into the call options, the latest state is read without tag
+func (_Contract *Contract) callOpts(ctx context.Context, blockTag []chainModel.BlockTag) (*bind.CallOpts, error) {
+	opts := &bind.CallOpts{Context: _Contract.traceContext(ctx)}
+	if len(blockTag) == 0 {
+		return opts, nil
+	}
+	if len(blockTag) > 1 {
+		return nil, errors.New("invalid parameter, only one block tag is allowed")
+	}
+
+	switch tag := blockTag[0]; tag {
+	case "", chainModel.BlockLatest:
+	case chainModel.BlockPending:
+		opts.Pending = true
+	case chainModel.BlockSafe, chainModel.BlockFinalized:
+		// ethclient不支持safe/finalized, 先查询对应的区块高度
+		number, err := utils.GetBlockNumByTagWithClient(ctx, _Contract.backend, string(tag))
+		if err != nil {
+			return nil, err
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(number)
+	default:
+		number, ok := tag.Number()
+		if !ok {
+			return nil, fmt.Errorf("invalid block tag:%s", tag)
+		}
+		opts.BlockNumber = number
+	}
+
+	return opts, nil
+}
+
+func parseInterfaceId(interfaceId string) ([4]byte, error) {
+	var idBytes4 [4]byte
+
+	if !strings.HasPrefix(interfaceId, "0x") && len(interfaceId) != 10 {
+		return idBytes4, errors.New("invalid parameter, please check parameter, must with hex prefix")
+	}
+	idBytes, err := hexutil.Decode(interfaceId)
+	if err != nil {
+		return idBytes4, err
+	}
+	if len(idBytes) < 4 {
+		return idBytes4, errors.New("invalid parameter, interface id must be 4 bytes")
+	}
+
+	copy(idBytes4[:], idBytes[:4])
+
+	return idBytes4, nil
 }
 
 func (_Contract *Contract) isTransactorExist(addr string) bool {
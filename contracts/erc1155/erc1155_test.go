@@ -1,7 +1,9 @@
 package erc1155
 
 import (
+	"context"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
 	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
 	"github.com/jason-bateman/go-erc-standard-contract/utils"
 	"testing"
@@ -43,18 +45,18 @@ func TestContract_ReadContract(t *testing.T) {
 		Owner: "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
 		Id:    "110801524474586856940016194582843495297783442968439414267952739331789173737983",
 	}
-	balance, err := contract.ReadBalanceOf(balanceOfInputs)
+	balance, err := contract.ReadBalanceOf(context.Background(), balanceOfInputs)
 	if err != nil {
 		t.Errorf("ReadBalanceOf err:%+v\n", err)
 		return
 	}
-	t.Logf("balance is:%d,\n", balance)
+	t.Logf("balance is:%s,\n", balance)
 
 	isApprovedForAllInputs := &model.MethodReadIsApprovedForAllInputs{
 		Owner:    "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
 		Operator: "0x604e91519c3f515d93050ae3b909d9ad037085b5",
 	}
-	approved, err := contract.ReadIsApprovedForAll(isApprovedForAllInputs)
+	approved, err := contract.ReadIsApprovedForAll(context.Background(), isApprovedForAllInputs)
 	if err != nil {
 		t.Errorf("ReadBalanceOf err:%+v\n", err)
 		return
@@ -65,21 +67,21 @@ func TestContract_ReadContract(t *testing.T) {
 		Owners: []string{"0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F", "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"},
 		Ids:    []string{"110801524474586856940016194582843495297783442968439414267952739331789173737983", "110801524474586856940016194582843495297783442968439414267952739331789173737983"},
 	}
-	batchBalance, err := contract.ReadBalanceOfBatch(balanceOfBatchInputs)
+	batchBalance, err := contract.ReadBalanceOfBatch(context.Background(), balanceOfBatchInputs)
 	if err != nil {
 		t.Errorf("ReadBalanceOfBatch err:%+v\n", err)
 		return
 	}
 	t.Log("batchBalance is:\n", batchBalance)
 
-	uri, err := contract.ReadUri("110801524474586856940016194582843495297783442968439414267952739331789173737983")
+	uri, err := contract.ReadUri(context.Background(), "110801524474586856940016194582843495297783442968439414267952739331789173737983")
 	if err != nil {
 		t.Errorf("ReadUri err:%+v\n", err)
 		return
 	}
 	t.Log("uri is:\n", uri)
 
-	isSupport, err := contract.ReadSupportsInterface("0x01ffc9a7")
+	isSupport, err := contract.ReadSupportsInterface(context.Background(), "0x01ffc9a7")
 	if err != nil {
 		t.Errorf("ReadSupportsInterface err:%+v\n", err)
 		return
@@ -88,6 +90,35 @@ func TestContract_ReadContract(t *testing.T) {
 
 }
 
+func TestContract_ReadBatch(t *testing.T) {
+	id := "110801524474586856940016194582843495297783442968439414267952739331789173737983"
+	chain := testchain.New(t, 1)
+
+	contract, err := Deploy(context.Background(), chain.Accounts[0].Signer, chain.Backend, "ipfs://{id}")
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource() // releasing resources
+
+	batch := contract.NewBatch()
+	balance := batch.ReadBalanceOf(&model.MethodReadBalanceOf{Owner: "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F", Id: id})
+	uri := batch.ReadUri(id)
+	invalid := batch.ReadUri("-1")
+
+	if err = batch.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	if invalid.Success {
+		t.Error("invalid token id should fail")
+	}
+	if !balance.Success || balance.Value != "0" {
+		t.Errorf("balance:%+v\n", balance)
+	}
+	if !uri.Success || uri.Value != "ipfs://{id}" {
+		t.Errorf("uri:%+v\n", uri)
+	}
+}
+
 func TestContract_WriteSafeTransferFrom(t *testing.T) {
 	toAddress := "0x604e91519c3F515D93050AE3B909d9AD037085b5"
 	senderPrivateKey := "Add the private key to this variable"
@@ -114,17 +145,17 @@ func TestContract_WriteSafeTransferFrom(t *testing.T) {
 		From:   senderAddress,
 		To:     toAddress,
 		Id:     tokenId,
-		Amount: 0x01,
+		Amount: "1",
 		Data:   []byte(""),
 	}
 
-	txNonce, err := utils.GetAddressTxNonceWithClient(contract.GetCallerClient(), senderAddress)
+	txNonce, err := utils.GetAddressTxNonceWithClient(context.Background(), contract.GetCallerClient(), senderAddress)
 	if err != nil {
 		t.Errorf("GetAddressTxNonceWithClient err:%+v\n", err)
 		return
 	}
 
-	txId, err := contract.WriteSafeTransferFrom(*txNonce, inputs)
+	txId, err := contract.WriteSafeTransferFrom(context.Background(), *txNonce, inputs)
 	if err != nil {
 		t.Errorf("WriteSafeTransferFrom err:%+v\n", err)
 		return
@@ -159,17 +190,17 @@ func TestContract_WriteBatchSafeTransferFrom(t *testing.T) {
 		From:    senderAddress,
 		To:      toAddress,
 		Ids:     []string{tokenId, tokenId},
-		Amounts: []int64{0x01, 0x01},
+		Amounts: []string{"1", "1"},
 		Data:    []byte(""),
 	}
 
-	txNonce, err := utils.GetAddressTxNonceWithClient(contract.GetCallerClient(), senderAddress)
+	txNonce, err := utils.GetAddressTxNonceWithClient(context.Background(), contract.GetCallerClient(), senderAddress)
 	if err != nil {
 		t.Errorf("GetAddressTxNonceWithClient err:%+v\n", err)
 		return
 	}
 
-	txId, err := contract.WriteSafeBatchTransferFrom(*txNonce, inputs)
+	txId, err := contract.WriteSafeBatchTransferFrom(context.Background(), *txNonce, inputs)
 	if err != nil {
 		t.Errorf("WriteSafeBatchTransferFrom err:%+v\n", err)
 		return
@@ -204,7 +235,7 @@ func TestFilterContractLogs(t *testing.T) {
 	//deployBlockNum := uint64(480883)
 	deployBlockNum := uint64(1754605)
 
-	nowBlockNum, err := utils.GetLatestBlockNumWithClient(contract.GetCallerClient())
+	nowBlockNum, err := utils.GetLatestBlockNumWithClient(context.Background(), contract.GetCallerClient())
 	if err != nil {
 		t.Errorf("GetLatestBlockNum err:%+v\n", err)
 		return
@@ -218,7 +249,7 @@ func TestFilterContractLogs(t *testing.T) {
 			break
 		}
 		t.Logf("Filter Start from %d -- %d", start, stop)
-		events, err := contract.FilterEvents(start, &stop)
+		events, err := contract.FilterEvents(context.Background(), start, &stop)
 		if err != nil {
 			t.Errorf("FilterEvents err:%+v\n", err)
 		}
@@ -259,7 +290,7 @@ func TestFilterFuzzyContractLogs(t *testing.T) {
 	//deployBlockNum := uint64(480883)
 	deployBlockNum := uint64(1754605)
 
-	nowBlockNum, err := utils.GetLatestBlockNumWithClient(contract.GetCallerClient())
+	nowBlockNum, err := utils.GetLatestBlockNumWithClient(context.Background(), contract.GetCallerClient())
 	if err != nil {
 		t.Errorf("GetLatestBlockNum err:%+v\n", err)
 		return
@@ -273,7 +304,7 @@ func TestFilterFuzzyContractLogs(t *testing.T) {
 			break
 		}
 		t.Logf("Filter Start from %d -- %d", start, stop)
-		events, err := contract.FilterEvents(start, &stop)
+		events, err := contract.FilterEvents(context.Background(), start, &stop)
 		if err != nil {
 			t.Errorf("FilterEvents err:%+v\n", err)
 		}
@@ -1,17 +1,27 @@
 package model
 
+import (
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/utils"
+)
+
+// Standard is the standard of the event messages, it selects the payload types when they are decoded from json
+const Standard = chainModel.StandardERC1155
+
 type ContractEvent int
 
 //event TransferSingle(address indexed _operator, address indexed _from, address indexed _to, uint256 _id, uint256 _value);
 //event TransferBatch(address indexed _operator, address indexed _from, address indexed _to, uint256[] _ids, uint256[] _values);
 //event ApprovalForAll(address indexed _owner, address indexed _operator, bool _approved);
 //event URI(string _value, uint256 indexed _id);
+//event OwnershipTransferred(address indexed previousOwner, address indexed newOwner); decoded as chainModel.Event4Generic
 
 const (
 	EventTransferSingle ContractEvent = iota
 	EventTransferBatch
 	EventApprovalForAll
 	EventURI
+	EventOwnershipTransferred
 )
 
 // Event4TransferSingle
@@ -21,7 +31,7 @@ type Event4TransferSingle struct {
 	From     string `json:"from"`
 	To       string `json:"to"`
 	Id       string `json:"id"`
-	Value    uint64 `json:"value"`
+	Value    string `json:"value"` // decimal uint256
 }
 
 // Event4TransferBatch
@@ -31,7 +41,7 @@ type Event4TransferBatch struct {
 	From     string   `json:"from"`
 	To       string   `json:"to"`
 	Ids      []string `json:"ids"`
-	Values   []uint64 `json:"value"`
+	Values   []string `json:"value"` // decimal uint256
 }
 
 // Event4ApprovalForAll
@@ -49,9 +59,53 @@ type Event4URI struct {
 	Id    string `json:"id"`
 }
 
+// ValueUint64 is a compatibility shim for callers of the former uint64 Value, it fails on overflow
+func (e *Event4TransferSingle) ValueUint64() (uint64, error) {
+	return utils.String2Uint64(e.Value)
+}
+
+// ValuesUint64 is a compatibility shim for callers of the former uint64 Values, it fails on overflow
+func (e *Event4TransferBatch) ValuesUint64() ([]uint64, error) {
+	values := make([]uint64, len(e.Values))
+	for i, v := range e.Values {
+		value, err := utils.String2Uint64(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (e *Event4TransferSingle) EventName() string { return SupportEvents[EventTransferSingle] }
+
+func (e *Event4TransferBatch) EventName() string { return SupportEvents[EventTransferBatch] }
+
+func (e *Event4ApprovalForAll) EventName() string { return SupportEvents[EventApprovalForAll] }
+
+func (e *Event4URI) EventName() string { return SupportEvents[EventURI] }
+
 var SupportEvents = map[ContractEvent]string{
-	EventTransferSingle: "TransferSingle",
-	EventTransferBatch:  "TransferBatch",
-	EventApprovalForAll: "ApprovalForAll",
-	EventURI:            "URI",
+	EventTransferSingle:       "TransferSingle",
+	EventTransferBatch:        "TransferBatch",
+	EventApprovalForAll:       "ApprovalForAll",
+	EventURI:                  "URI",
+	EventOwnershipTransferred: "OwnershipTransferred",
+}
+
+// 注册类型化的Payload, EthereumEventMessage反序列化时使用
+func init() {
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventTransferSingle], func() chainModel.EventPayload { return &Event4TransferSingle{} })
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventTransferBatch], func() chainModel.EventPayload { return &Event4TransferBatch{} })
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventApprovalForAll], func() chainModel.EventPayload { return &Event4ApprovalForAll{} })
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventURI], func() chainModel.EventPayload { return &Event4URI{} })
+}
+
+// EventFilter narrows the filtered events down by their indexed topics, an empty field matches everything
+type EventFilter struct {
+	Operator []string `json:"operator"` // TransferSingle, TransferBatch and ApprovalForAll operator
+	From     []string `json:"from"`     // TransferSingle and TransferBatch from
+	To       []string `json:"to"`       // TransferSingle and TransferBatch to
+	Account  []string `json:"account"`  // ApprovalForAll account
+	UriIds   []string `json:"uri_ids"`  // URI id
 }
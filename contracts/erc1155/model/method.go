@@ -37,7 +37,7 @@ type MethodWriteSafeTransferFromInputs struct {
 	From   string `json:"from"`
 	To     string `json:"to"`
 	Id     string `json:"id"`
-	Amount int64  `json:"amount"`
+	Amount string `json:"amount"` // decimal or 0x prefixed hex uint256
 	Data   []byte `json:"data"`
 }
 
@@ -47,7 +47,7 @@ type MethodWriteSafeBatchTransferFromInputs struct {
 	From    string   `json:"from"`
 	To      string   `json:"to"`
 	Ids     []string `json:"ids"`
-	Amounts []int64  `json:"amounts"`
+	Amounts []string `json:"amounts"` // decimal or 0x prefixed hex uint256
 	Data    []byte   `json:"data"`
 }
 
@@ -57,3 +57,10 @@ type MethodWriteSetApprovalForAllInputs struct {
 	Operator string `json:"operator"`
 	Approved bool   `json:"approved"`
 }
+
+// MethodWriteUpgradeToInputs
+//function upgradeToAndCall(address newImplementation, bytes memory data) external payable;
+type MethodWriteUpgradeToInputs struct {
+	NewImplementation string `json:"new_implementation"`
+	Data              string `json:"data"` // hex encoded call delegated to the new implementation, empty calls upgradeTo
+}
@@ -0,0 +1,117 @@
+package erc1155
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc1155 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/deploy"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/proxy"
+)
+
+// ProxyOpts are the optional settings of DeployProxy
+type ProxyOpts struct {
+	Implementation string        // deployed StandardERC1155Upgradeable, or StandardERC1155Initializable behind an Admin; empty deploys the one matching Admin first
+	Admin          string        // admin of a transparent proxy, empty deploys a UUPS proxy which the owner upgrades
+	Owner          string        // owner passed to initialize, default is the signer
+	Contract       *ContractOpts // options of the returned Contract, Backend and ContractAddr are set by DeployProxy
+}
+
+// DeployProxy deploys an EIP-1967 proxy of a StandardERC1155Upgradeable implementation, or StandardERC1155Initializable
+// behind an Admin, and initializes it with the uri and the owner in the same transaction, the returned Contract is
+// bound to the proxy. Without Admin the owner upgrades the proxy with WriteUpgradeTo, with Admin only the admin can
+// upgrade and it can not use the collection through the proxy. The Contract shares the backend, ReleaseResource does
+// not close it.
+func DeployProxy(ctx context.Context, signer *bind.TransactOpts, backend *backend.Backend, uri string, opts ...*ProxyOpts) (*Contract, error) {
+	if len(opts) > 1 {
+		return nil, errors.New("invalid parameter, only one proxy options is allowed")
+	}
+	ops := &ProxyOpts{}
+	if len(opts) == 1 && opts[0] != nil {
+		ops = opts[0]
+	}
+
+	address, err := deploy.Proxy(ctx, signer, backend, ops.Implementation, ops.Admin, ops.Owner, deployImplementation(backend), func(owner common.Address) ([]byte, error) {
+		parsed, err := erc1155.StandardERC1155UpgradeableMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		return parsed.Pack("initialize", uri, owner)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bindContract(backend, address, ops.Contract)
+}
+
+// deployImplementation deploys the embedded StandardERC1155Upgradeable bytecode, or StandardERC1155Initializable behind a
+// transparent proxy so that only the admin upgrades
+func deployImplementation(backend *backend.Backend) func(auth *bind.TransactOpts, transparent bool) (*types.Transaction, error) {
+	return func(auth *bind.TransactOpts, transparent bool) (*types.Transaction, error) {
+		var tx *types.Transaction
+		var err error
+		if transparent {
+			_, tx, _, err = erc1155.DeployStandardERC1155Initializable(auth, backend)
+		} else {
+			_, tx, _, err = erc1155.DeployStandardERC1155Upgradeable(auth, backend)
+		}
+		return tx, err
+	}
+}
+
+// ReadProxyAdmin returns the admin in the EIP-1967 admin slot, empty when the contract is no transparent proxy
+func (c *Contract) ReadProxyAdmin(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	admin, err := proxy.ReadSlot(opts, c.backend, c.contractAddr, proxy.AdminSlot)
+	if err != nil || admin == (common.Address{}) {
+		return "", err
+	}
+	return admin.Hex(), nil
+}
+
+// WriteUpgradeTo points the proxy to a new implementation, with Data the call is delegated to the new
+// implementation in the same transaction. The sender is the owner of a UUPS proxy or the admin of a
+// transparent proxy, both take the same upgradeTo and upgradeToAndCall calls.
+func (c *Contract) WriteUpgradeTo(ctx context.Context, senderAddress string, txNonce uint64, inputs *model.MethodWriteUpgradeToInputs) (string, error) {
+	if !c.enableTransactors {
+		return "", errors.New("the transactors is not supported. check the instantiation parameters")
+	}
+	if !c.isTransactorExist(senderAddress) {
+		return "", errors.New("transactor not exist")
+	}
+	if !common.IsHexAddress(inputs.NewImplementation) {
+		return "", errors.New("invalid implementation address")
+	}
+	data, err := hexutil.Decode(inputs.Data)
+	if inputs.Data != "" && err != nil {
+		return "", fmt.Errorf("invalid data: %w", err)
+	}
+
+	// 获取Transactor参数
+	opts, err := c.genTransactorOptions(ctx, senderAddress, txNonce, nil)
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := proxy.Upgrade(opts, c.transactors[senderAddress].client, c.contractAddr, common.HexToAddress(inputs.NewImplementation), data)
+	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
+		return "", err
+	}
+	c.metrics.TxSent(c.contractAddr.Hex(), proxy.UpgradeMethod(data))
+
+	return tx.Hash().String(), nil
+}
@@ -31,7 +31,7 @@ var (
 // AddressMetaData contains all meta data concerning the Address contract.
 var AddressMetaData = &bind.MetaData{
 	ABI: "[]",
-	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea2646970667358221220bc9aa5d746a1adaa83245e07b5a97eabdde64f77da3707edcc65df09fe78add864736f6c63430008000033",
+	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea26469706673582212203b99db4299d25688f302672e924857fefe23f64269b280f898c8125b8ef8423864736f6c63430008150033",
 }
 
 // AddressABI is the input ABI used to generate the binding from.
@@ -559,7 +559,7 @@ var ERC721MetaData = &bind.MetaData{
 		"c87b56dd": "tokenURI(uint256)",
 		"23b872dd": "transferFrom(address,address,uint256)",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b5060405162001582380380620015828339810160408190526200003491620001b9565b81516200004990600090602085019062000068565b5080516200005f90600190602084019062000068565b50505062000273565b828054620000769062000220565b90600052602060002090601f0160209004810192826200009a5760008555620000e5565b82601f10620000b557805160ff1916838001178555620000e5565b82800160010185558215620000e5579182015b82811115620000e5578251825591602001919060010190620000c8565b50620000f3929150620000f7565b5090565b5b80821115620000f35760008155600101620000f8565b600082601f8301126200011f578081fd5b81516001600160401b03808211156200013c576200013c6200025d565b6040516020601f8401601f19168201810183811183821017156200016457620001646200025d565b60405283825285840181018710156200017b578485fd5b8492505b838310156200019e57858301810151828401820152918201916200017f565b83831115620001af57848185840101525b5095945050505050565b60008060408385031215620001cc578182fd5b82516001600160401b0380821115620001e3578384fd5b620001f1868387016200010e565b9350602085015191508082111562000207578283fd5b5062000216858286016200010e565b9150509250929050565b6002810460018216806200023557607f821691505b602082108114156200025757634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b6112ff80620002836000396000f3fe608060405234801561001057600080fd5b50600436106100cf5760003560e01c80636352211e1161008c578063a22cb46511610066578063a22cb465146101a8578063b88d4fde146101bb578063c87b56dd146101ce578063e985e9c5146101e1576100cf565b80636352211e1461016d57806370a082311461018057806395d89b41146101a0576100cf565b806301ffc9a7146100d457806306fdde03146100fd578063081812fc14610112578063095ea7b31461013257806323b872dd1461014757806342842e0e1461015a575b600080fd5b6100e76100e2366004610cf3565b6101f4565b6040516100f49190610def565b60405180910390f35b61010561023c565b6040516100f49190610dfa565b610125610120366004610d2b565b6102ce565b6040516100f49190610d9e565b610145610140366004610cca565b61031a565b005b610145610155366004610b89565b6103b2565b610145610168366004610b89565b6103ea565b61012561017b366004610d2b565b610405565b61019361018e366004610b3d565b61043a565b6040516100f4919061118c565b61010561047e565b6101456101b6366004610c90565b61048d565b6101456101c9366004610bc4565b61055b565b6101056101dc366004610d2b565b61059a565b6100e76101ef366004610b57565b61061d565b60006001600160e01b031982166380ac58cd60e01b148061022557506001600160e01b03198216635b5e139f60e01b145b8061023457506102348261064b565b90505b919050565b60606000805461024b90611204565b80601f016020809104026020016040519081016040528092919081815260200182805461027790611204565b80156102c45780601f10610299576101008083540402835291602001916102c4565b820191906000526020600020905b8154815290600101906020018083116102a757829003601f168201915b5050505050905090565b60006102d982610664565b6102fe5760405162461bcd60e51b81526004016102f590611016565b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b600061032582610405565b9050806001600160a01b0316836001600160a01b031614156103595760405162461bcd60e51b81526004016102f5906110fa565b806001600160a01b031661036b610681565b6001600160a01b031614806103875750610387816101ef610681565b6103a35760405162461bcd60e51b81526004016102f590610f26565b6103ad8383610685565b505050565b6103c36103bd610681565b826106f3565b6103df5760405162461bcd60e51b81526004016102f59061113b565b6103ad838383610778565b6103ad8383836040518060200160405280600081525061055b565b6000818152600260205260408120546001600160a01b0316806102345760405162461bcd60e51b81526004016102f590610fcd565b60006001600160a01b0382166104625760405162461bcd60e51b81526004016102f590610f83565b506001600160a01b031660009081526003602052604090205490565b60606001805461024b90611204565b610495610681565b6001600160a01b0316826001600160a01b031614156104c65760405162461bcd60e51b81526004016102f590610ea3565b80600560006104d3610681565b6001600160a01b03908116825260208083019390935260409182016000908120918716808252919093529120805460ff191692151592909217909155610517610681565b6001600160a01b03167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c318360405161054f9190610def565b60405180910390a35050565b61056c610566610681565b836106f3565b6105885760405162461bcd60e51b81526004016102f59061113b565b610594848484846108a5565b50505050565b60606105a582610664565b6105c15760405162461bcd60e51b81526004016102f5906110ab565b60006105cb6108d8565b905060008151116105eb5760405180602001604052806000815250610616565b806105f5846108ea565b604051602001610606929190610d6f565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b6001600160e01b031981166301ffc9a760e01b14919050565b6000908152600260205260409020546001600160a01b0316151590565b3390565b600081815260046020526040902080546001600160a01b0319166001600160a01b03841690811790915581906106ba82610405565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60006106fe82610664565b61071a5760405162461bcd60e51b81526004016102f590610eda565b600061072583610405565b9050806001600160a01b0316846001600160a01b031614806107605750836001600160a01b0316610755846102ce565b6001600160a01b0316145b806107705750610770818561061d565b949350505050565b826001600160a01b031661078b82610405565b6001600160a01b0316146107b15760405162461bcd60e51b81526004016102f590611062565b6001600160a01b0382166107d75760405162461bcd60e51b81526004016102f590610e5f565b6107e28383836103ad565b6107ed600082610685565b6001600160a01b03831660009081526003602052604081208054600192906108169084906111c1565b90915550506001600160a01b0382166000908152600360205260408120805460019290610844908490611195565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b6108b0848484610778565b6108bc84848484610a05565b6105945760405162461bcd60e51b81526004016102f590610e0d565b60408051602081019091526000815290565b60608161090f57506040805180820190915260018152600360fc1b6020820152610237565b8160005b811561093957806109238161123f565b91506109329050600a836111ad565b9150610913565b60008167ffffffffffffffff81111561096257634e487b7160e01b600052604160045260246000fd5b6040519080825280601f01601f19166020018201604052801561098c576020820181803683370190505b5090505b8415610770576109a16001836111c1565b91506109ae600a8661125a565b6109b9906030611195565b60f81b8183815181106109dc57634e487b7160e01b600052603260045260246000fd5b60200101906001600160f81b031916908160001a9053506109fe600a866111ad565b9450610990565b6000610a19846001600160a01b0316610b20565b15610b1557836001600160a01b031663150b7a02610a35610681565b8786866040518563ffffffff1660e01b8152600401610a579493929190610db2565b602060405180830381600087803b158015610a7157600080fd5b505af1925050508015610aa1575060408051601f3d908101601f19168201909252610a9e91810190610d0f565b60015b610afb573d808015610acf576040519150601f19603f3d011682016040523d82523d6000602084013e610ad4565b606091505b508051610af35760405162461bcd60e51b81526004016102f590610e0d565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050610770565b506001949350505050565b3b151590565b80356001600160a01b038116811461023757600080fd5b600060208284031215610b4e578081fd5b61061682610b26565b60008060408385031215610b69578081fd5b610b7283610b26565b9150610b8060208401610b26565b90509250929050565b600080600060608486031215610b9d578081fd5b610ba684610b26565b9250610bb460208501610b26565b9150604084013590509250925092565b60008060008060808587031215610bd9578081fd5b610be285610b26565b93506020610bf1818701610b26565b935060408601359250606086013567ffffffffffffffff80821115610c14578384fd5b818801915088601f830112610c27578384fd5b813581811115610c3957610c3961129a565b604051601f8201601f1916810185018381118282101715610c5c57610c5c61129a565b60405281815283820185018b1015610c72578586fd5b81858501868301379081019093019390935250939692955090935050565b60008060408385031215610ca2578182fd5b610cab83610b26565b915060208301358015158114610cbf578182fd5b809150509250929050565b60008060408385031215610cdc578182fd5b610ce583610b26565b946020939093013593505050565b600060208284031215610d04578081fd5b8135610616816112b0565b600060208284031215610d20578081fd5b8151610616816112b0565b600060208284031215610d3c578081fd5b5035919050565b60008151808452610d5b8160208601602086016111d8565b601f01601f19169290920160200192915050565b60008351610d818184602088016111d8565b835190830190610d958183602088016111d8565b01949350505050565b6001600160a01b0391909116815260200190565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090610de590830184610d43565b9695505050505050565b901515815260200190565b6000602082526106166020830184610d43565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60208082526024908201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646040820152637265737360e01b606082015260800190565b60208082526019908201527f4552433732313a20617070726f766520746f2063616c6c657200000000000000604082015260600190565b6020808252602c908201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860408201526b34b9ba32b73a103a37b5b2b760a11b606082015260800190565b60208082526038908201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760408201527f6e6572206e6f7220617070726f76656420666f7220616c6c0000000000000000606082015260800190565b6020808252602a908201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604082015269726f206164647265737360b01b606082015260800190565b60208082526029908201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460408201526832b73a103a37b5b2b760b91b606082015260800190565b6020808252602c908201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860408201526b34b9ba32b73a103a37b5b2b760a11b606082015260800190565b60208082526029908201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960408201526839903737ba1037bbb760b91b606082015260800190565b6020808252602f908201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60408201526e3732bc34b9ba32b73a103a37b5b2b760891b606082015260800190565b60208082526021908201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656040820152603960f91b606082015260800190565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b90815260200190565b600082198211156111a8576111a861126e565b500190565b6000826111bc576111bc611284565b500490565b6000828210156111d3576111d361126e565b500390565b60005b838110156111f35781810151838201526020016111db565b838111156105945750506000910152565b60028104600182168061121857607f821691505b6020821081141561123957634e487b7160e01b600052602260045260246000fd5b50919050565b60006000198214156112535761125361126e565b5060010190565b60008261126957611269611284565b500690565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052601260045260246000fd5b634e487b7160e01b600052604160045260246000fd5b6001600160e01b0319811681146112c657600080fd5b5056fea26469706673582212203fbc1f779f7824aa25ef306d0a7d13ab258c13bda210186da8bf7ed55146e5ae64736f6c63430008000033",
+	Bin: "0x60806040523480156200001157600080fd5b50604051620015003803806200150083398101604081905262000034916200011f565b600062000042838262000218565b50600162000051828262000218565b505050620002e4565b634e487b7160e01b600052604160045260246000fd5b600082601f8301126200008257600080fd5b81516001600160401b03808211156200009f576200009f6200005a565b604051601f8301601f19908116603f01168101908282118183101715620000ca57620000ca6200005a565b81604052838152602092508683858801011115620000e757600080fd5b600091505b838210156200010b5785820183015181830184015290820190620000ec565b600093810190920192909252949350505050565b600080604083850312156200013357600080fd5b82516001600160401b03808211156200014b57600080fd5b620001598683870162000070565b935060208501519150808211156200017057600080fd5b506200017f8582860162000070565b9150509250929050565b600181811c908216806200019e57607f821691505b602082108103620001bf57634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200021357600081815260208120601f850160051c81016020861015620001ee5750805b601f850160051c820191505b818110156200020f57828155600101620001fa565b5050505b505050565b81516001600160401b038111156200023457620002346200005a565b6200024c8162000245845462000189565b84620001c5565b602080601f8311600181146200028457600084156200026b5750858301515b600019600386901b1c1916600185901b1785556200020f565b600085815260208120601f198616915b82811015620002b55788860151825594840194600190910190840162000294565b5085821015620002d45787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b61120c80620002f46000396000f3fe608060405234801561001057600080fd5b50600436106100cf5760003560e01c80636352211e1161008c578063a22cb46511610066578063a22cb465146101b3578063b88d4fde146101c6578063c87b56dd146101d9578063e985e9c5146101ec57600080fd5b80636352211e1461017757806370a082311461018a57806395d89b41146101ab57600080fd5b806301ffc9a7146100d457806306fdde03146100fc578063081812fc14610111578063095ea7b31461013c57806323b872dd1461015157806342842e0e14610164575b600080fd5b6100e76100e2366004610d30565b6101ff565b60405190151581526020015b60405180910390f35b610104610251565b6040516100f39190610d9d565b61012461011f366004610db0565b6102e3565b6040516001600160a01b0390911681526020016100f3565b61014f61014a366004610de5565b61037d565b005b61014f61015f366004610e0f565b610492565b61014f610172366004610e0f565b6104c3565b610124610185366004610db0565b6104de565b61019d610198366004610e4b565b610555565b6040519081526020016100f3565b6101046105dc565b61014f6101c1366004610e66565b6105eb565b61014f6101d4366004610eb8565b6106af565b6101046101e7366004610db0565b6106e7565b6100e76101fa366004610f94565b6107cf565b60006001600160e01b031982166380ac58cd60e01b148061023057506001600160e01b03198216635b5e139f60e01b145b8061024b57506301ffc9a760e01b6001600160e01b03198316145b92915050565b60606000805461026090610fc7565b80601f016020809104026020016040519081016040528092919081815260200182805461028c90610fc7565b80156102d95780601f106102ae576101008083540402835291602001916102d9565b820191906000526020600020905b8154815290600101906020018083116102bc57829003601f168201915b5050505050905090565b6000818152600260205260408120546001600160a01b03166103615760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b6000610388826104de565b9050806001600160a01b0316836001600160a01b0316036103f55760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b6064820152608401610358565b336001600160a01b0382161480610411575061041181336107cf565b6104835760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c00000000000000006064820152608401610358565b61048d83836107fd565b505050565b61049c338261086b565b6104b85760405162461bcd60e51b815260040161035890611001565b61048d838383610942565b61048d838383604051806020016040528060008152506106af565b6000818152600260205260408120546001600160a01b03168061024b5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b6064820152608401610358565b60006001600160a01b0382166105c05760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b6064820152608401610358565b506001600160a01b031660009081526003602052604090205490565b60606001805461026090610fc7565b336001600160a01b038316036106435760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610358565b3360008181526005602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6106b9338361086b565b6106d55760405162461bcd60e51b815260040161035890611001565b6106e184848484610ae2565b50505050565b6000818152600260205260409020546060906001600160a01b03166107665760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b6064820152608401610358565b600061077d60408051602081019091526000815290565b9050600081511161079d57604051806020016040528060008152506107c8565b806107a784610b15565b6040516020016107b8929190611052565b6040516020818303038152906040525b9392505050565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b600081815260046020526040902080546001600160a01b0319166001600160a01b0384169081179091558190610832826104de565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152600260205260408120546001600160a01b03166108e45760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610358565b60006108ef836104de565b9050806001600160a01b0316846001600160a01b0316148061092a5750836001600160a01b031661091f846102e3565b6001600160a01b0316145b8061093a575061093a81856107cf565b949350505050565b826001600160a01b0316610955826104de565b6001600160a01b0316146109bd5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b6064820152608401610358565b6001600160a01b038216610a1f5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610358565b610a2a6000826107fd565b6001600160a01b0383166000908152600360205260408120805460019290610a53908490611097565b90915550506001600160a01b0382166000908152600360205260408120805460019290610a819084906110aa565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b610aed848484610942565b610af984848484610c16565b6106e15760405162461bcd60e51b8152600401610358906110bd565b606081600003610b3c5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115610b665780610b508161110f565b9150610b5f9050600a8361113e565b9150610b40565b60008167ffffffffffffffff811115610b8157610b81610ea2565b6040519080825280601f01601f191660200182016040528015610bab576020820181803683370190505b5090505b841561093a57610bc0600183611097565b9150610bcd600a86611152565b610bd89060306110aa565b60f81b818381518110610bed57610bed611166565b60200101906001600160f81b031916908160001a905350610c0f600a8661113e565b9450610baf565b60006001600160a01b0384163b15610d0c57604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290610c5a90339089908890889060040161117c565b6020604051808303816000875af1925050508015610c95575060408051601f3d908101601f19168201909252610c92918101906111b9565b60015b610cf2573d808015610cc3576040519150601f19603f3d011682016040523d82523d6000602084013e610cc8565b606091505b508051600003610cea5760405162461bcd60e51b8152600401610358906110bd565b805181602001fd5b6001600160e01b031916630a85bd0160e11b14905061093a565b506001949350505050565b6001600160e01b031981168114610d2d57600080fd5b50565b600060208284031215610d4257600080fd5b81356107c881610d17565b60005b83811015610d68578181015183820152602001610d50565b50506000910152565b60008151808452610d89816020860160208601610d4d565b601f01601f19169290920160200192915050565b6020815260006107c86020830184610d71565b600060208284031215610dc257600080fd5b5035919050565b80356001600160a01b0381168114610de057600080fd5b919050565b60008060408385031215610df857600080fd5b610e0183610dc9565b946020939093013593505050565b600080600060608486031215610e2457600080fd5b610e2d84610dc9565b9250610e3b60208501610dc9565b9150604084013590509250925092565b600060208284031215610e5d57600080fd5b6107c882610dc9565b60008060408385031215610e7957600080fd5b610e8283610dc9565b915060208301358015158114610e9757600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b60008060008060808587031215610ece57600080fd5b610ed785610dc9565b9350610ee560208601610dc9565b925060408501359150606085013567ffffffffffffffff80821115610f0957600080fd5b818701915087601f830112610f1d57600080fd5b813581811115610f2f57610f2f610ea2565b604051601f8201601f19908116603f01168101908382118183101715610f5757610f57610ea2565b816040528281528a6020848701011115610f7057600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b60008060408385031215610fa757600080fd5b610fb083610dc9565b9150610fbe60208401610dc9565b90509250929050565b600181811c90821680610fdb57607f821691505b602082108103610ffb57634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b60008351611064818460208801610d4d565b835190830190611078818360208801610d4d565b01949350505050565b634e487b7160e01b600052601160045260246000fd5b8181038181111561024b5761024b611081565b8082018082111561024b5761024b611081565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60006001820161112157611121611081565b5060010190565b634e487b7160e01b600052601260045260246000fd5b60008261114d5761114d611128565b500490565b60008261116157611161611128565b500690565b634e487b7160e01b600052603260045260246000fd5b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906111af90830184610d71565b9695505050505050565b6000602082840312156111cb57600080fd5b81516107c881610d1756fea264697066735822122073aa015ac3d8d4d04dfb6110feae01d86758279ea273ff868b4fd9fada8ab46164736f6c63430008150033",
 }
 
 // ERC721ABI is the input ABI used to generate the binding from.
@@ -8371,7 +8371,7 @@ var StandardERC721MetaData = &bind.MetaData{
 		"23b872dd": "transferFrom(address,address,uint256)",
 		"f2fde38b": "transferOwnership(address)",
 	},
-	Bin: "0x60806040523480156200001157600080fd5b5060405162001f8f38038062001f8f83398101604081905262000034916200022f565b8151829082906200004d906000906020850190620000de565b50805162000063906001906020840190620000de565b505050620000806200007a6200008860201b60201c565b6200008c565b5050620002e9565b3390565b600b80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b828054620000ec9062000296565b90600052602060002090601f0160209004810192826200011057600085556200015b565b82601f106200012b57805160ff19168380011785556200015b565b828001600101855582156200015b579182015b828111156200015b5782518255916020019190600101906200013e565b50620001699291506200016d565b5090565b5b808211156200016957600081556001016200016e565b600082601f83011262000195578081fd5b81516001600160401b0380821115620001b257620001b2620002d3565b6040516020601f8401601f1916820181018381118382101715620001da57620001da620002d3565b6040528382528584018101871015620001f1578485fd5b8492505b83831015620002145785830181015182840182015291820191620001f5565b838311156200022557848185840101525b5095945050505050565b6000806040838503121562000242578182fd5b82516001600160401b038082111562000259578384fd5b620002678683870162000184565b935060208501519150808211156200027d578283fd5b506200028c8582860162000184565b9150509250929050565b600281046001821680620002ab57607f821691505b60208210811415620002cd57634e487b7160e01b600052602260045260246000fd5b50919050565b634e487b7160e01b600052604160045260246000fd5b611c9680620002f96000396000f3fe608060405234801561001057600080fd5b506004361061012c5760003560e01c80636352211e116100ad578063a22cb46511610071578063a22cb46514610256578063b88d4fde14610269578063c87b56dd1461027c578063e985e9c51461028f578063f2fde38b146102a25761012c565b80636352211e1461021857806370a082311461022b578063715018a61461023e5780638da5cb5b1461024657806395d89b411461024e5761012c565b806323b872dd116100f457806323b872dd146101b95780632f745c59146101cc57806342842e0e146101df57806342966c68146101f25780634f6ccce7146102055761012c565b806301ffc9a71461013157806306fdde031461015a578063081812fc1461016f578063095ea7b31461018f57806318160ddd146101a4575b600080fd5b61014461013f3660046114da565b6102b5565b60405161015191906115d6565b60405180910390f35b6101626102c8565b60405161015191906115e1565b61018261017d366004611512565b61035a565b6040516101519190611585565b6101a261019d3660046114b1565b6103a6565b005b6101ac61043e565b6040516101519190611b26565b6101a26101c7366004611370565b610444565b6101ac6101da3660046114b1565b61047c565b6101a26101ed366004611370565b6104ce565b6101a2610200366004611512565b6104e9565b6101ac610213366004611512565b61051c565b610182610226366004611512565b610577565b6101ac610239366004611324565b6105ac565b6101a26105f0565b61018261063b565b61016261064a565b6101a2610264366004611477565b610659565b6101a26102773660046113ab565b610727565b61016261028a366004611512565b610766565b61014461029d36600461133e565b610771565b6101a26102b0366004611324565b61079f565b60006102c08261080d565b90505b919050565b6060600080546102d790611b9e565b80601f016020809104026020016040519081016040528092919081815260200182805461030390611b9e565b80156103505780601f1061032557610100808354040283529160200191610350565b820191906000526020600020905b81548152906001019060200180831161033357829003601f168201915b5050505050905090565b600061036582610832565b61038a5760405162461bcd60e51b8152600401610381906118df565b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b60006103b182610577565b9050806001600160a01b0316836001600160a01b031614156103e55760405162461bcd60e51b8152600401610381906119f8565b806001600160a01b03166103f761084f565b6001600160a01b0316148061041357506104138161029d61084f565b61042f5760405162461bcd60e51b81526004016103819061179e565b6104398383610853565b505050565b60085490565b61045561044f61084f565b826108c1565b6104715760405162461bcd60e51b815260040161038190611a39565b610439838383610946565b6000610487836105ac565b82106104a55760405162461bcd60e51b8152600401610381906115f4565b506001600160a01b03919091166000908152600660209081526040808320938352929052205490565b61043983838360405180602001604052806000815250610727565b6104f461044f61084f565b6105105760405162461bcd60e51b815260040161038190611ad6565b61051981610a73565b50565b600061052661043e565b82106105445760405162461bcd60e51b815260040161038190611a8a565b6008828154811061056557634e487b7160e01b600052603260045260246000fd5b90600052602060002001549050919050565b6000818152600260205260408120546001600160a01b0316806102c05760405162461bcd60e51b815260040161038190611845565b60006001600160a01b0382166105d45760405162461bcd60e51b8152600401610381906117fb565b506001600160a01b031660009081526003602052604090205490565b6105f861084f565b6001600160a01b031661060961063b565b6001600160a01b03161461062f5760405162461bcd60e51b81526004016103819061192b565b6106396000610a7c565b565b600b546001600160a01b031690565b6060600180546102d790611b9e565b61066161084f565b6001600160a01b0316826001600160a01b031614156106925760405162461bcd60e51b81526004016103819061171b565b806005600061069f61084f565b6001600160a01b03908116825260208083019390935260409182016000908120918716808252919093529120805460ff1916921515929092179091556106e361084f565b6001600160a01b03167f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c318360405161071b91906115d6565b60405180910390a35050565b61073861073261084f565b836108c1565b6107545760405162461bcd60e51b815260040161038190611a39565b61076084848484610ace565b50505050565b60606102c082610b01565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b6107a761084f565b6001600160a01b03166107b861063b565b6001600160a01b0316146107de5760405162461bcd60e51b81526004016103819061192b565b6001600160a01b0381166108045760405162461bcd60e51b815260040161038190611691565b61051981610a7c565b60006001600160e01b0319821663780e9d6360e01b14806102c057506102c082610c1a565b6000908152600260205260409020546001600160a01b0316151590565b3390565b600081815260046020526040902080546001600160a01b0319166001600160a01b038416908117909155819061088882610577565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b60006108cc82610832565b6108e85760405162461bcd60e51b815260040161038190611752565b60006108f383610577565b9050806001600160a01b0316846001600160a01b0316148061092e5750836001600160a01b03166109238461035a565b6001600160a01b0316145b8061093e575061093e8185610771565b949350505050565b826001600160a01b031661095982610577565b6001600160a01b03161461097f5760405162461bcd60e51b815260040161038190611960565b6001600160a01b0382166109a55760405162461bcd60e51b8152600401610381906116d7565b6109b0838383610c5a565b6109bb600082610853565b6001600160a01b03831660009081526003602052604081208054600192906109e4908490611b5b565b90915550506001600160a01b0382166000908152600360205260408120805460019290610a12908490611b2f565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b61051981610c65565b600b80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b610ad9848484610946565b610ae584848484610ca5565b6107605760405162461bcd60e51b81526004016103819061163f565b6060610b0c82610832565b610b285760405162461bcd60e51b81526004016103819061188e565b6000828152600a602052604081208054610b4190611b9e565b80601f0160208091040260200160405190810160405280929190818152602001828054610b6d90611b9e565b8015610bba5780601f10610b8f57610100808354040283529160200191610bba565b820191906000526020600020905b815481529060010190602001808311610b9d57829003601f168201915b505050505090506000610bcb610dc0565b9050805160001415610bdf575090506102c3565b815115610c11578082604051602001610bf9929190611556565b604051602081830303815290604052925050506102c3565b61093e84610dd2565b60006001600160e01b031982166380ac58cd60e01b1480610c4b57506001600160e01b03198216635b5e139f60e01b145b806102c057506102c082610e55565b610439838383610e6e565b610c6e81610ef7565b6000818152600a602052604090208054610c8790611b9e565b159050610519576000818152600a60205260408120610519916112bd565b6000610cb9846001600160a01b0316610f9e565b15610db557836001600160a01b031663150b7a02610cd561084f565b8786866040518563ffffffff1660e01b8152600401610cf79493929190611599565b602060405180830381600087803b158015610d1157600080fd5b505af1925050508015610d41575060408051601f3d908101601f19168201909252610d3e918101906114f6565b60015b610d9b573d808015610d6f576040519150601f19603f3d011682016040523d82523d6000602084013e610d74565b606091505b508051610d935760405162461bcd60e51b81526004016103819061163f565b805181602001fd5b6001600160e01b031916630a85bd0160e11b14905061093e565b506001949350505050565b60408051602081019091526000815290565b6060610ddd82610832565b610df95760405162461bcd60e51b8152600401610381906119a9565b6000610e03610dc0565b90506000815111610e235760405180602001604052806000815250610e4e565b80610e2d84610fa4565b604051602001610e3e929190611556565b6040516020818303038152906040525b9392505050565b6001600160e01b031981166301ffc9a760e01b14919050565b610e79838383610439565b6001600160a01b038316610e9557610e90816110bf565b610eb8565b816001600160a01b0316836001600160a01b031614610eb857610eb88382611103565b6001600160a01b038216610ed457610ecf816111a0565b610439565b826001600160a01b0316826001600160a01b031614610439576104398282611279565b6000610f0282610577565b9050610f1081600084610c5a565b610f1b600083610853565b6001600160a01b0381166000908152600360205260408120805460019290610f44908490611b5b565b909155505060008281526002602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b3b151590565b606081610fc957506040805180820190915260018152600360fc1b60208201526102c3565b8160005b8115610ff35780610fdd81611bd9565b9150610fec9050600a83611b47565b9150610fcd565b60008167ffffffffffffffff81111561101c57634e487b7160e01b600052604160045260246000fd5b6040519080825280601f01601f191660200182016040528015611046576020820181803683370190505b5090505b841561093e5761105b600183611b5b565b9150611068600a86611bf4565b611073906030611b2f565b60f81b81838151811061109657634e487b7160e01b600052603260045260246000fd5b60200101906001600160f81b031916908160001a9053506110b8600a86611b47565b945061104a565b600880546000838152600960205260408120829055600182018355919091527ff3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee30155565b60006001611110846105ac565b61111a9190611b5b565b60008381526007602052604090205490915080821461116d576001600160a01b03841660009081526006602090815260408083208584528252808320548484528184208190558352600790915290208190555b5060009182526007602090815260408084208490556001600160a01b039094168352600681528383209183525290812055565b6008546000906111b290600190611b5b565b600083815260096020526040812054600880549394509092849081106111e857634e487b7160e01b600052603260045260246000fd5b90600052602060002001549050806008838154811061121757634e487b7160e01b600052603260045260246000fd5b600091825260208083209091019290925582815260099091526040808220849055858252812055600880548061125d57634e487b7160e01b600052603160045260246000fd5b6001900381819060005260206000200160009055905550505050565b6000611284836105ac565b6001600160a01b039093166000908152600660209081526040808320868452825280832085905593825260079052919091209190915550565b5080546112c990611b9e565b6000825580601f106112db5750610519565b601f01602090049060005260206000209081019061051991905b8082111561130957600081556001016112f5565b5090565b80356001600160a01b03811681146102c357600080fd5b600060208284031215611335578081fd5b610e4e8261130d565b60008060408385031215611350578081fd5b6113598361130d565b91506113676020840161130d565b90509250929050565b600080600060608486031215611384578081fd5b61138d8461130d565b925061139b6020850161130d565b9150604084013590509250925092565b600080600080608085870312156113c0578081fd5b6113c98561130d565b935060206113d881870161130d565b935060408601359250606086013567ffffffffffffffff808211156113fb578384fd5b818801915088601f83011261140e578384fd5b81358181111561142057611420611c34565b604051601f8201601f191681018501838111828210171561144357611443611c34565b60405281815283820185018b1015611459578586fd5b81858501868301379081019093019390935250939692955090935050565b60008060408385031215611489578182fd5b6114928361130d565b9150602083013580151581146114a6578182fd5b809150509250929050565b600080604083850312156114c3578182fd5b6114cc8361130d565b946020939093013593505050565b6000602082840312156114eb578081fd5b8135610e4e81611c4a565b600060208284031215611507578081fd5b8151610e4e81611c4a565b600060208284031215611523578081fd5b5035919050565b60008151808452611542816020860160208601611b72565b601f01601f19169290920160200192915050565b60008351611568818460208801611b72565b83519083019061157c818360208801611b72565b01949350505050565b6001600160a01b0391909116815260200190565b6001600160a01b03858116825284166020820152604081018390526080606082018190526000906115cc9083018461152a565b9695505050505050565b901515815260200190565b600060208252610e4e602083018461152a565b6020808252602b908201527f455243373231456e756d657261626c653a206f776e657220696e646578206f7560408201526a74206f6620626f756e647360a81b606082015260800190565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60208082526026908201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160408201526564647265737360d01b606082015260800190565b60208082526024908201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646040820152637265737360e01b606082015260800190565b60208082526019908201527f4552433732313a20617070726f766520746f2063616c6c657200000000000000604082015260600190565b6020808252602c908201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860408201526b34b9ba32b73a103a37b5b2b760a11b606082015260800190565b60208082526038908201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760408201527f6e6572206e6f7220617070726f76656420666f7220616c6c0000000000000000606082015260800190565b6020808252602a908201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604082015269726f206164647265737360b01b606082015260800190565b60208082526029908201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460408201526832b73a103a37b5b2b760b91b606082015260800190565b60208082526031908201527f45524337323155524953746f726167653a2055524920717565727920666f72206040820152703737b732bc34b9ba32b73a103a37b5b2b760791b606082015260800190565b6020808252602c908201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860408201526b34b9ba32b73a103a37b5b2b760a11b606082015260800190565b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b60208082526029908201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960408201526839903737ba1037bbb760b91b606082015260800190565b6020808252602f908201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60408201526e3732bc34b9ba32b73a103a37b5b2b760891b606082015260800190565b60208082526021908201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656040820152603960f91b606082015260800190565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b6020808252602c908201527f455243373231456e756d657261626c653a20676c6f62616c20696e646578206f60408201526b7574206f6620626f756e647360a01b606082015260800190565b60208082526030908201527f4552433732314275726e61626c653a2063616c6c6572206973206e6f74206f7760408201526f1b995c881b9bdc88185c1c1c9bdd995960821b606082015260800190565b90815260200190565b60008219821115611b4257611b42611c08565b500190565b600082611b5657611b56611c1e565b500490565b600082821015611b6d57611b6d611c08565b500390565b60005b83811015611b8d578181015183820152602001611b75565b838111156107605750506000910152565b600281046001821680611bb257607f821691505b60208210811415611bd357634e487b7160e01b600052602260045260246000fd5b50919050565b6000600019821415611bed57611bed611c08565b5060010190565b600082611c0357611c03611c1e565b500690565b634e487b7160e01b600052601160045260246000fd5b634e487b7160e01b600052601260045260246000fd5b634e487b7160e01b600052604160045260246000fd5b6001600160e01b03198116811461051957600080fdfea2646970667358221220cf05237a231f0720010a5615c2d6eb14f00d830546a6d316d9dd1f14440d22b464736f6c63430008000033",
+	Bin: "0x60806040523480156200001157600080fd5b5060405162001ee538038062001ee5833981016040819052620000349162000193565b818160006200004483826200028c565b5060016200005382826200028c565b505050620000706200006a6200007860201b60201c565b6200007c565b505062000358565b3390565b600b80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b634e487b7160e01b600052604160045260246000fd5b600082601f830112620000f657600080fd5b81516001600160401b0380821115620001135762000113620000ce565b604051601f8301601f19908116603f011681019082821181831017156200013e576200013e620000ce565b816040528381526020925086838588010111156200015b57600080fd5b600091505b838210156200017f578582018301518183018401529082019062000160565b600093810190920192909252949350505050565b60008060408385031215620001a757600080fd5b82516001600160401b0380821115620001bf57600080fd5b620001cd86838701620000e4565b93506020850151915080821115620001e457600080fd5b50620001f385828601620000e4565b9150509250929050565b600181811c908216806200021257607f821691505b6020821081036200023357634e487b7160e01b600052602260045260246000fd5b50919050565b601f8211156200028757600081815260208120601f850160051c81016020861015620002625750805b601f850160051c820191505b8181101562000283578281556001016200026e565b5050505b505050565b81516001600160401b03811115620002a857620002a8620000ce565b620002c081620002b98454620001fd565b8462000239565b602080601f831160018114620002f85760008415620002df5750858301515b600019600386901b1c1916600185901b17855562000283565b600085815260208120601f198616915b82811015620003295788860151825594840194600190910190840162000308565b5085821015620003485787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b611b7d80620003686000396000f3fe608060405234801561001057600080fd5b506004361061012c5760003560e01c80636352211e116100ad578063a22cb46511610071578063a22cb46514610266578063b88d4fde14610279578063c87b56dd1461028c578063e985e9c51461029f578063f2fde38b146102db57600080fd5b80636352211e1461021f57806370a0823114610232578063715018a6146102455780638da5cb5b1461024d57806395d89b411461025e57600080fd5b806323b872dd116100f457806323b872dd146101c05780632f745c59146101d357806342842e0e146101e657806342966c68146101f95780634f6ccce71461020c57600080fd5b806301ffc9a71461013157806306fdde0314610159578063081812fc1461016e578063095ea7b31461019957806318160ddd146101ae575b600080fd5b61014461013f36600461168b565b6102ee565b60405190151581526020015b60405180910390f35b6101616102ff565b60405161015091906116f8565b61018161017c36600461170b565b610391565b6040516001600160a01b039091168152602001610150565b6101ac6101a7366004611740565b61042b565b005b6008545b604051908152602001610150565b6101ac6101ce36600461176a565b610540565b6101b26101e1366004611740565b610572565b6101ac6101f436600461176a565b610608565b6101ac61020736600461170b565b610623565b6101b261021a36600461170b565b61069d565b61018161022d36600461170b565b610730565b6101b26102403660046117a6565b6107a7565b6101ac61082e565b600b546001600160a01b0316610181565b610161610894565b6101ac6102743660046117c1565b6108a3565b6101ac610287366004611813565b610967565b61016161029a36600461170b565b61099f565b6101446102ad3660046118ef565b6001600160a01b03918216600090815260056020908152604080832093909416825291909152205460ff1690565b6101ac6102e93660046117a6565b6109aa565b60006102f982610a72565b92915050565b60606000805461030e90611922565b80601f016020809104026020016040519081016040528092919081815260200182805461033a90611922565b80156103875780601f1061035c57610100808354040283529160200191610387565b820191906000526020600020905b81548152906001019060200180831161036a57829003601f168201915b5050505050905090565b6000818152600260205260408120546001600160a01b031661040f5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084015b60405180910390fd5b506000908152600460205260409020546001600160a01b031690565b600061043682610730565b9050806001600160a01b0316836001600160a01b0316036104a35760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b6064820152608401610406565b336001600160a01b03821614806104bf57506104bf81336102ad565b6105315760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c00000000000000006064820152608401610406565b61053b8383610a97565b505050565b61054b335b82610b05565b6105675760405162461bcd60e51b81526004016104069061195c565b61053b838383610bfc565b600061057d836107a7565b82106105df5760405162461bcd60e51b815260206004820152602b60248201527f455243373231456e756d657261626c653a206f776e657220696e646578206f7560448201526a74206f6620626f756e647360a81b6064820152608401610406565b506001600160a01b03919091166000908152600660209081526040808320938352929052205490565b61053b83838360405180602001604052806000815250610967565b61062c33610545565b6106915760405162461bcd60e51b815260206004820152603060248201527f4552433732314275726e61626c653a2063616c6c6572206973206e6f74206f7760448201526f1b995c881b9bdc88185c1c1c9bdd995960821b6064820152608401610406565b61069a81610da7565b50565b60006106a860085490565b821061070b5760405162461bcd60e51b815260206004820152602c60248201527f455243373231456e756d657261626c653a20676c6f62616c20696e646578206f60448201526b7574206f6620626f756e647360a01b6064820152608401610406565b6008828154811061071e5761071e6119ad565b90600052602060002001549050919050565b6000818152600260205260408120546001600160a01b0316806102f95760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b6064820152608401610406565b60006001600160a01b0382166108125760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b6064820152608401610406565b506001600160a01b031660009081526003602052604090205490565b600b546001600160a01b031633146108885760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610406565b6108926000610db0565b565b60606001805461030e90611922565b336001600160a01b038316036108fb5760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610406565b3360008181526005602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b6109713383610b05565b61098d5760405162461bcd60e51b81526004016104069061195c565b61099984848484610e02565b50505050565b60606102f982610e35565b600b546001600160a01b03163314610a045760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65726044820152606401610406565b6001600160a01b038116610a695760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610406565b61069a81610db0565b60006001600160e01b0319821663780e9d6360e01b14806102f957506102f982610fb3565b600081815260046020526040902080546001600160a01b0319166001600160a01b0384169081179091558190610acc82610730565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152600260205260408120546001600160a01b0316610b7e5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610406565b6000610b8983610730565b9050806001600160a01b0316846001600160a01b03161480610bc45750836001600160a01b0316610bb984610391565b6001600160a01b0316145b80610bf457506001600160a01b0380821660009081526005602090815260408083209388168352929052205460ff165b949350505050565b826001600160a01b0316610c0f82610730565b6001600160a01b031614610c775760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b6064820152608401610406565b6001600160a01b038216610cd95760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610406565b610ce4838383611003565b610cef600082610a97565b6001600160a01b0383166000908152600360205260408120805460019290610d189084906119d9565b90915550506001600160a01b0382166000908152600360205260408120805460019290610d469084906119ec565b909155505060008181526002602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b61069a8161100e565b600b80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b610e0d848484610bfc565b610e198484848461104e565b6109995760405162461bcd60e51b8152600401610406906119ff565b6000818152600260205260409020546060906001600160a01b0316610eb65760405162461bcd60e51b815260206004820152603160248201527f45524337323155524953746f726167653a2055524920717565727920666f72206044820152703737b732bc34b9ba32b73a103a37b5b2b760791b6064820152608401610406565b6000828152600a602052604081208054610ecf90611922565b80601f0160208091040260200160405190810160405280929190818152602001828054610efb90611922565b8015610f485780601f10610f1d57610100808354040283529160200191610f48565b820191906000526020600020905b815481529060010190602001808311610f2b57829003601f168201915b505050505090506000610f6660408051602081019091526000815290565b90508051600003610f78575092915050565b815115610faa578082604051602001610f92929190611a51565b60405160208183030381529060405292505050919050565b610bf48461114f565b60006001600160e01b031982166380ac58cd60e01b1480610fe457506001600160e01b03198216635b5e139f60e01b145b806102f957506301ffc9a760e01b6001600160e01b03198316146102f9565b61053b838383611237565b611017816112ef565b6000818152600a60205260409020805461103090611922565b15905061069a576000818152600a6020526040812061069a91611627565b60006001600160a01b0384163b1561114457604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290611092903390899088908890600401611a80565b6020604051808303816000875af19250505080156110cd575060408051601f3d908101601f191682019092526110ca91810190611abd565b60015b61112a573d8080156110fb576040519150601f19603f3d011682016040523d82523d6000602084013e611100565b606091505b5080516000036111225760405162461bcd60e51b8152600401610406906119ff565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050610bf4565b506001949350505050565b6000818152600260205260409020546060906001600160a01b03166111ce5760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b6064820152608401610406565b60006111e560408051602081019091526000815290565b905060008151116112055760405180602001604052806000815250611230565b8061120f84611396565b604051602001611220929190611a51565b6040516020818303038152906040525b9392505050565b6001600160a01b0383166112925761128d81600880546000838152600960205260408120829055600182018355919091527ff3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee30155565b6112b5565b816001600160a01b0316836001600160a01b0316146112b5576112b58382611497565b6001600160a01b0382166112cc5761053b81611534565b826001600160a01b0316826001600160a01b03161461053b5761053b82826115e3565b60006112fa82610730565b905061130881600084611003565b611313600083610a97565b6001600160a01b038116600090815260036020526040812080546001929061133c9084906119d9565b909155505060008281526002602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b6060816000036113bd5750506040805180820190915260018152600360fc1b602082015290565b8160005b81156113e757806113d181611ada565b91506113e09050600a83611b09565b91506113c1565b60008167ffffffffffffffff811115611402576114026117fd565b6040519080825280601f01601f19166020018201604052801561142c576020820181803683370190505b5090505b8415610bf4576114416001836119d9565b915061144e600a86611b1d565b6114599060306119ec565b60f81b81838151811061146e5761146e6119ad565b60200101906001600160f81b031916908160001a905350611490600a86611b09565b9450611430565b600060016114a4846107a7565b6114ae91906119d9565b600083815260076020526040902054909150808214611501576001600160a01b03841660009081526006602090815260408083208584528252808320548484528184208190558352600790915290208190555b5060009182526007602090815260408084208490556001600160a01b039094168352600681528383209183525290812055565b600854600090611546906001906119d9565b6000838152600960205260408120546008805493945090928490811061156e5761156e6119ad565b90600052602060002001549050806008838154811061158f5761158f6119ad565b60009182526020808320909101929092558281526009909152604080822084905585825281205560088054806115c7576115c7611b31565b6001900381819060005260206000200160009055905550505050565b60006115ee836107a7565b6001600160a01b039093166000908152600660209081526040808320868452825280832085905593825260079052919091209190915550565b50805461163390611922565b6000825580601f10611643575050565b601f01602090049060005260206000209081019061069a91905b80821115611671576000815560010161165d565b5090565b6001600160e01b03198116811461069a57600080fd5b60006020828403121561169d57600080fd5b813561123081611675565b60005b838110156116c35781810151838201526020016116ab565b50506000910152565b600081518084526116e48160208601602086016116a8565b601f01601f19169290920160200192915050565b60208152600061123060208301846116cc565b60006020828403121561171d57600080fd5b5035919050565b80356001600160a01b038116811461173b57600080fd5b919050565b6000806040838503121561175357600080fd5b61175c83611724565b946020939093013593505050565b60008060006060848603121561177f57600080fd5b61178884611724565b925061179660208501611724565b9150604084013590509250925092565b6000602082840312156117b857600080fd5b61123082611724565b600080604083850312156117d457600080fd5b6117dd83611724565b9150602083013580151581146117f257600080fd5b809150509250929050565b634e487b7160e01b600052604160045260246000fd5b6000806000806080858703121561182957600080fd5b61183285611724565b935061184060208601611724565b925060408501359150606085013567ffffffffffffffff8082111561186457600080fd5b818701915087601f83011261187857600080fd5b81358181111561188a5761188a6117fd565b604051601f8201601f19908116603f011681019083821181831017156118b2576118b26117fd565b816040528281528a60208487010111156118cb57600080fd5b82602086016020830137600060208483010152809550505050505092959194509250565b6000806040838503121561190257600080fd5b61190b83611724565b915061191960208401611724565b90509250929050565b600181811c9082168061193657607f821691505b60208210810361195657634e487b7160e01b600052602260045260246000fd5b50919050565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b818103818111156102f9576102f96119c3565b808201808211156102f9576102f96119c3565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60008351611a638184602088016116a8565b835190830190611a778183602088016116a8565b01949350505050565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090611ab3908301846116cc565b9695505050505050565b600060208284031215611acf57600080fd5b815161123081611675565b600060018201611aec57611aec6119c3565b5060010190565b634e487b7160e01b600052601260045260246000fd5b600082611b1857611b18611af3565b500490565b600082611b2c57611b2c611af3565b500690565b634e487b7160e01b600052603160045260246000fdfea26469706673582212203872e256a86394181b79ba707aa3cb81e8e8391b176c7154fadbd71b046269cc64736f6c63430008150033",
 }
 
 // StandardERC721ABI is the input ABI used to generate the binding from.
@@ -9719,7 +9719,7 @@ func (_StandardERC721 *StandardERC721Filterer) ParseTransfer(log types.Log) (*St
 // StringsMetaData contains all meta data concerning the Strings contract.
 var StringsMetaData = &bind.MetaData{
 	ABI: "[]",
-	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea264697066735822122014bc9c349f7036ecd842e14130832ee572ba291e4306ea85ad230690722337d664736f6c63430008000033",
+	Bin: "0x60566037600b82828239805160001a607314602a57634e487b7160e01b600052600060045260246000fd5b30600052607381538281f3fe73000000000000000000000000000000000000000030146080604052600080fdfea26469706673582212205c30999a5ec591c7212d152ea681dd2529a551ffc587554d3d4c425e92186aec64736f6c63430008150033",
 }
 
 // StringsABI is the input ABI used to generate the binding from.
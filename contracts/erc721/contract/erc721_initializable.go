@@ -0,0 +1,3280 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package erc721
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// StandardERC721InitializableMetaData contains all meta data concerning the StandardERC721Initializable contract.
+var StandardERC721InitializableMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenOfOwnerByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"095ea7b3": "approve(address,uint256)",
+		"70a08231": "balanceOf(address)",
+		"42966c68": "burn(uint256)",
+		"081812fc": "getApproved(uint256)",
+		"077f224a": "initialize(string,string,address)",
+		"e985e9c5": "isApprovedForAll(address,address)",
+		"06fdde03": "name()",
+		"8da5cb5b": "owner()",
+		"6352211e": "ownerOf(uint256)",
+		"715018a6": "renounceOwnership()",
+		"42842e0e": "safeTransferFrom(address,address,uint256)",
+		"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+		"a22cb465": "setApprovalForAll(address,bool)",
+		"01ffc9a7": "supportsInterface(bytes4)",
+		"95d89b41": "symbol()",
+		"4f6ccce7": "tokenByIndex(uint256)",
+		"2f745c59": "tokenOfOwnerByIndex(address,uint256)",
+		"c87b56dd": "tokenURI(uint256)",
+		"18160ddd": "totalSupply()",
+		"23b872dd": "transferFrom(address,address,uint256)",
+		"f2fde38b": "transferOwnership(address)",
+	},
+	Bin: "0x608060405234801561001057600080fd5b50600054610100900460ff168061002a575060005460ff16155b6100915760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b606482015260840160405180910390fd5b600054610100900460ff161580156100b3576000805461ffff19166101011790555b80156100c5576000805461ff00191690555b50612009806100d56000396000f3fe608060405234801561001057600080fd5b50600436106101375760003560e01c80634f6ccce7116100b857806395d89b411161007c57806395d89b411461027d578063a22cb46514610285578063b88d4fde14610298578063c87b56dd146102ab578063e985e9c5146102be578063f2fde38b146102fa57600080fd5b80634f6ccce71461022a5780636352211e1461023d57806370a0823114610250578063715018a6146102635780638da5cb5b1461026b57600080fd5b806318160ddd116100ff57806318160ddd146101cc57806323b872dd146101de5780632f745c59146101f157806342842e0e1461020457806342966c681461021757600080fd5b806301ffc9a71461013c57806306fdde0314610164578063077f224a14610179578063081812fc1461018e578063095ea7b3146101b9575b600080fd5b61014f61014a366004611911565b61030d565b60405190151581526020015b60405180910390f35b61016c61031e565b60405161015b919061197e565b61018c610187366004611a59565b6103b0565b005b6101a161019c366004611acd565b610433565b6040516001600160a01b03909116815260200161015b565b61018c6101c7366004611ae6565b6104c8565b60cb545b60405190815260200161015b565b61018c6101ec366004611b10565b6105dd565b6101d06101ff366004611ae6565b61060f565b61018c610212366004611b10565b6106a5565b61018c610225366004611acd565b6106c0565b6101d0610238366004611acd565b61073a565b6101a161024b366004611acd565b6107cd565b6101d061025e366004611b4c565b610844565b61018c6108cb565b61012d546001600160a01b03166101a1565b61016c610932565b61018c610293366004611b67565b610941565b61018c6102a6366004611ba3565b610a05565b61016c6102b9366004611acd565b610a37565b61014f6102cc366004611c1f565b6001600160a01b039182166000908152606a6020908152604080832093909416825291909152205460ff1690565b61018c610308366004611b4c565b610a42565b600061031882610b0b565b92915050565b60606065805461032d90611c52565b80601f016020809104026020016040519081016040528092919081815260200182805461035990611c52565b80156103a65780601f1061037b576101008083540402835291602001916103a6565b820191906000526020600020905b81548152906001019060200180831161038957829003601f168201915b5050505050905090565b600054610100900460ff16806103c9575060005460ff16155b6103ee5760405162461bcd60e51b81526004016103e590611c8c565b60405180910390fd5b600054610100900460ff16158015610410576000805461ffff19166101011790555b61041b848484610b30565b801561042d576000805461ff00191690555b50505050565b6000818152606760205260408120546001600160a01b03166104ac5760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084016103e5565b506000908152606960205260409020546001600160a01b031690565b60006104d3826107cd565b9050806001600160a01b0316836001600160a01b0316036105405760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b60648201526084016103e5565b336001600160a01b038216148061055c575061055c81336102cc565b6105ce5760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c000000000000000060648201526084016103e5565b6105d88383610c2a565b505050565b6105e8335b82610c98565b6106045760405162461bcd60e51b81526004016103e590611cda565b6105d8838383610d8f565b600061061a83610844565b821061067c5760405162461bcd60e51b815260206004820152602b60248201527f455243373231456e756d657261626c653a206f776e657220696e646578206f7560448201526a74206f6620626f756e647360a81b60648201526084016103e5565b506001600160a01b0391909116600090815260c960209081526040808320938352929052205490565b6105d883838360405180602001604052806000815250610a05565b6106c9336105e2565b61072e5760405162461bcd60e51b815260206004820152603060248201527f4552433732314275726e61626c653a2063616c6c6572206973206e6f74206f7760448201526f1b995c881b9bdc88185c1c1c9bdd995960821b60648201526084016103e5565b61073781610f3a565b50565b600061074560cb5490565b82106107a85760405162461bcd60e51b815260206004820152602c60248201527f455243373231456e756d657261626c653a20676c6f62616c20696e646578206f60448201526b7574206f6620626f756e647360a01b60648201526084016103e5565b60cb82815481106107bb576107bb611d2b565b90600052602060002001549050919050565b6000818152606760205260408120546001600160a01b0316806103185760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b60648201526084016103e5565b60006001600160a01b0382166108af5760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b60648201526084016103e5565b506001600160a01b031660009081526068602052604090205490565b61012d546001600160a01b031633146109265760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016103e5565b6109306000610f43565b565b60606066805461032d90611c52565b336001600160a01b038316036109995760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c65720000000000000060448201526064016103e5565b336000818152606a602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b610a0f3383610c98565b610a2b5760405162461bcd60e51b81526004016103e590611cda565b61042d84848484610f96565b606061031882610fc9565b61012d546001600160a01b03163314610a9d5760405162461bcd60e51b815260206004820181905260248201527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e657260448201526064016103e5565b6001600160a01b038116610b025760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b60648201526084016103e5565b61073781610f43565b60006001600160e01b0319821663780e9d6360e01b1480610318575061031882611147565b600054610100900460ff1680610b49575060005460ff16155b610b655760405162461bcd60e51b81526004016103e590611c8c565b600054610100900460ff16158015610b87576000805461ffff19166101011790555b6001600160a01b038216610bef5760405162461bcd60e51b815260206004820152602960248201527f5374616e646172644552433732313a206f776e657220697320746865207a65726044820152686f206164647265737360b81b60648201526084016103e5565b610bf7611197565b610bff611197565b610c098484611202565b610c11611197565b610c19611197565b610c21611197565b61041b82610f43565b600081815260696020526040902080546001600160a01b0319166001600160a01b0384169081179091558190610c5f826107cd565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152606760205260408120546001600160a01b0316610d115760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b60648201526084016103e5565b6000610d1c836107cd565b9050806001600160a01b0316846001600160a01b03161480610d575750836001600160a01b0316610d4c84610433565b6001600160a01b0316145b80610d8757506001600160a01b038082166000908152606a602090815260408083209388168352929052205460ff165b949350505050565b826001600160a01b0316610da2826107cd565b6001600160a01b031614610e0a5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b60648201526084016103e5565b6001600160a01b038216610e6c5760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b60648201526084016103e5565b610e77838383611289565b610e82600082610c2a565b6001600160a01b0383166000908152606860205260408120805460019290610eab908490611d57565b90915550506001600160a01b0382166000908152606860205260408120805460019290610ed9908490611d6a565b909155505060008181526067602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b61073781611294565b61012d80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b610fa1848484610d8f565b610fad848484846112d4565b61042d5760405162461bcd60e51b81526004016103e590611d7d565b6000818152606760205260409020546060906001600160a01b031661104a5760405162461bcd60e51b815260206004820152603160248201527f45524337323155524953746f726167653a2055524920717565727920666f72206044820152703737b732bc34b9ba32b73a103a37b5b2b760791b60648201526084016103e5565b600082815260fb60205260408120805461106390611c52565b80601f016020809104026020016040519081016040528092919081815260200182805461108f90611c52565b80156110dc5780601f106110b1576101008083540402835291602001916110dc565b820191906000526020600020905b8154815290600101906020018083116110bf57829003601f168201915b5050505050905060006110fa60408051602081019091526000815290565b9050805160000361110c575092915050565b81511561113e578082604051602001611126929190611dcf565b60405160208183030381529060405292505050919050565b610d87846113d5565b60006001600160e01b031982166380ac58cd60e01b148061117857506001600160e01b03198216635b5e139f60e01b145b8061031857506301ffc9a760e01b6001600160e01b0319831614610318565b600054610100900460ff16806111b0575060005460ff16155b6111cc5760405162461bcd60e51b81526004016103e590611c8c565b600054610100900460ff161580156111ee576000805461ffff19166101011790555b8015610737576000805461ff001916905550565b600054610100900460ff168061121b575060005460ff16155b6112375760405162461bcd60e51b81526004016103e590611c8c565b600054610100900460ff16158015611259576000805461ffff19166101011790555b60656112658482611e4c565b5060666112728382611e4c565b5080156105d8576000805461ff0019169055505050565b6105d88383836114bd565b61129d81611575565b600081815260fb6020526040902080546112b690611c52565b15905061073757600081815260fb60205260408120610737916118ad565b60006001600160a01b0384163b156113ca57604051630a85bd0160e11b81526001600160a01b0385169063150b7a0290611318903390899088908890600401611f0c565b6020604051808303816000875af1925050508015611353575060408051601f3d908101601f1916820190925261135091810190611f49565b60015b6113b0573d808015611381576040519150601f19603f3d011682016040523d82523d6000602084013e611386565b606091505b5080516000036113a85760405162461bcd60e51b81526004016103e590611d7d565b805181602001fd5b6001600160e01b031916630a85bd0160e11b149050610d87565b506001949350505050565b6000818152606760205260409020546060906001600160a01b03166114545760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b60648201526084016103e5565b600061146b60408051602081019091526000815290565b9050600081511161148b57604051806020016040528060008152506114b6565b806114958461161c565b6040516020016114a6929190611dcf565b6040516020818303038152906040525b9392505050565b6001600160a01b038316611518576115138160cb8054600083815260cc60205260408120829055600182018355919091527fa7ce836d032b2bf62b7e2097a8e0a6d8aeb35405ad15271e96d3b0188a1d06fb0155565b61153b565b816001600160a01b0316836001600160a01b03161461153b5761153b838261171d565b6001600160a01b038216611552576105d8816117ba565b826001600160a01b0316826001600160a01b0316146105d8576105d88282611869565b6000611580826107cd565b905061158e81600084611289565b611599600083610c2a565b6001600160a01b03811660009081526068602052604081208054600192906115c2908490611d57565b909155505060008281526067602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b6060816000036116435750506040805180820190915260018152600360fc1b602082015290565b8160005b811561166d578061165781611f66565b91506116669050600a83611f95565b9150611647565b60008167ffffffffffffffff81111561168857611688611991565b6040519080825280601f01601f1916602001820160405280156116b2576020820181803683370190505b5090505b8415610d87576116c7600183611d57565b91506116d4600a86611fa9565b6116df906030611d6a565b60f81b8183815181106116f4576116f4611d2b565b60200101906001600160f81b031916908160001a905350611716600a86611f95565b94506116b6565b6000600161172a84610844565b6117349190611d57565b600083815260ca6020526040902054909150808214611787576001600160a01b038416600090815260c960209081526040808320858452825280832054848452818420819055835260ca90915290208190555b50600091825260ca602090815260408084208490556001600160a01b03909416835260c981528383209183525290812055565b60cb546000906117cc90600190611d57565b600083815260cc602052604081205460cb80549394509092849081106117f4576117f4611d2b565b906000526020600020015490508060cb838154811061181557611815611d2b565b600091825260208083209091019290925582815260cc909152604080822084905585825281205560cb80548061184d5761184d611fbd565b6001900381819060005260206000200160009055905550505050565b600061187483610844565b6001600160a01b03909316600090815260c960209081526040808320868452825280832085905593825260ca9052919091209190915550565b5080546118b990611c52565b6000825580601f106118c9575050565b601f01602090049060005260206000209081019061073791905b808211156118f757600081556001016118e3565b5090565b6001600160e01b03198116811461073757600080fd5b60006020828403121561192357600080fd5b81356114b6816118fb565b60005b83811015611949578181015183820152602001611931565b50506000910152565b6000815180845261196a81602086016020860161192e565b601f01601f19169290920160200192915050565b6020815260006114b66020830184611952565b634e487b7160e01b600052604160045260246000fd5b600067ffffffffffffffff808411156119c2576119c2611991565b604051601f8501601f19908116603f011681019082821181831017156119ea576119ea611991565b81604052809350858152868686011115611a0357600080fd5b858560208301376000602087830101525050509392505050565b600082601f830112611a2e57600080fd5b6114b6838335602085016119a7565b80356001600160a01b0381168114611a5457600080fd5b919050565b600080600060608486031215611a6e57600080fd5b833567ffffffffffffffff80821115611a8657600080fd5b611a9287838801611a1d565b94506020860135915080821115611aa857600080fd5b50611ab586828701611a1d565b925050611ac460408501611a3d565b90509250925092565b600060208284031215611adf57600080fd5b5035919050565b60008060408385031215611af957600080fd5b611b0283611a3d565b946020939093013593505050565b600080600060608486031215611b2557600080fd5b611b2e84611a3d565b9250611b3c60208501611a3d565b9150604084013590509250925092565b600060208284031215611b5e57600080fd5b6114b682611a3d565b60008060408385031215611b7a57600080fd5b611b8383611a3d565b915060208301358015158114611b9857600080fd5b809150509250929050565b60008060008060808587031215611bb957600080fd5b611bc285611a3d565b9350611bd060208601611a3d565b925060408501359150606085013567ffffffffffffffff811115611bf357600080fd5b8501601f81018713611c0457600080fd5b611c13878235602084016119a7565b91505092959194509250565b60008060408385031215611c3257600080fd5b611c3b83611a3d565b9150611c4960208401611a3d565b90509250929050565b600181811c90821680611c6657607f821691505b602082108103611c8657634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b634e487b7160e01b600052601160045260246000fd5b8181038181111561031857610318611d41565b8082018082111561031857610318611d41565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60008351611de181846020880161192e565b835190830190611df581836020880161192e565b01949350505050565b601f8211156105d857600081815260208120601f850160051c81016020861015611e255750805b601f850160051c820191505b81811015611e4457828155600101611e31565b505050505050565b815167ffffffffffffffff811115611e6657611e66611991565b611e7a81611e748454611c52565b84611dfe565b602080601f831160018114611eaf5760008415611e975750858301515b600019600386901b1c1916600185901b178555611e44565b600085815260208120601f198616915b82811015611ede57888601518255948401946001909101908401611ebf565b5085821015611efc5787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b6001600160a01b0385811682528416602082015260408101839052608060608201819052600090611f3f90830184611952565b9695505050505050565b600060208284031215611f5b57600080fd5b81516114b6816118fb565b600060018201611f7857611f78611d41565b5060010190565b634e487b7160e01b600052601260045260246000fd5b600082611fa457611fa4611f7f565b500490565b600082611fb857611fb8611f7f565b500690565b634e487b7160e01b600052603160045260246000fdfea2646970667358221220d9eb91956c5a2d4b7307b206e17d54f746a13f07df33f55eeeb5ba39cb6ce4ad64736f6c63430008150033",
+}
+
+// StandardERC721InitializableABI is the input ABI used to generate the binding from.
+// Deprecated: Use StandardERC721InitializableMetaData.ABI instead.
+var StandardERC721InitializableABI = StandardERC721InitializableMetaData.ABI
+
+// Deprecated: Use StandardERC721InitializableMetaData.Sigs instead.
+// StandardERC721InitializableFuncSigs maps the 4-byte function signature to its string representation.
+var StandardERC721InitializableFuncSigs = StandardERC721InitializableMetaData.Sigs
+
+// StandardERC721InitializableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StandardERC721InitializableMetaData.Bin instead.
+var StandardERC721InitializableBin = StandardERC721InitializableMetaData.Bin
+
+// DeployStandardERC721Initializable deploys a new Ethereum contract, binding an instance of StandardERC721Initializable to it.
+func DeployStandardERC721Initializable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StandardERC721Initializable, error) {
+	parsed, err := StandardERC721InitializableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StandardERC721InitializableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &StandardERC721Initializable{StandardERC721InitializableCaller: StandardERC721InitializableCaller{contract: contract}, StandardERC721InitializableTransactor: StandardERC721InitializableTransactor{contract: contract}, StandardERC721InitializableFilterer: StandardERC721InitializableFilterer{contract: contract}}, nil
+}
+
+// StandardERC721Initializable is an auto generated Go binding around an Ethereum contract.
+type StandardERC721Initializable struct {
+	StandardERC721InitializableCaller     // Read-only binding to the contract
+	StandardERC721InitializableTransactor // Write-only binding to the contract
+	StandardERC721InitializableFilterer   // Log filterer for contract events
+}
+
+// StandardERC721InitializableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StandardERC721InitializableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721InitializableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StandardERC721InitializableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721InitializableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StandardERC721InitializableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721InitializableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StandardERC721InitializableSession struct {
+	Contract     *StandardERC721Initializable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// StandardERC721InitializableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StandardERC721InitializableCallerSession struct {
+	Contract *StandardERC721InitializableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// StandardERC721InitializableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StandardERC721InitializableTransactorSession struct {
+	Contract     *StandardERC721InitializableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// StandardERC721InitializableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StandardERC721InitializableRaw struct {
+	Contract *StandardERC721Initializable // Generic contract binding to access the raw methods on
+}
+
+// StandardERC721InitializableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StandardERC721InitializableCallerRaw struct {
+	Contract *StandardERC721InitializableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StandardERC721InitializableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StandardERC721InitializableTransactorRaw struct {
+	Contract *StandardERC721InitializableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStandardERC721Initializable creates a new instance of StandardERC721Initializable, bound to a specific deployed contract.
+func NewStandardERC721Initializable(address common.Address, backend bind.ContractBackend) (*StandardERC721Initializable, error) {
+	contract, err := bindStandardERC721Initializable(address, false, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721Initializable{StandardERC721InitializableCaller: StandardERC721InitializableCaller{contract: contract}, StandardERC721InitializableTransactor: StandardERC721InitializableTransactor{contract: contract}, StandardERC721InitializableFilterer: StandardERC721InitializableFilterer{contract: contract}}, nil
+}
+
+// NewStandardERC721InitializableCaller creates a new read-only instance of StandardERC721Initializable, bound to a specific deployed contract.
+func NewStandardERC721InitializableCaller(address common.Address, caller bind.ContractCaller) (*StandardERC721InitializableCaller, error) {
+	contract, err := bindStandardERC721Initializable(address, false, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableCaller{contract: contract}, nil
+}
+
+// NewStandardERC721InitializableTransactor creates a new write-only instance of StandardERC721Initializable, bound to a specific deployed contract.
+func NewStandardERC721InitializableTransactor(address common.Address, transactor bind.ContractTransactor) (*StandardERC721InitializableTransactor, error) {
+	contract, err := bindStandardERC721Initializable(address, false, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableTransactor{contract: contract}, nil
+}
+
+// NewStandardERC721InitializableFilterer creates a new log filterer instance of StandardERC721Initializable, bound to a specific deployed contract.
+func NewStandardERC721InitializableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*StandardERC721InitializableFilterer, error) {
+	contract, err := bindStandardERC721Initializable(address, fuzzyAddress, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableFilterer{contract: contract}, nil
+}
+
+// bindStandardERC721Initializable binds a generic wrapper to an already deployed contract.
+func bindStandardERC721Initializable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StandardERC721InitializableABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC721Initializable *StandardERC721InitializableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC721Initializable.Contract.StandardERC721InitializableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC721Initializable *StandardERC721InitializableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.StandardERC721InitializableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC721Initializable *StandardERC721InitializableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.StandardERC721InitializableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC721Initializable *StandardERC721InitializableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC721Initializable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.BalanceOf(&_StandardERC721Initializable.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.BalanceOf(&_StandardERC721Initializable.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Initializable.Contract.GetApproved(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Initializable.Contract.GetApproved(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _StandardERC721Initializable.Contract.IsApprovedForAll(&_StandardERC721Initializable.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _StandardERC721Initializable.Contract.IsApprovedForAll(&_StandardERC721Initializable.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Name() (string, error) {
+	return _StandardERC721Initializable.Contract.Name(&_StandardERC721Initializable.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) Name() (string, error) {
+	return _StandardERC721Initializable.Contract.Name(&_StandardERC721Initializable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Owner() (common.Address, error) {
+	return _StandardERC721Initializable.Contract.Owner(&_StandardERC721Initializable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) Owner() (common.Address, error) {
+	return _StandardERC721Initializable.Contract.Owner(&_StandardERC721Initializable.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Initializable.Contract.OwnerOf(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Initializable.Contract.OwnerOf(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC721Initializable.Contract.SupportsInterface(&_StandardERC721Initializable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC721Initializable.Contract.SupportsInterface(&_StandardERC721Initializable.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Symbol() (string, error) {
+	return _StandardERC721Initializable.Contract.Symbol(&_StandardERC721Initializable.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) Symbol() (string, error) {
+	return _StandardERC721Initializable.Contract.Symbol(&_StandardERC721Initializable.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TokenByIndex(&_StandardERC721Initializable.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TokenByIndex(&_StandardERC721Initializable.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TokenOfOwnerByIndex(&_StandardERC721Initializable.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TokenOfOwnerByIndex(&_StandardERC721Initializable.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _StandardERC721Initializable.Contract.TokenURI(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _StandardERC721Initializable.Contract.TokenURI(&_StandardERC721Initializable.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Initializable.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TotalSupply() (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TotalSupply(&_StandardERC721Initializable.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Initializable *StandardERC721InitializableCallerSession) TotalSupply() (*big.Int, error) {
+	return _StandardERC721Initializable.Contract.TotalSupply(&_StandardERC721Initializable.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Approve(&_StandardERC721Initializable.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Approve(&_StandardERC721Initializable.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Burn(&_StandardERC721Initializable.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Burn(&_StandardERC721Initializable.TransactOpts, tokenId)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) Initialize(opts *bind.TransactOpts, name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "initialize", name, symbol, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) Initialize(name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Initialize(&_StandardERC721Initializable.TransactOpts, name, symbol, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) Initialize(name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.Initialize(&_StandardERC721Initializable.TransactOpts, name, symbol, owner_)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.RenounceOwnership(&_StandardERC721Initializable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.RenounceOwnership(&_StandardERC721Initializable.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SafeTransferFrom(&_StandardERC721Initializable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SafeTransferFrom(&_StandardERC721Initializable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SafeTransferFrom0(&_StandardERC721Initializable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SafeTransferFrom0(&_StandardERC721Initializable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SetApprovalForAll(&_StandardERC721Initializable.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.SetApprovalForAll(&_StandardERC721Initializable.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.TransferFrom(&_StandardERC721Initializable.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.TransferFrom(&_StandardERC721Initializable.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.TransferOwnership(&_StandardERC721Initializable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Initializable *StandardERC721InitializableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Initializable.Contract.TransferOwnership(&_StandardERC721Initializable.TransactOpts, newOwner)
+}
+
+// StandardERC721InitializableApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableApprovalIterator struct {
+	Event *StandardERC721InitializableApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721InitializableApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721InitializableApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721InitializableApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721InitializableApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721InitializableApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721InitializableApproval represents a Approval event raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*StandardERC721InitializableApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableApprovalIterator{contract: _StandardERC721Initializable.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *StandardERC721InitializableApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721InitializableApproval)
+				if err := _StandardERC721Initializable.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) ParseApproval(log types.Log) (*StandardERC721InitializableApproval, error) {
+	event := new(StandardERC721InitializableApproval)
+	if err := _StandardERC721Initializable.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721InitializableApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableApprovalForAllIterator struct {
+	Event *StandardERC721InitializableApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721InitializableApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721InitializableApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721InitializableApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721InitializableApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721InitializableApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721InitializableApprovalForAll represents a ApprovalForAll event raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*StandardERC721InitializableApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableApprovalForAllIterator{contract: _StandardERC721Initializable.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *StandardERC721InitializableApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721InitializableApprovalForAll)
+				if err := _StandardERC721Initializable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) ParseApprovalForAll(log types.Log) (*StandardERC721InitializableApprovalForAll, error) {
+	event := new(StandardERC721InitializableApprovalForAll)
+	if err := _StandardERC721Initializable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721InitializableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableOwnershipTransferredIterator struct {
+	Event *StandardERC721InitializableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721InitializableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721InitializableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721InitializableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721InitializableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721InitializableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721InitializableOwnershipTransferred represents a OwnershipTransferred event raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StandardERC721InitializableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableOwnershipTransferredIterator{contract: _StandardERC721Initializable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StandardERC721InitializableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721InitializableOwnershipTransferred)
+				if err := _StandardERC721Initializable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) ParseOwnershipTransferred(log types.Log) (*StandardERC721InitializableOwnershipTransferred, error) {
+	event := new(StandardERC721InitializableOwnershipTransferred)
+	if err := _StandardERC721Initializable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721InitializableTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableTransferIterator struct {
+	Event *StandardERC721InitializableTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721InitializableTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721InitializableTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721InitializableTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721InitializableTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721InitializableTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721InitializableTransfer represents a Transfer event raised by the StandardERC721Initializable contract.
+type StandardERC721InitializableTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*StandardERC721InitializableTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721InitializableTransferIterator{contract: _StandardERC721Initializable.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *StandardERC721InitializableTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Initializable.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721InitializableTransfer)
+				if err := _StandardERC721Initializable.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Initializable *StandardERC721InitializableFilterer) ParseTransfer(log types.Log) (*StandardERC721InitializableTransfer, error) {
+	event := new(StandardERC721InitializableTransfer)
+	if err := _StandardERC721Initializable.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableMetaData contains all meta data concerning the StandardERC721Upgradeable contract.
+var StandardERC721UpgradeableMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"previousAdmin\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"newAdmin\",\"type\":\"address\"}],\"name\":\"AdminChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"approved\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Approval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"ApprovalForAll\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"beacon\",\"type\":\"address\"}],\"name\":\"BeaconUpgraded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"Transfer\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"implementation\",\"type\":\"address\"}],\"name\":\"Upgraded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"approve\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"}],\"name\":\"balanceOf\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"burn\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"getApproved\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"name\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"symbol\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"owner_\",\"type\":\"address\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"}],\"name\":\"isApprovedForAll\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"name\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"ownerOf\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_data\",\"type\":\"bytes\"}],\"name\":\"safeTransferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"operator\",\"type\":\"address\"},{\"internalType\":\"bool\",\"name\":\"approved\",\"type\":\"bool\"}],\"name\":\"setApprovalForAll\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes4\",\"name\":\"interfaceId\",\"type\":\"bytes4\"}],\"name\":\"supportsInterface\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"symbol\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"owner\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"index\",\"type\":\"uint256\"}],\"name\":\"tokenOfOwnerByIndex\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"tokenURI\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"totalSupply\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"from\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"to\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"tokenId\",\"type\":\"uint256\"}],\"name\":\"transferFrom\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"}],\"name\":\"upgradeTo\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newImplementation\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"data\",\"type\":\"bytes\"}],\"name\":\"upgradeToAndCall\",\"outputs\":[],\"stateMutability\":\"payable\",\"type\":\"function\"}]",
+	Sigs: map[string]string{
+		"095ea7b3": "approve(address,uint256)",
+		"70a08231": "balanceOf(address)",
+		"42966c68": "burn(uint256)",
+		"081812fc": "getApproved(uint256)",
+		"077f224a": "initialize(string,string,address)",
+		"e985e9c5": "isApprovedForAll(address,address)",
+		"06fdde03": "name()",
+		"8da5cb5b": "owner()",
+		"6352211e": "ownerOf(uint256)",
+		"715018a6": "renounceOwnership()",
+		"42842e0e": "safeTransferFrom(address,address,uint256)",
+		"b88d4fde": "safeTransferFrom(address,address,uint256,bytes)",
+		"a22cb465": "setApprovalForAll(address,bool)",
+		"01ffc9a7": "supportsInterface(bytes4)",
+		"95d89b41": "symbol()",
+		"4f6ccce7": "tokenByIndex(uint256)",
+		"2f745c59": "tokenOfOwnerByIndex(address,uint256)",
+		"c87b56dd": "tokenURI(uint256)",
+		"18160ddd": "totalSupply()",
+		"23b872dd": "transferFrom(address,address,uint256)",
+		"f2fde38b": "transferOwnership(address)",
+		"3659cfe6": "upgradeTo(address)",
+		"4f1ef286": "upgradeToAndCall(address,bytes)",
+	},
+	Bin: "0x60a06040523060805234801561001457600080fd5b50600054610100900460ff168061002e575060005460ff16155b6100955760405162461bcd60e51b815260206004820152602e60248201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160448201526d191e481a5b9a5d1a585b1a5e995960921b606482015260840160405180910390fd5b600054610100900460ff161580156100b7576000805461ffff19166101011790555b80156100c9576000805461ff00191690555b50608051612771620000fb6000396000818161080c0152818161084c0152818161096501526109a501526127716000f3fe6080604052600436106101405760003560e01c80634f1ef286116100b657806395d89b411161006f57806395d89b411461037c578063a22cb46514610391578063b88d4fde146103b1578063c87b56dd146103d1578063e985e9c5146103f1578063f2fde38b1461043a57600080fd5b80634f1ef286146102d55780634f6ccce7146102e85780636352211e1461030857806370a0823114610328578063715018a6146103485780638da5cb5b1461035d57600080fd5b806318160ddd1161010857806318160ddd1461021657806323b872dd146102355780632f745c59146102555780633659cfe61461027557806342842e0e1461029557806342966c68146102b557600080fd5b806301ffc9a71461014557806306fdde031461017a578063077f224a1461019c578063081812fc146101be578063095ea7b3146101f6575b600080fd5b34801561015157600080fd5b50610165610160366004611f38565b61045a565b60405190151581526020015b60405180910390f35b34801561018657600080fd5b5061018f61046b565b6040516101719190611fa5565b3480156101a857600080fd5b506101bc6101b7366004612077565b6104fd565b005b3480156101ca57600080fd5b506101de6101d93660046120eb565b610590565b6040516001600160a01b039091168152602001610171565b34801561020257600080fd5b506101bc610211366004612104565b610625565b34801561022257600080fd5b5060cb545b604051908152602001610171565b34801561024157600080fd5b506101bc61025036600461212e565b61073a565b34801561026157600080fd5b50610227610270366004612104565b61076c565b34801561028157600080fd5b506101bc61029036600461216a565b610802565b3480156102a157600080fd5b506101bc6102b036600461212e565b6108c9565b3480156102c157600080fd5b506101bc6102d03660046120eb565b6108e4565b6101bc6102e3366004612185565b61095b565b3480156102f457600080fd5b506102276103033660046120eb565b610a14565b34801561031457600080fd5b506101de6103233660046120eb565b610aa7565b34801561033457600080fd5b5061022761034336600461216a565b610b1e565b34801561035457600080fd5b506101bc610ba5565b34801561036957600080fd5b5061012d546001600160a01b03166101de565b34801561038857600080fd5b5061018f610bdc565b34801561039d57600080fd5b506101bc6103ac3660046121d3565b610beb565b3480156103bd57600080fd5b506101bc6103cc36600461220f565b610caf565b3480156103dd57600080fd5b5061018f6103ec3660046120eb565b610ce1565b3480156103fd57600080fd5b5061016561040c366004612277565b6001600160a01b039182166000908152606a6020908152604080832093909416825291909152205460ff1690565b34801561044657600080fd5b506101bc61045536600461216a565b610cec565b600061046582610d85565b92915050565b60606065805461047a906122aa565b80601f01602080910402602001604051908101604052809291908181526020018280546104a6906122aa565b80156104f35780601f106104c8576101008083540402835291602001916104f3565b820191906000526020600020905b8154815290600101906020018083116104d657829003601f168201915b5050505050905090565b600054610100900460ff1680610516575060005460ff16155b61053b5760405162461bcd60e51b8152600401610532906122e4565b60405180910390fd5b600054610100900460ff1615801561055d576000805461ffff19166101011790555b610568848484610daa565b610570610ea4565b610578610ea4565b801561058a576000805461ff00191690555b50505050565b6000818152606760205260408120546001600160a01b03166106095760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a20617070726f76656420717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610532565b506000908152606960205260409020546001600160a01b031690565b600061063082610aa7565b9050806001600160a01b0316836001600160a01b03160361069d5760405162461bcd60e51b815260206004820152602160248201527f4552433732313a20617070726f76616c20746f2063757272656e74206f776e656044820152603960f91b6064820152608401610532565b336001600160a01b03821614806106b957506106b9813361040c565b61072b5760405162461bcd60e51b815260206004820152603860248201527f4552433732313a20617070726f76652063616c6c6572206973206e6f74206f7760448201527f6e6572206e6f7220617070726f76656420666f7220616c6c00000000000000006064820152608401610532565b6107358383610f0f565b505050565b610745335b82610f7d565b6107615760405162461bcd60e51b815260040161053290612332565b610735838383611074565b600061077783610b1e565b82106107d95760405162461bcd60e51b815260206004820152602b60248201527f455243373231456e756d657261626c653a206f776e657220696e646578206f7560448201526a74206f6620626f756e647360a81b6064820152608401610532565b506001600160a01b0391909116600090815260c960209081526040808320938352929052205490565b6001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016300361084a5760405162461bcd60e51b815260040161053290612383565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031661087c61121f565b6001600160a01b0316146108a25760405162461bcd60e51b8152600401610532906123cf565b6108ab8161124d565b6108c681604051806020016040528060008152506000611278565b50565b61073583838360405180602001604052806000815250610caf565b6108ed3361073f565b6109525760405162461bcd60e51b815260206004820152603060248201527f4552433732314275726e61626c653a2063616c6c6572206973206e6f74206f7760448201526f1b995c881b9bdc88185c1c1c9bdd995960821b6064820152608401610532565b6108c6816113c3565b6001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001630036109a35760405162461bcd60e51b815260040161053290612383565b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166109d561121f565b6001600160a01b0316146109fb5760405162461bcd60e51b8152600401610532906123cf565b610a048261124d565b610a1082826001611278565b5050565b6000610a1f60cb5490565b8210610a825760405162461bcd60e51b815260206004820152602c60248201527f455243373231456e756d657261626c653a20676c6f62616c20696e646578206f60448201526b7574206f6620626f756e647360a01b6064820152608401610532565b60cb8281548110610a9557610a9561241b565b90600052602060002001549050919050565b6000818152606760205260408120546001600160a01b0316806104655760405162461bcd60e51b815260206004820152602960248201527f4552433732313a206f776e657220717565727920666f72206e6f6e657869737460448201526832b73a103a37b5b2b760b91b6064820152608401610532565b60006001600160a01b038216610b895760405162461bcd60e51b815260206004820152602a60248201527f4552433732313a2062616c616e636520717565727920666f7220746865207a65604482015269726f206164647265737360b01b6064820152608401610532565b506001600160a01b031660009081526068602052604090205490565b61012d546001600160a01b03163314610bd05760405162461bcd60e51b815260040161053290612431565b610bda60006113cc565b565b60606066805461047a906122aa565b336001600160a01b03831603610c435760405162461bcd60e51b815260206004820152601960248201527f4552433732313a20617070726f766520746f2063616c6c6572000000000000006044820152606401610532565b336000818152606a602090815260408083206001600160a01b03871680855290835292819020805460ff191686151590811790915590519081529192917f17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31910160405180910390a35050565b610cb93383610f7d565b610cd55760405162461bcd60e51b815260040161053290612332565b61058a8484848461141f565b606061046582611452565b61012d546001600160a01b03163314610d175760405162461bcd60e51b815260040161053290612431565b6001600160a01b038116610d7c5760405162461bcd60e51b815260206004820152602660248201527f4f776e61626c653a206e6577206f776e657220697320746865207a65726f206160448201526564647265737360d01b6064820152608401610532565b6108c6816113cc565b60006001600160e01b0319821663780e9d6360e01b14806104655750610465826115d0565b600054610100900460ff1680610dc3575060005460ff16155b610ddf5760405162461bcd60e51b8152600401610532906122e4565b600054610100900460ff16158015610e01576000805461ffff19166101011790555b6001600160a01b038216610e695760405162461bcd60e51b815260206004820152602960248201527f5374616e646172644552433732313a206f776e657220697320746865207a65726044820152686f206164647265737360b81b6064820152608401610532565b610e71610ea4565b610e79610ea4565b610e838484611620565b610e8b610ea4565b610e93610ea4565b610e9b610ea4565b610578826113cc565b600054610100900460ff1680610ebd575060005460ff16155b610ed95760405162461bcd60e51b8152600401610532906122e4565b600054610100900460ff16158015610efb576000805461ffff19166101011790555b80156108c6576000805461ff001916905550565b600081815260696020526040902080546001600160a01b0319166001600160a01b0384169081179091558190610f4482610aa7565b6001600160a01b03167f8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b92560405160405180910390a45050565b6000818152606760205260408120546001600160a01b0316610ff65760405162461bcd60e51b815260206004820152602c60248201527f4552433732313a206f70657261746f7220717565727920666f72206e6f6e657860448201526b34b9ba32b73a103a37b5b2b760a11b6064820152608401610532565b600061100183610aa7565b9050806001600160a01b0316846001600160a01b0316148061103c5750836001600160a01b031661103184610590565b6001600160a01b0316145b8061106c57506001600160a01b038082166000908152606a602090815260408083209388168352929052205460ff165b949350505050565b826001600160a01b031661108782610aa7565b6001600160a01b0316146110ef5760405162461bcd60e51b815260206004820152602960248201527f4552433732313a207472616e73666572206f6620746f6b656e2074686174206960448201526839903737ba1037bbb760b91b6064820152608401610532565b6001600160a01b0382166111515760405162461bcd60e51b8152602060048201526024808201527f4552433732313a207472616e7366657220746f20746865207a65726f206164646044820152637265737360e01b6064820152608401610532565b61115c8383836116a7565b611167600082610f0f565b6001600160a01b038316600090815260686020526040812080546001929061119090849061247c565b90915550506001600160a01b03821660009081526068602052604081208054600192906111be90849061248f565b909155505060008181526067602052604080822080546001600160a01b0319166001600160a01b0386811691821790925591518493918716917fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef91a4505050565b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc546001600160a01b031690565b61012d546001600160a01b031633146108c65760405162461bcd60e51b815260040161053290612431565b600061128261121f565b905061128d846116b2565b60008351118061129a5750815b156112ab576112a98484611757565b505b7f4910fdfa16fed3260ed0e7147f7cc6da11a60208b5b9406d12a635614ffd9143805460ff166113bc57805460ff191660011781556040516001600160a01b038316602482015261132a90869060440160408051601f198184030181529190526020810180516001600160e01b0316631b2ce7f360e11b179052611757565b50805460ff1916815561133b61121f565b6001600160a01b0316826001600160a01b0316146113b35760405162461bcd60e51b815260206004820152602f60248201527f45524331393637557067726164653a207570677261646520627265616b73206660448201526e75727468657220757067726164657360881b6064820152608401610532565b6113bc85611842565b5050505050565b6108c681611882565b61012d80546001600160a01b038381166001600160a01b0319831681179093556040519116919082907f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e090600090a35050565b61142a848484611074565b611436848484846118c2565b61058a5760405162461bcd60e51b8152600401610532906124a2565b6000818152606760205260409020546060906001600160a01b03166114d35760405162461bcd60e51b815260206004820152603160248201527f45524337323155524953746f726167653a2055524920717565727920666f72206044820152703737b732bc34b9ba32b73a103a37b5b2b760791b6064820152608401610532565b600082815260fb6020526040812080546114ec906122aa565b80601f0160208091040260200160405190810160405280929190818152602001828054611518906122aa565b80156115655780601f1061153a57610100808354040283529160200191611565565b820191906000526020600020905b81548152906001019060200180831161154857829003601f168201915b50505050509050600061158360408051602081019091526000815290565b90508051600003611595575092915050565b8151156115c75780826040516020016115af9291906124f4565b60405160208183030381529060405292505050919050565b61106c846119c3565b60006001600160e01b031982166380ac58cd60e01b148061160157506001600160e01b03198216635b5e139f60e01b145b8061046557506301ffc9a760e01b6001600160e01b0319831614610465565b600054610100900460ff1680611639575060005460ff16155b6116555760405162461bcd60e51b8152600401610532906122e4565b600054610100900460ff16158015611677576000805461ffff19166101011790555b60656116838482612571565b5060666116908382612571565b508015610735576000805461ff0019169055505050565b610735838383611aab565b803b6117165760405162461bcd60e51b815260206004820152602d60248201527f455243313936373a206e657720696d706c656d656e746174696f6e206973206e60448201526c1bdd08184818dbdb9d1c9858dd609a1b6064820152608401610532565b7f360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc80546001600160a01b0319166001600160a01b0392909216919091179055565b6060823b6117b65760405162461bcd60e51b815260206004820152602660248201527f416464726573733a2064656c65676174652063616c6c20746f206e6f6e2d636f6044820152651b9d1c9858dd60d21b6064820152608401610532565b600080846001600160a01b0316846040516117d19190612631565b600060405180830381855af49150503d806000811461180c576040519150601f19603f3d011682016040523d82523d6000602084013e611811565b606091505b5091509150611839828260405180606001604052806027815260200161271560279139611b63565b95945050505050565b61184b816116b2565b6040516001600160a01b038216907fbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b90600090a250565b61188b81611b9c565b600081815260fb6020526040902080546118a4906122aa565b1590506108c657600081815260fb602052604081206108c691611ed4565b60006001600160a01b0384163b156119b857604051630a85bd0160e11b81526001600160a01b0385169063150b7a029061190690339089908890889060040161264d565b6020604051808303816000875af1925050508015611941575060408051601f3d908101601f1916820190925261193e9181019061268a565b60015b61199e573d80801561196f576040519150601f19603f3d011682016040523d82523d6000602084013e611974565b606091505b5080516000036119965760405162461bcd60e51b8152600401610532906124a2565b805181602001fd5b6001600160e01b031916630a85bd0160e11b14905061106c565b506001949350505050565b6000818152606760205260409020546060906001600160a01b0316611a425760405162461bcd60e51b815260206004820152602f60248201527f4552433732314d657461646174613a2055524920717565727920666f72206e6f60448201526e3732bc34b9ba32b73a103a37b5b2b760891b6064820152608401610532565b6000611a5960408051602081019091526000815290565b90506000815111611a795760405180602001604052806000815250611aa4565b80611a8384611c43565b604051602001611a949291906124f4565b6040516020818303038152906040525b9392505050565b6001600160a01b038316611b0657611b018160cb8054600083815260cc60205260408120829055600182018355919091527fa7ce836d032b2bf62b7e2097a8e0a6d8aeb35405ad15271e96d3b0188a1d06fb0155565b611b29565b816001600160a01b0316836001600160a01b031614611b2957611b298382611d44565b6001600160a01b038216611b405761073581611de1565b826001600160a01b0316826001600160a01b031614610735576107358282611e90565b60608315611b72575081611aa4565b825115611b825782518084602001fd5b8160405162461bcd60e51b81526004016105329190611fa5565b6000611ba782610aa7565b9050611bb5816000846116a7565b611bc0600083610f0f565b6001600160a01b0381166000908152606860205260408120805460019290611be990849061247c565b909155505060008281526067602052604080822080546001600160a01b0319169055518391906001600160a01b038416907fddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef908390a45050565b606081600003611c6a5750506040805180820190915260018152600360fc1b602082015290565b8160005b8115611c945780611c7e816126a7565b9150611c8d9050600a836126d6565b9150611c6e565b60008167ffffffffffffffff811115611caf57611caf611fb8565b6040519080825280601f01601f191660200182016040528015611cd9576020820181803683370190505b5090505b841561106c57611cee60018361247c565b9150611cfb600a866126ea565b611d0690603061248f565b60f81b818381518110611d1b57611d1b61241b565b60200101906001600160f81b031916908160001a905350611d3d600a866126d6565b9450611cdd565b60006001611d5184610b1e565b611d5b919061247c565b600083815260ca6020526040902054909150808214611dae576001600160a01b038416600090815260c960209081526040808320858452825280832054848452818420819055835260ca90915290208190555b50600091825260ca602090815260408084208490556001600160a01b03909416835260c981528383209183525290812055565b60cb54600090611df39060019061247c565b600083815260cc602052604081205460cb8054939450909284908110611e1b57611e1b61241b565b906000526020600020015490508060cb8381548110611e3c57611e3c61241b565b600091825260208083209091019290925582815260cc909152604080822084905585825281205560cb805480611e7457611e746126fe565b6001900381819060005260206000200160009055905550505050565b6000611e9b83610b1e565b6001600160a01b03909316600090815260c960209081526040808320868452825280832085905593825260ca9052919091209190915550565b508054611ee0906122aa565b6000825580601f10611ef0575050565b601f0160209004906000526020600020908101906108c691905b80821115611f1e5760008155600101611f0a565b5090565b6001600160e01b0319811681146108c657600080fd5b600060208284031215611f4a57600080fd5b8135611aa481611f22565b60005b83811015611f70578181015183820152602001611f58565b50506000910152565b60008151808452611f91816020860160208601611f55565b601f01601f19169290920160200192915050565b602081526000611aa46020830184611f79565b634e487b7160e01b600052604160045260246000fd5b600082601f830112611fdf57600080fd5b813567ffffffffffffffff80821115611ffa57611ffa611fb8565b604051601f8301601f19908116603f0116810190828211818310171561202257612022611fb8565b8160405283815286602085880101111561203b57600080fd5b836020870160208301376000602085830101528094505050505092915050565b80356001600160a01b038116811461207257600080fd5b919050565b60008060006060848603121561208c57600080fd5b833567ffffffffffffffff808211156120a457600080fd5b6120b087838801611fce565b945060208601359150808211156120c657600080fd5b506120d386828701611fce565b9250506120e26040850161205b565b90509250925092565b6000602082840312156120fd57600080fd5b5035919050565b6000806040838503121561211757600080fd5b6121208361205b565b946020939093013593505050565b60008060006060848603121561214357600080fd5b61214c8461205b565b925061215a6020850161205b565b9150604084013590509250925092565b60006020828403121561217c57600080fd5b611aa48261205b565b6000806040838503121561219857600080fd5b6121a18361205b565b9150602083013567ffffffffffffffff8111156121bd57600080fd5b6121c985828601611fce565b9150509250929050565b600080604083850312156121e657600080fd5b6121ef8361205b565b91506020830135801515811461220457600080fd5b809150509250929050565b6000806000806080858703121561222557600080fd5b61222e8561205b565b935061223c6020860161205b565b925060408501359150606085013567ffffffffffffffff81111561225f57600080fd5b61226b87828801611fce565b91505092959194509250565b6000806040838503121561228a57600080fd5b6122938361205b565b91506122a16020840161205b565b90509250929050565b600181811c908216806122be57607f821691505b6020821081036122de57634e487b7160e01b600052602260045260246000fd5b50919050565b6020808252602e908201527f496e697469616c697a61626c653a20636f6e747261637420697320616c72656160408201526d191e481a5b9a5d1a585b1a5e995960921b606082015260800190565b60208082526031908201527f4552433732313a207472616e736665722063616c6c6572206973206e6f74206f6040820152701ddb995c881b9bdc88185c1c1c9bdd9959607a1b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b19195b1959d85d1958d85b1b60a21b606082015260800190565b6020808252602c908201527f46756e6374696f6e206d7573742062652063616c6c6564207468726f7567682060408201526b6163746976652070726f787960a01b606082015260800190565b634e487b7160e01b600052603260045260246000fd5b6020808252818101527f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e6572604082015260600190565b634e487b7160e01b600052601160045260246000fd5b8181038181111561046557610465612466565b8082018082111561046557610465612466565b60208082526032908201527f4552433732313a207472616e7366657220746f206e6f6e20455243373231526560408201527131b2b4bb32b91034b6b83632b6b2b73a32b960711b606082015260800190565b60008351612506818460208801611f55565b83519083019061251a818360208801611f55565b01949350505050565b601f82111561073557600081815260208120601f850160051c8101602086101561254a5750805b601f850160051c820191505b8181101561256957828155600101612556565b505050505050565b815167ffffffffffffffff81111561258b5761258b611fb8565b61259f8161259984546122aa565b84612523565b602080601f8311600181146125d457600084156125bc5750858301515b600019600386901b1c1916600185901b178555612569565b600085815260208120601f198616915b82811015612603578886015182559484019460019091019084016125e4565b50858210156126215787850151600019600388901b60f8161c191681555b5050505050600190811b01905550565b60008251612643818460208701611f55565b9190910192915050565b6001600160a01b038581168252841660208201526040810183905260806060820181905260009061268090830184611f79565b9695505050505050565b60006020828403121561269c57600080fd5b8151611aa481611f22565b6000600182016126b9576126b9612466565b5060010190565b634e487b7160e01b600052601260045260246000fd5b6000826126e5576126e56126c0565b500490565b6000826126f9576126f96126c0565b500690565b634e487b7160e01b600052603160045260246000fdfe416464726573733a206c6f772d6c6576656c2064656c65676174652063616c6c206661696c6564a2646970667358221220b45e221ee6fe2e8be056c7b65bbe82df67c466aa14a9d53d668e1fe505749f0364736f6c63430008150033",
+}
+
+// StandardERC721UpgradeableABI is the input ABI used to generate the binding from.
+// Deprecated: Use StandardERC721UpgradeableMetaData.ABI instead.
+var StandardERC721UpgradeableABI = StandardERC721UpgradeableMetaData.ABI
+
+// Deprecated: Use StandardERC721UpgradeableMetaData.Sigs instead.
+// StandardERC721UpgradeableFuncSigs maps the 4-byte function signature to its string representation.
+var StandardERC721UpgradeableFuncSigs = StandardERC721UpgradeableMetaData.Sigs
+
+// StandardERC721UpgradeableBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StandardERC721UpgradeableMetaData.Bin instead.
+var StandardERC721UpgradeableBin = StandardERC721UpgradeableMetaData.Bin
+
+// DeployStandardERC721Upgradeable deploys a new Ethereum contract, binding an instance of StandardERC721Upgradeable to it.
+func DeployStandardERC721Upgradeable(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *StandardERC721Upgradeable, error) {
+	parsed, err := StandardERC721UpgradeableMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StandardERC721UpgradeableBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &StandardERC721Upgradeable{StandardERC721UpgradeableCaller: StandardERC721UpgradeableCaller{contract: contract}, StandardERC721UpgradeableTransactor: StandardERC721UpgradeableTransactor{contract: contract}, StandardERC721UpgradeableFilterer: StandardERC721UpgradeableFilterer{contract: contract}}, nil
+}
+
+// StandardERC721Upgradeable is an auto generated Go binding around an Ethereum contract.
+type StandardERC721Upgradeable struct {
+	StandardERC721UpgradeableCaller     // Read-only binding to the contract
+	StandardERC721UpgradeableTransactor // Write-only binding to the contract
+	StandardERC721UpgradeableFilterer   // Log filterer for contract events
+}
+
+// StandardERC721UpgradeableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type StandardERC721UpgradeableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721UpgradeableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type StandardERC721UpgradeableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721UpgradeableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type StandardERC721UpgradeableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// StandardERC721UpgradeableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type StandardERC721UpgradeableSession struct {
+	Contract     *StandardERC721Upgradeable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// StandardERC721UpgradeableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type StandardERC721UpgradeableCallerSession struct {
+	Contract *StandardERC721UpgradeableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// StandardERC721UpgradeableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type StandardERC721UpgradeableTransactorSession struct {
+	Contract     *StandardERC721UpgradeableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// StandardERC721UpgradeableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type StandardERC721UpgradeableRaw struct {
+	Contract *StandardERC721Upgradeable // Generic contract binding to access the raw methods on
+}
+
+// StandardERC721UpgradeableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type StandardERC721UpgradeableCallerRaw struct {
+	Contract *StandardERC721UpgradeableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// StandardERC721UpgradeableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type StandardERC721UpgradeableTransactorRaw struct {
+	Contract *StandardERC721UpgradeableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewStandardERC721Upgradeable creates a new instance of StandardERC721Upgradeable, bound to a specific deployed contract.
+func NewStandardERC721Upgradeable(address common.Address, backend bind.ContractBackend) (*StandardERC721Upgradeable, error) {
+	contract, err := bindStandardERC721Upgradeable(address, false, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721Upgradeable{StandardERC721UpgradeableCaller: StandardERC721UpgradeableCaller{contract: contract}, StandardERC721UpgradeableTransactor: StandardERC721UpgradeableTransactor{contract: contract}, StandardERC721UpgradeableFilterer: StandardERC721UpgradeableFilterer{contract: contract}}, nil
+}
+
+// NewStandardERC721UpgradeableCaller creates a new read-only instance of StandardERC721Upgradeable, bound to a specific deployed contract.
+func NewStandardERC721UpgradeableCaller(address common.Address, caller bind.ContractCaller) (*StandardERC721UpgradeableCaller, error) {
+	contract, err := bindStandardERC721Upgradeable(address, false, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableCaller{contract: contract}, nil
+}
+
+// NewStandardERC721UpgradeableTransactor creates a new write-only instance of StandardERC721Upgradeable, bound to a specific deployed contract.
+func NewStandardERC721UpgradeableTransactor(address common.Address, transactor bind.ContractTransactor) (*StandardERC721UpgradeableTransactor, error) {
+	contract, err := bindStandardERC721Upgradeable(address, false, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableTransactor{contract: contract}, nil
+}
+
+// NewStandardERC721UpgradeableFilterer creates a new log filterer instance of StandardERC721Upgradeable, bound to a specific deployed contract.
+func NewStandardERC721UpgradeableFilterer(address common.Address, fuzzyAddress bool, filterer bind.ContractFilterer) (*StandardERC721UpgradeableFilterer, error) {
+	contract, err := bindStandardERC721Upgradeable(address, fuzzyAddress, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableFilterer{contract: contract}, nil
+}
+
+// bindStandardERC721Upgradeable binds a generic wrapper to an already deployed contract.
+func bindStandardERC721Upgradeable(address common.Address, fuzzyAddress bool, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(StandardERC721UpgradeableABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, fuzzyAddress, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC721Upgradeable.Contract.StandardERC721UpgradeableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.StandardERC721UpgradeableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.StandardERC721UpgradeableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _StandardERC721Upgradeable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.contract.Transact(opts, method, params...)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) BalanceOf(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "balanceOf", owner)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.BalanceOf(&_StandardERC721Upgradeable.CallOpts, owner)
+}
+
+// BalanceOf is a free data retrieval call binding the contract method 0x70a08231.
+//
+// Solidity: function balanceOf(address owner) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) BalanceOf(owner common.Address) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.BalanceOf(&_StandardERC721Upgradeable.CallOpts, owner)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) GetApproved(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "getApproved", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.GetApproved(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// GetApproved is a free data retrieval call binding the contract method 0x081812fc.
+//
+// Solidity: function getApproved(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) GetApproved(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.GetApproved(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) IsApprovedForAll(opts *bind.CallOpts, owner common.Address, operator common.Address) (bool, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "isApprovedForAll", owner, operator)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _StandardERC721Upgradeable.Contract.IsApprovedForAll(&_StandardERC721Upgradeable.CallOpts, owner, operator)
+}
+
+// IsApprovedForAll is a free data retrieval call binding the contract method 0xe985e9c5.
+//
+// Solidity: function isApprovedForAll(address owner, address operator) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) IsApprovedForAll(owner common.Address, operator common.Address) (bool, error) {
+	return _StandardERC721Upgradeable.Contract.IsApprovedForAll(&_StandardERC721Upgradeable.CallOpts, owner, operator)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) Name(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "name")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Name() (string, error) {
+	return _StandardERC721Upgradeable.Contract.Name(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// Name is a free data retrieval call binding the contract method 0x06fdde03.
+//
+// Solidity: function name() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) Name() (string, error) {
+	return _StandardERC721Upgradeable.Contract.Name(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "owner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Owner() (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.Owner(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
+//
+// Solidity: function owner() view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) Owner() (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.Owner(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) OwnerOf(opts *bind.CallOpts, tokenId *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "ownerOf", tokenId)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.OwnerOf(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// OwnerOf is a free data retrieval call binding the contract method 0x6352211e.
+//
+// Solidity: function ownerOf(uint256 tokenId) view returns(address)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) OwnerOf(tokenId *big.Int) (common.Address, error) {
+	return _StandardERC721Upgradeable.Contract.OwnerOf(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC721Upgradeable.Contract.SupportsInterface(&_StandardERC721Upgradeable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _StandardERC721Upgradeable.Contract.SupportsInterface(&_StandardERC721Upgradeable.CallOpts, interfaceId)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) Symbol(opts *bind.CallOpts) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "symbol")
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Symbol() (string, error) {
+	return _StandardERC721Upgradeable.Contract.Symbol(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// Symbol is a free data retrieval call binding the contract method 0x95d89b41.
+//
+// Solidity: function symbol() view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) Symbol() (string, error) {
+	return _StandardERC721Upgradeable.Contract.Symbol(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) TokenByIndex(opts *bind.CallOpts, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "tokenByIndex", index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TokenByIndex(&_StandardERC721Upgradeable.CallOpts, index)
+}
+
+// TokenByIndex is a free data retrieval call binding the contract method 0x4f6ccce7.
+//
+// Solidity: function tokenByIndex(uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) TokenByIndex(index *big.Int) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TokenByIndex(&_StandardERC721Upgradeable.CallOpts, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) TokenOfOwnerByIndex(opts *bind.CallOpts, owner common.Address, index *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "tokenOfOwnerByIndex", owner, index)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TokenOfOwnerByIndex(&_StandardERC721Upgradeable.CallOpts, owner, index)
+}
+
+// TokenOfOwnerByIndex is a free data retrieval call binding the contract method 0x2f745c59.
+//
+// Solidity: function tokenOfOwnerByIndex(address owner, uint256 index) view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) TokenOfOwnerByIndex(owner common.Address, index *big.Int) (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TokenOfOwnerByIndex(&_StandardERC721Upgradeable.CallOpts, owner, index)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) TokenURI(opts *bind.CallOpts, tokenId *big.Int) (string, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "tokenURI", tokenId)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _StandardERC721Upgradeable.Contract.TokenURI(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// TokenURI is a free data retrieval call binding the contract method 0xc87b56dd.
+//
+// Solidity: function tokenURI(uint256 tokenId) view returns(string)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) TokenURI(tokenId *big.Int) (string, error) {
+	return _StandardERC721Upgradeable.Contract.TokenURI(&_StandardERC721Upgradeable.CallOpts, tokenId)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCaller) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _StandardERC721Upgradeable.contract.Call(opts, &out, "totalSupply")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TotalSupply() (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TotalSupply(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// TotalSupply is a free data retrieval call binding the contract method 0x18160ddd.
+//
+// Solidity: function totalSupply() view returns(uint256)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableCallerSession) TotalSupply() (*big.Int, error) {
+	return _StandardERC721Upgradeable.Contract.TotalSupply(&_StandardERC721Upgradeable.CallOpts)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) Approve(opts *bind.TransactOpts, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "approve", to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Approve(&_StandardERC721Upgradeable.TransactOpts, to, tokenId)
+}
+
+// Approve is a paid mutator transaction binding the contract method 0x095ea7b3.
+//
+// Solidity: function approve(address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) Approve(to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Approve(&_StandardERC721Upgradeable.TransactOpts, to, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) Burn(opts *bind.TransactOpts, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "burn", tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Burn(&_StandardERC721Upgradeable.TransactOpts, tokenId)
+}
+
+// Burn is a paid mutator transaction binding the contract method 0x42966c68.
+//
+// Solidity: function burn(uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) Burn(tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Burn(&_StandardERC721Upgradeable.TransactOpts, tokenId)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) Initialize(opts *bind.TransactOpts, name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "initialize", name, symbol, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) Initialize(name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Initialize(&_StandardERC721Upgradeable.TransactOpts, name, symbol, owner_)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x077f224a.
+//
+// Solidity: function initialize(string name, string symbol, address owner_) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) Initialize(name string, symbol string, owner_ common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.Initialize(&_StandardERC721Upgradeable.TransactOpts, name, symbol, owner_)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "renounceOwnership")
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.RenounceOwnership(&_StandardERC721Upgradeable.TransactOpts)
+}
+
+// RenounceOwnership is a paid mutator transaction binding the contract method 0x715018a6.
+//
+// Solidity: function renounceOwnership() returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) RenounceOwnership() (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.RenounceOwnership(&_StandardERC721Upgradeable.TransactOpts)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) SafeTransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "safeTransferFrom", from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SafeTransferFrom(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom is a paid mutator transaction binding the contract method 0x42842e0e.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) SafeTransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SafeTransferFrom(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) SafeTransferFrom0(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "safeTransferFrom0", from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SafeTransferFrom0(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SafeTransferFrom0 is a paid mutator transaction binding the contract method 0xb88d4fde.
+//
+// Solidity: function safeTransferFrom(address from, address to, uint256 tokenId, bytes _data) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) SafeTransferFrom0(from common.Address, to common.Address, tokenId *big.Int, _data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SafeTransferFrom0(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId, _data)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) SetApprovalForAll(opts *bind.TransactOpts, operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "setApprovalForAll", operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SetApprovalForAll(&_StandardERC721Upgradeable.TransactOpts, operator, approved)
+}
+
+// SetApprovalForAll is a paid mutator transaction binding the contract method 0xa22cb465.
+//
+// Solidity: function setApprovalForAll(address operator, bool approved) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) SetApprovalForAll(operator common.Address, approved bool) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.SetApprovalForAll(&_StandardERC721Upgradeable.TransactOpts, operator, approved)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "transferFrom", from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.TransferFrom(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId)
+}
+
+// TransferFrom is a paid mutator transaction binding the contract method 0x23b872dd.
+//
+// Solidity: function transferFrom(address from, address to, uint256 tokenId) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) TransferFrom(from common.Address, to common.Address, tokenId *big.Int) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.TransferFrom(&_StandardERC721Upgradeable.TransactOpts, from, to, tokenId)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "transferOwnership", newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.TransferOwnership(&_StandardERC721Upgradeable.TransactOpts, newOwner)
+}
+
+// TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
+//
+// Solidity: function transferOwnership(address newOwner) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) TransferOwnership(newOwner common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.TransferOwnership(&_StandardERC721Upgradeable.TransactOpts, newOwner)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) UpgradeTo(opts *bind.TransactOpts, newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "upgradeTo", newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.UpgradeTo(&_StandardERC721Upgradeable.TransactOpts, newImplementation)
+}
+
+// UpgradeTo is a paid mutator transaction binding the contract method 0x3659cfe6.
+//
+// Solidity: function upgradeTo(address newImplementation) returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) UpgradeTo(newImplementation common.Address) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.UpgradeTo(&_StandardERC721Upgradeable.TransactOpts, newImplementation)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactor) UpgradeToAndCall(opts *bind.TransactOpts, newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.contract.Transact(opts, "upgradeToAndCall", newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.UpgradeToAndCall(&_StandardERC721Upgradeable.TransactOpts, newImplementation, data)
+}
+
+// UpgradeToAndCall is a paid mutator transaction binding the contract method 0x4f1ef286.
+//
+// Solidity: function upgradeToAndCall(address newImplementation, bytes data) payable returns()
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableTransactorSession) UpgradeToAndCall(newImplementation common.Address, data []byte) (*types.Transaction, error) {
+	return _StandardERC721Upgradeable.Contract.UpgradeToAndCall(&_StandardERC721Upgradeable.TransactOpts, newImplementation, data)
+}
+
+// StandardERC721UpgradeableAdminChangedIterator is returned from FilterAdminChanged and is used to iterate over the raw logs and unpacked data for AdminChanged events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableAdminChangedIterator struct {
+	Event *StandardERC721UpgradeableAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableAdminChanged represents a AdminChanged event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableAdminChanged struct {
+	PreviousAdmin common.Address
+	NewAdmin      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterAdminChanged is a free log retrieval operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterAdminChanged(opts *bind.FilterOpts) (*StandardERC721UpgradeableAdminChangedIterator, error) {
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableAdminChangedIterator{contract: _StandardERC721Upgradeable.contract, event: "AdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchAdminChanged is a free log subscription operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchAdminChanged(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableAdminChanged) (event.Subscription, error) {
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "AdminChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableAdminChanged)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAdminChanged is a log parse operation binding the contract event 0x7e644d79422f17c01e4894b5f4f588d331ebfa28653d42ae832dc59e38c9798f.
+//
+// Solidity: event AdminChanged(address previousAdmin, address newAdmin)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseAdminChanged(log types.Log) (*StandardERC721UpgradeableAdminChanged, error) {
+	event := new(StandardERC721UpgradeableAdminChanged)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "AdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableApprovalIterator is returned from FilterApproval and is used to iterate over the raw logs and unpacked data for Approval events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableApprovalIterator struct {
+	Event *StandardERC721UpgradeableApproval // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableApprovalIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableApproval)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableApproval)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableApprovalIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableApprovalIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableApproval represents a Approval event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApproval is a free log retrieval operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterApproval(opts *bind.FilterOpts, owner []common.Address, approved []common.Address, tokenId []*big.Int) (*StandardERC721UpgradeableApprovalIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableApprovalIterator{contract: _StandardERC721Upgradeable.contract, event: "Approval", logs: logs, sub: sub}, nil
+}
+
+// WatchApproval is a free log subscription operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchApproval(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableApproval, owner []common.Address, approved []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var approvedRule []interface{}
+	for _, approvedItem := range approved {
+		approvedRule = append(approvedRule, approvedItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "Approval", ownerRule, approvedRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableApproval)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Approval", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApproval is a log parse operation binding the contract event 0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925.
+//
+// Solidity: event Approval(address indexed owner, address indexed approved, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseApproval(log types.Log) (*StandardERC721UpgradeableApproval, error) {
+	event := new(StandardERC721UpgradeableApproval)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Approval", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableApprovalForAllIterator is returned from FilterApprovalForAll and is used to iterate over the raw logs and unpacked data for ApprovalForAll events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableApprovalForAllIterator struct {
+	Event *StandardERC721UpgradeableApprovalForAll // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableApprovalForAllIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableApprovalForAll)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableApprovalForAll)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableApprovalForAllIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableApprovalForAllIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableApprovalForAll represents a ApprovalForAll event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalForAll is a free log retrieval operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterApprovalForAll(opts *bind.FilterOpts, owner []common.Address, operator []common.Address) (*StandardERC721UpgradeableApprovalForAllIterator, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableApprovalForAllIterator{contract: _StandardERC721Upgradeable.contract, event: "ApprovalForAll", logs: logs, sub: sub}, nil
+}
+
+// WatchApprovalForAll is a free log subscription operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchApprovalForAll(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableApprovalForAll, owner []common.Address, operator []common.Address) (event.Subscription, error) {
+
+	var ownerRule []interface{}
+	for _, ownerItem := range owner {
+		ownerRule = append(ownerRule, ownerItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "ApprovalForAll", ownerRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableApprovalForAll)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalForAll is a log parse operation binding the contract event 0x17307eab39ab6107e8899845ad3d59bd9653f200f220920489ca2b5937696c31.
+//
+// Solidity: event ApprovalForAll(address indexed owner, address indexed operator, bool approved)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseApprovalForAll(log types.Log) (*StandardERC721UpgradeableApprovalForAll, error) {
+	event := new(StandardERC721UpgradeableApprovalForAll)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "ApprovalForAll", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableBeaconUpgradedIterator is returned from FilterBeaconUpgraded and is used to iterate over the raw logs and unpacked data for BeaconUpgraded events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableBeaconUpgradedIterator struct {
+	Event *StandardERC721UpgradeableBeaconUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableBeaconUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableBeaconUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableBeaconUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableBeaconUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableBeaconUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableBeaconUpgraded represents a BeaconUpgraded event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableBeaconUpgraded struct {
+	Beacon common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterBeaconUpgraded is a free log retrieval operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterBeaconUpgraded(opts *bind.FilterOpts, beacon []common.Address) (*StandardERC721UpgradeableBeaconUpgradedIterator, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableBeaconUpgradedIterator{contract: _StandardERC721Upgradeable.contract, event: "BeaconUpgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchBeaconUpgraded is a free log subscription operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchBeaconUpgraded(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableBeaconUpgraded, beacon []common.Address) (event.Subscription, error) {
+
+	var beaconRule []interface{}
+	for _, beaconItem := range beacon {
+		beaconRule = append(beaconRule, beaconItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "BeaconUpgraded", beaconRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableBeaconUpgraded)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseBeaconUpgraded is a log parse operation binding the contract event 0x1cf3b03a6cf19fa2baba4df148e9dcabedea7f8a5c07840e207e5c089be95d3e.
+//
+// Solidity: event BeaconUpgraded(address indexed beacon)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseBeaconUpgraded(log types.Log) (*StandardERC721UpgradeableBeaconUpgraded, error) {
+	event := new(StandardERC721UpgradeableBeaconUpgraded)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "BeaconUpgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableOwnershipTransferredIterator struct {
+	Event *StandardERC721UpgradeableOwnershipTransferred // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableOwnershipTransferredIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableOwnershipTransferred)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableOwnershipTransferred)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableOwnershipTransferredIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableOwnershipTransferredIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableOwnershipTransferred represents a OwnershipTransferred event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableOwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterOwnershipTransferred is a free log retrieval operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StandardERC721UpgradeableOwnershipTransferredIterator, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableOwnershipTransferredIterator{contract: _StandardERC721Upgradeable.contract, event: "OwnershipTransferred", logs: logs, sub: sub}, nil
+}
+
+// WatchOwnershipTransferred is a free log subscription operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error) {
+
+	var previousOwnerRule []interface{}
+	for _, previousOwnerItem := range previousOwner {
+		previousOwnerRule = append(previousOwnerRule, previousOwnerItem)
+	}
+	var newOwnerRule []interface{}
+	for _, newOwnerItem := range newOwner {
+		newOwnerRule = append(newOwnerRule, newOwnerItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "OwnershipTransferred", previousOwnerRule, newOwnerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableOwnershipTransferred)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOwnershipTransferred is a log parse operation binding the contract event 0x8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e0.
+//
+// Solidity: event OwnershipTransferred(address indexed previousOwner, address indexed newOwner)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseOwnershipTransferred(log types.Log) (*StandardERC721UpgradeableOwnershipTransferred, error) {
+	event := new(StandardERC721UpgradeableOwnershipTransferred)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "OwnershipTransferred", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableTransferIterator is returned from FilterTransfer and is used to iterate over the raw logs and unpacked data for Transfer events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableTransferIterator struct {
+	Event *StandardERC721UpgradeableTransfer // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableTransferIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableTransfer)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableTransfer)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableTransferIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableTransferIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableTransfer represents a Transfer event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterTransfer is a free log retrieval operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address, tokenId []*big.Int) (*StandardERC721UpgradeableTransferIterator, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableTransferIterator{contract: _StandardERC721Upgradeable.contract, event: "Transfer", logs: logs, sub: sub}, nil
+}
+
+// WatchTransfer is a free log subscription operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchTransfer(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableTransfer, from []common.Address, to []common.Address, tokenId []*big.Int) (event.Subscription, error) {
+
+	var fromRule []interface{}
+	for _, fromItem := range from {
+		fromRule = append(fromRule, fromItem)
+	}
+	var toRule []interface{}
+	for _, toItem := range to {
+		toRule = append(toRule, toItem)
+	}
+	var tokenIdRule []interface{}
+	for _, tokenIdItem := range tokenId {
+		tokenIdRule = append(tokenIdRule, tokenIdItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "Transfer", fromRule, toRule, tokenIdRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableTransfer)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Transfer", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseTransfer is a log parse operation binding the contract event 0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef.
+//
+// Solidity: event Transfer(address indexed from, address indexed to, uint256 indexed tokenId)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseTransfer(log types.Log) (*StandardERC721UpgradeableTransfer, error) {
+	event := new(StandardERC721UpgradeableTransfer)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Transfer", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// StandardERC721UpgradeableUpgradedIterator is returned from FilterUpgraded and is used to iterate over the raw logs and unpacked data for Upgraded events raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableUpgradedIterator struct {
+	Event *StandardERC721UpgradeableUpgraded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *StandardERC721UpgradeableUpgradedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(StandardERC721UpgradeableUpgraded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(StandardERC721UpgradeableUpgraded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *StandardERC721UpgradeableUpgradedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *StandardERC721UpgradeableUpgradedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// StandardERC721UpgradeableUpgraded represents a Upgraded event raised by the StandardERC721Upgradeable contract.
+type StandardERC721UpgradeableUpgraded struct {
+	Implementation common.Address
+	Raw            types.Log // Blockchain specific contextual infos
+}
+
+// FilterUpgraded is a free log retrieval operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) FilterUpgraded(opts *bind.FilterOpts, implementation []common.Address) (*StandardERC721UpgradeableUpgradedIterator, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.FilterLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return &StandardERC721UpgradeableUpgradedIterator{contract: _StandardERC721Upgradeable.contract, event: "Upgraded", logs: logs, sub: sub}, nil
+}
+
+// WatchUpgraded is a free log subscription operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) WatchUpgraded(opts *bind.WatchOpts, sink chan<- *StandardERC721UpgradeableUpgraded, implementation []common.Address) (event.Subscription, error) {
+
+	var implementationRule []interface{}
+	for _, implementationItem := range implementation {
+		implementationRule = append(implementationRule, implementationItem)
+	}
+
+	logs, sub, err := _StandardERC721Upgradeable.contract.WatchLogs(opts, "Upgraded", implementationRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(StandardERC721UpgradeableUpgraded)
+				if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseUpgraded is a log parse operation binding the contract event 0xbc7cd75a20ee27fd9adebab32041f755214dbc6bffa90cc0225b39da2e5c2d3b.
+//
+// Solidity: event Upgraded(address indexed implementation)
+func (_StandardERC721Upgradeable *StandardERC721UpgradeableFilterer) ParseUpgraded(log types.Log) (*StandardERC721UpgradeableUpgraded, error) {
+	event := new(StandardERC721UpgradeableUpgraded)
+	if err := _StandardERC721Upgradeable.contract.UnpackLog(event, "Upgraded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
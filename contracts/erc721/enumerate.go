@@ -0,0 +1,172 @@
+package erc721
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
+	"github.com/jason-bateman/go-erc-standard-contract/utils"
+)
+
+// ListTokensOfOwner returns every token of the owner, it reads the pages of ListTokensOfOwnerPage until the last one
+func (c *Contract) ListTokensOfOwner(ctx context.Context, owner string) ([]*model.TokenInfo, error) {
+	tokens := []*model.TokenInfo{}
+	cursor := ""
+
+	for {
+		page, err := c.ListTokensOfOwnerPage(ctx, owner, cursor, chainModel.MULTICALL_BATCH_SIZE)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, page.Tokens...)
+		if page.NextCursor == "" {
+			return tokens, nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// ListTokensOfOwnerPage returns a page of at most limit tokens of the owner through ERC721Enumerable.
+// cursor is the NextCursor of the previous page, empty for the first page. The first page pins the head block
+// and every later page reads the same block, so a transfer in between does not shift the indexes of the listing.
+func (c *Contract) ListTokensOfOwnerPage(ctx context.Context, owner string, cursor string, limit int) (*model.TokenPage, error) {
+	readTotal := func(blockTag chainModel.BlockTag) (string, error) {
+		return c.ReadBalanceOf(ctx, owner, blockTag)
+	}
+
+	return c.listTokens(ctx, cursor, limit, readTotal, func(batch *Batch, index *big.Int) *multicall.BatchResult {
+		return batch.ReadTokenOfOwnerByIndex(&model.MethodReadTokenOfOwnerByIndexInputs{Owner: owner, Index: index.String()})
+	})
+}
+
+// ListAllTokens returns a page of at most limit tokens of the collection through ERC721Enumerable.
+// cursor is the NextCursor of the previous page, empty for the first page. All pages read the block of the first one.
+func (c *Contract) ListAllTokens(ctx context.Context, cursor string, limit int) (*model.TokenPage, error) {
+	readTotal := func(blockTag chainModel.BlockTag) (string, error) {
+		return c.ReadTotalSupply(ctx, blockTag)
+	}
+
+	return c.listTokens(ctx, cursor, limit, readTotal, func(batch *Batch, index *big.Int) *multicall.BatchResult {
+		return batch.ReadTokenByIndex(index.String())
+	})
+}
+
+// tokenCursor is the position of a listing: the block every page reads, the index the page starts from and the
+// token count at that block. It is encoded as block:index:total.
+type tokenCursor struct {
+	block uint64
+	index *big.Int
+	total *big.Int
+}
+
+func parseTokenCursor(cursor string) (*tokenCursor, error) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	block, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	index, err := utils.ParseUint256(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	total, err := utils.ParseUint256(parts[2])
+	if err != nil {
+		return nil, err
+	}
+	return &tokenCursor{block: block, index: index, total: total}, nil
+}
+
+func (t *tokenCursor) String() string {
+	return fmt.Sprintf("%d:%s:%s", t.block, t.index, t.total)
+}
+
+// listTokens reads the token ids of the page indexes in one batch, then their owners and token uris in another one.
+// The first page resolves the head block and the token count once, the cursor carries both to the next pages.
+func (c *Contract) listTokens(ctx context.Context, cursor string, limit int, readTotal func(blockTag chainModel.BlockTag) (string, error), readTokenId func(batch *Batch, index *big.Int) *multicall.BatchResult) (*model.TokenPage, error) {
+	// 参数处理
+	var position *tokenCursor
+	var err error
+	if cursor != "" {
+		if position, err = parseTokenCursor(cursor); err != nil {
+			return nil, err
+		}
+	} else {
+		head, err := c.backend.BlockNumber(ctx)
+		if err != nil {
+			return nil, err
+		}
+		total, err := readTotal(chainModel.BlockNumber(head))
+		if err != nil {
+			return nil, err
+		}
+		bTotal, err := utils.ParseUint256(total)
+		if err != nil {
+			return nil, err
+		}
+		position = &tokenCursor{block: head, index: new(big.Int), total: bTotal}
+	}
+	if limit <= 0 {
+		limit = chainModel.MULTICALL_BATCH_SIZE
+	}
+	blockTag := chainModel.BlockNumber(position.block)
+	start := position.index
+
+	page := &model.TokenPage{Tokens: []*model.TokenInfo{}, Total: position.total.String(), Block: position.block}
+
+	// 计算本页的index区间
+	stop := new(big.Int).Add(start, big.NewInt(int64(limit)))
+	if stop.Cmp(position.total) >= 0 {
+		stop.Set(position.total)
+	} else {
+		page.NextCursor = (&tokenCursor{block: position.block, index: stop, total: position.total}).String()
+	}
+	if start.Cmp(stop) >= 0 {
+		return page, nil
+	}
+
+	// 批量获取tokenId
+	batch := c.NewBatch()
+	var tokenIds []*multicall.BatchResult
+	for index := new(big.Int).Set(start); index.Cmp(stop) < 0; index.Add(index, big.NewInt(1)) {
+		tokenIds = append(tokenIds, readTokenId(batch, index))
+	}
+	if err = batch.Execute(ctx, blockTag); err != nil {
+		return nil, err
+	}
+
+	// 批量获取owner和tokenURI
+	batch = c.NewBatch()
+	owners := make([]*multicall.BatchResult, len(tokenIds))
+	uris := make([]*multicall.BatchResult, len(tokenIds))
+	for i, tokenId := range tokenIds {
+		if !tokenId.Success {
+			return nil, fmt.Errorf("token index %s err:%+v", new(big.Int).Add(start, big.NewInt(int64(i))), tokenId.Err)
+		}
+		owners[i] = batch.ReadOwnerOf(tokenId.Value.(string))
+		uris[i] = batch.ReadTokenURI(tokenId.Value.(string))
+	}
+	if err = batch.Execute(ctx, blockTag); err != nil {
+		return nil, err
+	}
+
+	for i, tokenId := range tokenIds {
+		if !owners[i].Success {
+			return nil, fmt.Errorf("ownerOf %s err:%+v", tokenId.Value, owners[i].Err)
+		}
+		token := &model.TokenInfo{TokenId: tokenId.Value.(string), Owner: owners[i].Value.(string)}
+		if uris[i].Success {
+			token.TokenURI = uris[i].Value.(string)
+		}
+		page.Tokens = append(page.Tokens, token)
+	}
+
+	return page, nil
+}
@@ -7,53 +7,82 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/jason-bateman/go-erc-standard-contract/backend"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
 	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+	"github.com/jason-bateman/go-erc-standard-contract/logger"
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
 	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
+	"github.com/jason-bateman/go-erc-standard-contract/tracing"
 	"github.com/jason-bateman/go-erc-standard-contract/utils"
-	"log"
-	"math"
 	"math/big"
 	"strings"
 	"time"
 )
 
 type contractTransactor struct {
-	client     *ethclient.Client // client
+	client     *backend.Backend // client
 	key        *ecdsa.PrivateKey
 	transactor *erc721.StandardERC721Transactor // transactor
 }
 
 type contractCaller struct {
-	client *ethclient.Client            // client
+	client *backend.Backend             // client
 	caller *erc721.StandardERC721Caller // caller
 }
 
 type contractFilterer struct {
-	client             *ethclient.Client              // client
+	client             *backend.Backend               // client
 	stepNum            uint64                         // step num default is 100 block
 	filterFuzzyAddress bool                           // fuzzy bind contract address(listen for the full number of matching topic events)
+	filterAddresses    []common.Address               // contract addresses matched in one query, overrides the bound contract address
+	messageJSON        bool                           // fill EthereumEventMessage.Message with the json encoded payload
 	events             []model.ContractEvent          // events
+	topics             eventTopics                    // indexed topic filter
+	decoder            *bind.EventDecoder             // abi driven decoder of the events without a typed wrapper
+	abiEvents          []string                       // abi events decoded by the decoder
 	filterer           *erc721.StandardERC721Filterer // Filterer
 }
 
+type eventTopics struct {
+	from     []common.Address
+	to       []common.Address
+	owner    []common.Address
+	approved []common.Address
+	operator []common.Address
+	tokenIds []*big.Int
+}
+
 type ContractOpts struct {
-	Rpc                string // rpc
-	ContractAddr       string // contract address
-	EnableTransactors  bool   // enable transactors
-	EnableFilter       bool   // enable filter
-	FilterStep         uint64 // the step size of the block interval obtained each time
-	FilterFuzzyAddress bool   // fuzzy bind contract address(listen for the full number of matching topic events)
+	Rpc                string               // rpc
+	Rpcs               []string             // rpc endpoints in priority order with failover, Rpc is used when empty
+	Backend            *backend.Backend     // backend shared by several contracts, overrides Rpc and Rpcs, not closed by ReleaseResource
+	RpcLimiter         *backend.LimiterOpts // client side rate limit and concurrency cap of every rpc endpoint, nil disables it
+	ContractAddr       string               // contract address
+	EnableTransactors  bool                 // enable transactors
+	EnableFilter       bool                 // enable filter
+	FilterStep         uint64               // the step size of the block interval obtained each time
+	FilterFuzzyAddress bool                 // fuzzy bind contract address(listen for the full number of matching topic events)
+	FilterAddresses    []string             // listen for the events of a set of contract addresses in one query
+	FilterMessageJSON  bool                 // also fill EthereumEventMessage.Message with the json encoded payload
+	FilterABI          string               // abi json of a derived contract to decode custom events, default is the standard abi
+	Metrics            metrics.Metrics      // rpc and transaction metrics, default is no-op
+	Logger             logger.Logger        // structured logs of the event filters, default is silent
+	Tracer             tracing.Tracer       // spans of the transaction options, contract calls, sends and log filters, default is no-op
+	Multicall          string               // Multicall3 contract address of the batch reader, empty falls back to json rpc batch requests
 }
 
 type Contract struct {
-	rpc               string                         // rpc
+	backend           *backend.Backend               // backend
+	ownBackend        bool                           // the backend is created by the contract and closed by ReleaseResource
 	chainId           int64                          // chain id
 	contractAddr      common.Address                 // contract address
 	enableTransactors bool                           // enable transactors
@@ -61,6 +90,10 @@ type Contract struct {
 	transactors       map[string]*contractTransactor // transactors
 	caller            *contractCaller                // caller
 	filter            *contractFilterer              // filter
+	multicall         *multicall.Multicall           // batch reader
+	metrics           metrics.Metrics                // metrics
+	tracer            tracing.Tracer                 // tracer
+	logger            logger.Logger                  // logger
 }
 
 func NewContract(ops *ContractOpts) (*Contract, error) {
@@ -73,15 +106,36 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 		return nil, errors.New("invalid address")
 	}
 
+	if ops.EnableFilter && ops.FilterFuzzyAddress && len(ops.FilterAddresses) != 0 {
+		return nil, errors.New("fuzzy address and address set filter can not be enabled together")
+	}
+
 	// 合约地址格式转换
 	contractAddr := common.HexToAddress(ops.ContractAddr)
 
-	// caller 初始化
-	caller.client, err = ethclient.Dial(ops.Rpc)
-	if err != nil {
-		return nil, err
+	// backend 初始化, 配置多个rpc时自动故障切换
+	if ops.Backend != nil {
+		con.backend = ops.Backend
+	} else {
+		rpcs := ops.Rpcs
+		if len(rpcs) == 0 {
+			rpcs = []string{ops.Rpc}
+		}
+		con.backend, err = backend.NewBackend(&backend.BackendOpts{Rpcs: rpcs, Limiter: ops.RpcLimiter, Metrics: ops.Metrics})
+		if err != nil {
+			return nil, err
+		}
+		con.ownBackend = true
+		defer func() {
+			if err != nil {
+				con.backend.Close()
+			}
+		}()
 	}
 
+	// caller 初始化
+	caller.client = con.backend
+
 	chainId, err := caller.client.ChainID(context.Background())
 	if err != nil {
 		return nil, err
@@ -93,21 +147,32 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 	}
 
 	// 填充返回
-	con.rpc = ops.Rpc
 	con.chainId = chainId.Int64()
 	con.contractAddr = contractAddr
 	con.enableTransactors = ops.EnableTransactors
+	con.metrics = metrics.OrNop(ops.Metrics)
+	con.tracer = ops.Tracer
+	con.logger = logger.OrNop(ops.Logger)
 	con.enableFilter = ops.EnableFilter
 	con.caller = &caller
 
+	// 批量读取, 未配置Multicall3合约时使用json rpc batch
+	con.multicall, err = multicall.NewMulticall(con.backend, con.backend, &multicall.MulticallOpts{Address: ops.Multicall})
+	if err != nil {
+		return nil, err
+	}
+
 	if ops.EnableFilter {
 		var filter contractFilterer
-		// filter初始化
-		filter.client, err = ethclient.Dial(ops.Rpc)
+
+		filter.filterAddresses, err = utils.HexToAddresses(ops.FilterAddresses)
 		if err != nil {
 			return nil, err
 		}
 
+		// filter初始化
+		filter.client = con.backend
+
 		filter.filterer, err = erc721.NewStandardERC721Filterer(contractAddr, ops.FilterFuzzyAddress, filter.client)
 		if err != nil {
 			return nil, err
@@ -117,7 +182,16 @@ func NewContract(ops *ContractOpts) (*Contract, error) {
 		} else {
 			filter.stepNum = ops.FilterStep
 		}
+		metadata := erc721.StandardERC721MetaData
+		if ops.FilterABI != "" {
+			metadata = &bind.MetaData{ABI: ops.FilterABI}
+		}
+		filter.decoder, err = bind.NewEventDecoder(contractAddr, ops.FilterFuzzyAddress, metadata, filter.client)
+		if err != nil {
+			return nil, err
+		}
 		filter.filterFuzzyAddress = ops.FilterFuzzyAddress
+		filter.messageJSON = ops.FilterMessageJSON
 		con.filter = &filter
 	}
 
@@ -138,10 +212,7 @@ func (c *Contract) AddTransactors(privateKeys []string) error {
 	for _, k := range privateKeys {
 		var transactor contractTransactor
 
-		transactor.client, err = ethclient.Dial(c.rpc)
-		if err != nil {
-			return err
-		}
+		transactor.client = c.backend
 
 		transactor.key, err = crypto.HexToECDSA(k)
 		if err != nil {
@@ -178,26 +249,103 @@ func (c *Contract) AddEvents(events []model.ContractEvent) error {
 	return nil
 }
 
+func (c *Contract) AddABIEvents(names []string) error {
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	for _, name := range names {
+		if !c.filter.decoder.HasEvent(name) {
+			return fmt.Errorf("unsupported Event:%s, not found in abi", name)
+		}
+		c.filter.abiEvents = append(c.filter.abiEvents, name)
+	}
+
+	return nil
+}
+
+func (c *Contract) SetEventFilter(filter *model.EventFilter) error {
+	var topics eventTopics
+	var err error
+
+	if !c.enableFilter {
+		return errors.New("the filter is not supported. check the instantiation parameters")
+	}
+
+	if filter != nil {
+		if topics.from, err = utils.HexToAddresses(filter.From); err != nil {
+			return err
+		}
+		if topics.to, err = utils.HexToAddresses(filter.To); err != nil {
+			return err
+		}
+		if topics.owner, err = utils.HexToAddresses(filter.Owner); err != nil {
+			return err
+		}
+		if topics.approved, err = utils.HexToAddresses(filter.Approved); err != nil {
+			return err
+		}
+		if topics.operator, err = utils.HexToAddresses(filter.Operator); err != nil {
+			return err
+		}
+		if topics.tokenIds, err = utils.ParseUint256s(filter.TokenIds); err != nil {
+			return err
+		}
+	}
+
+	c.filter.topics = topics
+
+	return nil
+}
+
+// GetCallerClient returns the client of the preferred rpc endpoint, use GetBackend for requests with failover
 func (c *Contract) GetCallerClient() *ethclient.Client {
-	return c.caller.client
+	return c.backend.Client()
+}
+
+func (c *Contract) GetBackend() *backend.Backend {
+	return c.backend
 }
 
-func (c *Contract) ReadBalanceOf(owner string) (uint64, error) {
+func (c *Contract) ReadBalanceOf(ctx context.Context, owner string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
-	balance, err := c.caller.caller.BalanceOf(&bind.CallOpts{}, common.HexToAddress(owner))
+	balance, err := c.caller.caller.BalanceOf(opts, common.HexToAddress(owner))
+	if err != nil {
+		return "", err
+	}
+
+	return balance.String(), nil
+}
+
+// ReadBalanceOfUint64 is a compatibility shim for callers of the former uint64 ReadBalanceOf, it fails on overflow
+func (c *Contract) ReadBalanceOfUint64(ctx context.Context, owner string, blockTag ...chainModel.BlockTag) (uint64, error) {
+	balance, err := c.ReadBalanceOf(ctx, owner, blockTag...)
 	if err != nil {
 		return 0, err
 	}
 
-	return balance.Uint64(), nil
+	return utils.String2Uint64(balance)
 }
 
-func (c *Contract) ReadOwnerOf(tokenId string) (string, error) {
+func (c *Contract) ReadOwnerOf(ctx context.Context, tokenId string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	bTokenId := utils.String2BigInt(tokenId)
+	bTokenId, err := utils.ParseUint256(tokenId)
+	if err != nil {
+		return "", err
+	}
 
-	owner, err := c.caller.caller.OwnerOf(&bind.CallOpts{}, bTokenId)
+	owner, err := c.caller.caller.OwnerOf(opts, bTokenId)
 	if err != nil {
 		return "", err
 	}
@@ -205,12 +353,20 @@ func (c *Contract) ReadOwnerOf(tokenId string) (string, error) {
 	return owner.Hex(), nil
 }
 
-func (c *Contract) ReadGetApproved(tokenId string) (string, error) {
+func (c *Contract) ReadGetApproved(ctx context.Context, tokenId string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	bTokenId := utils.String2BigInt(tokenId)
+	bTokenId, err := utils.ParseUint256(tokenId)
+	if err != nil {
+		return "", err
+	}
 
-	approver, err := c.caller.caller.GetApproved(&bind.CallOpts{}, bTokenId)
+	approver, err := c.caller.caller.GetApproved(opts, bTokenId)
 	if err != nil {
 		return "", err
 	}
@@ -218,9 +374,14 @@ func (c *Contract) ReadGetApproved(tokenId string) (string, error) {
 	return approver.Hex(), nil
 }
 
-func (c *Contract) ReadIsApprovedForAll(inputs *model.MethodReadIsApprovedForAllInputs) (bool, error) {
+func (c *Contract) ReadIsApprovedForAll(ctx context.Context, inputs *model.MethodReadIsApprovedForAllInputs, blockTag ...chainModel.BlockTag) (bool, error) {
 
-	approved, err := c.caller.caller.IsApprovedForAll(&bind.CallOpts{}, common.HexToAddress(inputs.Owner), common.HexToAddress(inputs.Operator))
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return false, err
+	}
+
+	approved, err := c.caller.caller.IsApprovedForAll(opts, common.HexToAddress(inputs.Owner), common.HexToAddress(inputs.Operator))
 	if err != nil {
 		return false, err
 	}
@@ -228,9 +389,14 @@ func (c *Contract) ReadIsApprovedForAll(inputs *model.MethodReadIsApprovedForAll
 	return approved, nil
 }
 
-func (c *Contract) ReadName() (string, error) {
+func (c *Contract) ReadName(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
-	name, err := c.caller.caller.Name(&bind.CallOpts{})
+	name, err := c.caller.caller.Name(opts)
 	if err != nil {
 		return "", err
 	}
@@ -238,9 +404,14 @@ func (c *Contract) ReadName() (string, error) {
 	return name, nil
 }
 
-func (c *Contract) ReadSymbol() (string, error) {
+func (c *Contract) ReadSymbol(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
 
-	symbol, err := c.caller.caller.Symbol(&bind.CallOpts{})
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	symbol, err := c.caller.caller.Symbol(opts)
 	if err != nil {
 		return "", err
 	}
@@ -248,22 +419,45 @@ func (c *Contract) ReadSymbol() (string, error) {
 	return symbol, nil
 }
 
-func (c *Contract) ReadTotalSupply() (uint64, error) {
+func (c *Contract) ReadTotalSupply(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	totalSupply, err := c.caller.caller.TotalSupply(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return totalSupply.String(), nil
+}
 
-	totalSupply, err := c.caller.caller.TotalSupply(&bind.CallOpts{})
+// ReadTotalSupplyUint64 is a compatibility shim for callers of the former uint64 ReadTotalSupply, it fails on overflow
+func (c *Contract) ReadTotalSupplyUint64(ctx context.Context, blockTag ...chainModel.BlockTag) (uint64, error) {
+	totalSupply, err := c.ReadTotalSupply(ctx, blockTag...)
 	if err != nil {
 		return 0, err
 	}
 
-	return totalSupply.Uint64(), nil
+	return utils.String2Uint64(totalSupply)
 }
 
-func (c *Contract) ReadTokenURI(id string) (string, error) {
+func (c *Contract) ReadTokenURI(ctx context.Context, id string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(id)
+	tokenId, err := utils.ParseUint256(id)
+	if err != nil {
+		return "", err
+	}
 
-	uri, err := c.caller.caller.TokenURI(&bind.CallOpts{}, tokenId)
+	uri, err := c.caller.caller.TokenURI(opts, tokenId)
 	if err != nil {
 		return "", err
 	}
@@ -271,22 +465,41 @@ func (c *Contract) ReadTokenURI(id string) (string, error) {
 	return uri, nil
 }
 
-func (c *Contract) ReadTokenByIndex(id string) (uint64, error) {
+func (c *Contract) ReadTokenByIndex(ctx context.Context, index string, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(id)
+	bIndex, err := utils.ParseUint256(index)
+	if err != nil {
+		return "", err
+	}
 
-	index, err := c.caller.caller.TokenByIndex(&bind.CallOpts{}, tokenId)
+	tokenId, err := c.caller.caller.TokenByIndex(opts, bIndex)
 	if err != nil {
-		return 0, err
+		return "", err
 	}
 
-	return index.Uint64(), nil
+	return tokenId.String(), nil
 }
 
-func (c *Contract) ReadTokenOfOwnerByIndex(inputs *model.MethodReadTokenOfOwnerByIndexInputs) (string, error) {
+func (c *Contract) ReadTokenOfOwnerByIndex(ctx context.Context, inputs *model.MethodReadTokenOfOwnerByIndexInputs, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
 
-	tokenId, err := c.caller.caller.TokenOfOwnerByIndex(&bind.CallOpts{}, common.HexToAddress(inputs.Owner), big.NewInt(inputs.Index))
+	// 参数处理
+	bIndex, err := utils.ParseUint256(inputs.Index)
+	if err != nil {
+		return "", err
+	}
+
+	tokenId, err := c.caller.caller.TokenOfOwnerByIndex(opts, common.HexToAddress(inputs.Owner), bIndex)
 	if err != nil {
 		return "", err
 	}
@@ -294,20 +507,20 @@ func (c *Contract) ReadTokenOfOwnerByIndex(inputs *model.MethodReadTokenOfOwnerB
 	return tokenId.String(), nil
 }
 
-func (c *Contract) ReadSupportsInterface(interfaceId string) (bool, error) {
-	var idBytes4 [4]byte
+func (c *Contract) ReadSupportsInterface(ctx context.Context, interfaceId string, blockTag ...chainModel.BlockTag) (bool, error) {
 
-	if !strings.HasPrefix(interfaceId, "0x") && len(interfaceId) != 10 {
-		return false, errors.New("invalid parameter, please check parameter, must with hex prefix")
-	}
-	idBytes, err := hexutil.Decode(interfaceId)
+	opts, err := c.callOpts(ctx, blockTag)
 	if err != nil {
 		return false, err
 	}
 
-	copy(idBytes4[:], idBytes[:4])
+	// 参数处理
+	idBytes4, err := parseInterfaceId(interfaceId)
+	if err != nil {
+		return false, err
+	}
 
-	supported, err := c.caller.caller.SupportsInterface(&bind.CallOpts{}, idBytes4)
+	supported, err := c.caller.caller.SupportsInterface(opts, idBytes4)
 	if err != nil {
 		return false, err
 	}
@@ -315,7 +528,23 @@ func (c *Contract) ReadSupportsInterface(interfaceId string) (bool, error) {
 	return supported, nil
 }
 
-func (c *Contract) WriteSafeTransferFrom(txNonce uint64, inputs *model.MethodWriteSafeTransferFromInputs) (string, error) {
+// ReadOwner returns the Ownable owner of the contract
+func (c *Contract) ReadOwner(ctx context.Context, blockTag ...chainModel.BlockTag) (string, error) {
+
+	opts, err := c.callOpts(ctx, blockTag)
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := c.caller.caller.Owner(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return owner.Hex(), nil
+}
+
+func (c *Contract) WriteSafeTransferFrom(ctx context.Context, txNonce uint64, inputs *model.MethodWriteSafeTransferFromInputs) (string, error) {
 
 	if !c.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
@@ -326,24 +555,33 @@ func (c *Contract) WriteSafeTransferFrom(txNonce uint64, inputs *model.MethodWri
 	}
 
 	// 获取Transactor参数
-	opts, err := c.genTransactorOptions(inputs.From, txNonce, inputs.PayableValue)
+	payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, inputs.PayableValue)
+	if err != nil {
+		return "", err
+	}
+	opts, err := c.genTransactorOptions(ctx, inputs.From, txNonce, payableValue)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
 
 	// 提交交易
 	tx, err := c.transactors[inputs.From].transactor.SafeTransferFrom0(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), tokenId, inputs.Data)
 	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
 		return "", err
 	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "safeTransferFrom")
 
 	return tx.Hash().String(), nil
 }
 
-func (c *Contract) WriteSafeTransferFromWithoutData(txNonce uint64, inputs *model.MethodWriteSafeTransferFromWithoutDataInputs) (string, error) {
+func (c *Contract) WriteSafeTransferFromWithoutData(ctx context.Context, txNonce uint64, inputs *model.MethodWriteSafeTransferFromWithoutDataInputs) (string, error) {
 
 	if !c.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
@@ -354,24 +592,33 @@ func (c *Contract) WriteSafeTransferFromWithoutData(txNonce uint64, inputs *mode
 	}
 
 	// 获取Transactor参数
-	opts, err := c.genTransactorOptions(inputs.From, txNonce, inputs.PayableValue)
+	payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, inputs.PayableValue)
+	if err != nil {
+		return "", err
+	}
+	opts, err := c.genTransactorOptions(ctx, inputs.From, txNonce, payableValue)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
 
 	// 提交交易
 	tx, err := c.transactors[inputs.From].transactor.SafeTransferFrom(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), tokenId)
 	if err != nil {
+		c.metrics.TxFailed(c.contractAddr.Hex())
 		return "", err
 	}
+	c.metrics.TxSent(c.contractAddr.Hex(), "safeTransferFrom")
 
 	return tx.Hash().String(), nil
 }
 
-func (_Contract *Contract) WriteTransferFrom(txNonce uint64, inputs *model.MethodWriteTransferFromInputs) (string, error) {
+func (_Contract *Contract) WriteTransferFrom(ctx context.Context, txNonce uint64, inputs *model.MethodWriteTransferFromInputs) (string, error) {
 
 	if !_Contract.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
@@ -382,24 +629,33 @@ func (_Contract *Contract) WriteTransferFrom(txNonce uint64, inputs *model.Metho
 	}
 
 	// 获取Transactor参数
-	opts, err := _Contract.genTransactorOptions(inputs.From, txNonce, inputs.PayableValue)
+	payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, inputs.PayableValue)
+	if err != nil {
+		return "", err
+	}
+	opts, err := _Contract.genTransactorOptions(ctx, inputs.From, txNonce, payableValue)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
 
 	// 提交交易
 	tx, err := _Contract.transactors[inputs.From].transactor.TransferFrom(opts, common.HexToAddress(inputs.From), common.HexToAddress(inputs.To), tokenId)
 	if err != nil {
+		_Contract.metrics.TxFailed(_Contract.contractAddr.Hex())
 		return "", err
 	}
+	_Contract.metrics.TxSent(_Contract.contractAddr.Hex(), "transferFrom")
 
 	return tx.Hash().String(), nil
 }
 
-func (_Contract *Contract) WriteApprove(senderAddress string, txNonce uint64, inputs *model.MethodWriteApproveInputs) (string, error) {
+func (_Contract *Contract) WriteApprove(ctx context.Context, senderAddress string, txNonce uint64, inputs *model.MethodWriteApproveInputs) (string, error) {
 	if !_Contract.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
 	}
@@ -408,24 +664,33 @@ func (_Contract *Contract) WriteApprove(senderAddress string, txNonce uint64, in
 	}
 
 	// 获取Transactor参数
-	opts, err := _Contract.genTransactorOptions(senderAddress, txNonce, 0)
+	payableValue, err := utils.ResolvePayableValue(inputs.PayableWei, inputs.PayableEther, inputs.PayableValue)
+	if err != nil {
+		return "", err
+	}
+	opts, err := _Contract.genTransactorOptions(ctx, senderAddress, txNonce, payableValue)
 	if err != nil {
 		return "", err
 	}
 
 	// 参数处理
-	tokenId := utils.String2BigInt(inputs.Id)
+	tokenId, err := utils.ParseUint256(inputs.Id)
+	if err != nil {
+		return "", err
+	}
 
 	// 提交交易
 	tx, err := _Contract.transactors[senderAddress].transactor.Approve(opts, common.HexToAddress(inputs.ApprovedAddress), tokenId)
 	if err != nil {
+		_Contract.metrics.TxFailed(_Contract.contractAddr.Hex())
 		return "", err
 	}
+	_Contract.metrics.TxSent(_Contract.contractAddr.Hex(), "approve")
 
 	return tx.Hash().String(), nil
 }
 
-func (_Contract *Contract) WriteSetApprovalForAll(senderAddress string, txNonce uint64, inputs *model.MethodWriteSetApprovalForAllInputs) (string, error) {
+func (_Contract *Contract) WriteSetApprovalForAll(ctx context.Context, senderAddress string, txNonce uint64, inputs *model.MethodWriteSetApprovalForAllInputs) (string, error) {
 	if !_Contract.enableTransactors {
 		return "", errors.New("the transactors is not supported. check the instantiation parameters")
 	}
@@ -434,27 +699,29 @@ func (_Contract *Contract) WriteSetApprovalForAll(senderAddress string, txNonce
 	}
 
 	// 获取Transactor参数
-	opts, err := _Contract.genTransactorOptions(senderAddress, txNonce, 0)
+	opts, err := _Contract.genTransactorOptions(ctx, senderAddress, txNonce, nil)
 	if err != nil {
 		return "", err
 	}
 
 	tx, err := _Contract.transactors[senderAddress].transactor.SetApprovalForAll(opts, common.HexToAddress(inputs.Operator), inputs.Approved)
 	if err != nil {
+		_Contract.metrics.TxFailed(_Contract.contractAddr.Hex())
 		return "", err
 	}
+	_Contract.metrics.TxSent(_Contract.contractAddr.Hex(), "setApprovalForAll")
 
 	return tx.Hash().String(), nil
 }
 
-func (_Contract *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
+func (_Contract *Contract) FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
 	var events, eventsAll []*chainModel.EthereumEventMessage
 
 	if !_Contract.enableFilter {
 		return nil, errors.New("the filter is not supported. check the instantiation parameters")
 	}
 
-	latestBlockNum, err := utils.GetLatestBlockNumWithClient(_Contract.caller.client)
+	latestBlockNum, err := _Contract.backend.BlockNumber(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -462,6 +729,10 @@ func (_Contract *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint
 	if latestBlockNum < *stopBlockNum {
 		*stopBlockNum = latestBlockNum
 	}
+	// 起始区块超过最新区块时不发送from > to的查询, 节点会拒绝
+	if startBlockNum > *stopBlockNum {
+		return nil, nil
+	}
 	if *stopBlockNum > startBlockNum+_Contract.filter.stepNum {
 		errMsg := fmt.Sprintf("Max Filter step num is %d, current is %d", _Contract.filter.stepNum, *stopBlockNum-startBlockNum)
 		return nil, errors.New(errMsg)
@@ -470,8 +741,10 @@ func (_Contract *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint
 	stop := stopBlockNum
 
 	opts := &bind.FilterOpts{
-		Start: startBlockNum,
-		End:   stop,
+		Context:   _Contract.traceContext(ctx),
+		Start:     startBlockNum,
+		End:       stop,
+		Addresses: _Contract.filter.filterAddresses,
 	}
 	for _, e := range _Contract.filter.events {
 
@@ -494,30 +767,75 @@ func (_Contract *Contract) FilterEvents(startBlockNum uint64, stopBlockNum *uint
 				return nil, err
 			}
 
+		case model.EventOwnershipTransferred:
+			events, err = _Contract.eventGeneric(opts, model.SupportEvents[e])
+			if err != nil {
+				return nil, err
+			}
+
 		default:
 			errMsg := fmt.Sprintf("unsupported Event:%s", model.SupportEvents[e])
 			return nil, errors.New(errMsg)
 		}
 
 		if len(events) != 0 {
-			log.Printf("Filter for %d out %d \"%s\" messages, from %d -- %d ", _Contract.chainId, len(events), model.SupportEvents[e], startBlockNum, *stopBlockNum)
+			_Contract.logger.Debug("filter events", logger.F("chain_id", _Contract.chainId), logger.F("event", model.SupportEvents[e]), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
+			eventsAll = utils.MergeEventMessage(eventsAll, events)
+		}
+	}
+	for _, name := range _Contract.filter.abiEvents {
+		events, err = _Contract.eventGeneric(opts, name)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(events) != 0 {
+			_Contract.logger.Debug("filter events", logger.F("chain_id", _Contract.chainId), logger.F("event", name), logger.F("count", len(events)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
 			eventsAll = utils.MergeEventMessage(eventsAll, events)
 		}
 	}
 	if len(eventsAll) != 0 {
-		log.Printf("Filter for %d out total %d messages, from %d -- %d ", _Contract.chainId, len(eventsAll), startBlockNum, *stopBlockNum)
+		_Contract.logger.Info("filter events done", logger.F("chain_id", _Contract.chainId), logger.F("count", len(eventsAll)), logger.F("from", startBlockNum), logger.F("to", *stopBlockNum))
 	}
 	return eventsAll, nil
 }
 
+// WaitTransaction waits until the transaction is mined and records it as confirmed or failed by its receipt status
+func (_Contract *Contract) WaitTransaction(ctx context.Context, txId string) (*types.Receipt, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := _Contract.backend.TransactionReceipt(ctx, common.HexToHash(txId))
+		if err == nil {
+			if receipt.Status == types.ReceiptStatusSuccessful {
+				_Contract.metrics.TxConfirmed(_Contract.contractAddr.Hex())
+			} else {
+				_Contract.metrics.TxFailed(_Contract.contractAddr.Hex())
+			}
+			return receipt, nil
+		}
+		if !errors.Is(err, ethereum.NotFound) {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
 func (_Contract *Contract) ReleaseResource() {
 
-	//释放caller
-	_Contract.caller.client.Close()
+	//释放backend, 共享的backend由创建者释放
+	if _Contract.ownBackend {
+		_Contract.backend.Close()
+	}
 
 	//释放filter
 	if _Contract.enableFilter {
-		_Contract.filter.client.Close()
 		_Contract.filter.events = nil
 	}
 
@@ -525,14 +843,17 @@ func (_Contract *Contract) ReleaseResource() {
 
 	if _Contract.enableTransactors {
 		for _, v := range _Contract.transactors {
-			v.client.Close()
 			v.key = nil
 		}
 		_Contract.transactors = make(map[string]*contractTransactor)
 	}
 }
 
-func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce uint64, payableValue float64) (*bind.TransactOpts, error) {
+func (_Contract *Contract) genTransactorOptions(ctx context.Context, providerAddress string, txNonce uint64, payableValue *big.Int) (_ *bind.TransactOpts, err error) {
+	ctx = _Contract.traceContext(ctx)
+	spanCtx, span := tracing.Start(ctx, "genTransactorOptions", tracing.String(tracing.AttrFrom, providerAddress))
+	defer func() { span.End(err) }()
+
 	// 填充TransactOpts结构
 	opts, err := bind.NewKeyedTransactorWithChainID(_Contract.transactors[providerAddress].key, big.NewInt(_Contract.chainId))
 	if err != nil {
@@ -545,23 +866,27 @@ func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce
 	}
 
 	// 支付Native货币数量
-	if payableValue > 0 {
-		decimal := big.NewFloat(math.Pow(10, 18))
-		convertPayableValue, _ := new(big.Float).Mul(decimal, big.NewFloat(payableValue)).Int(&big.Int{})
-		opts.Value = convertPayableValue
+	if payableValue != nil && payableValue.Sign() > 0 {
+		opts.Value = payableValue
 	}
 
 	//自定义gas limit
 	opts.GasLimit = chainModel.TRANSCATION_MAX_GAS_LIMINT
 
-	// 获取网络手续费
-	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(3)*time.Second)
+	// 获取网络手续费, ctx未设置deadline时默认3秒超时
+	gasCtx, cancel := spanCtx, context.CancelFunc(func() {})
+	if _, ok := ctx.Deadline(); !ok {
+		gasCtx, cancel = context.WithTimeout(spanCtx, time.Duration(3)*time.Second)
+	}
 	defer cancel()
-	gasPrice, err := _Contract.transactors[providerAddress].client.SuggestGasPrice(ctx)
+	gasPrice, err := _Contract.transactors[providerAddress].client.SuggestGasPrice(gasCtx)
 	if err != nil {
 		return nil, err
 	}
 	opts.GasPrice = gasPrice
+	span.SetAttributes(tracing.String(tracing.AttrGasPrice, gasPrice.String()))
+	// bind的Call/transact/FilterLogs从opts.Context取tracer
+	opts.Context = ctx
 
 	return opts, nil
 }
@@ -569,7 +894,7 @@ func (_Contract *Contract) genTransactorOptions(providerAddress string, txNonce
 func (_Contract *Contract) eventApproval(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterApproval(opts, []common.Address{}, []common.Address{}, []*big.Int{})
+	iter, err := _Contract.filter.filterer.FilterApproval(opts, _Contract.filter.topics.owner, _Contract.filter.topics.approved, _Contract.filter.topics.tokenIds)
 	if err != nil {
 		return nil, err
 	}
@@ -585,12 +910,11 @@ func (_Contract *Contract) eventApproval(opts *bind.FilterOpts) ([]*chainModel.E
 				Approved: iter.Event.Approved.Hex(),
 				TokenId:  iter.Event.TokenId.String(),
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-			event := _Contract.eventMsgCommonFill(model.EventApproval, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: ApprovalForAll get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -603,7 +927,7 @@ func (_Contract *Contract) eventApproval(opts *bind.FilterOpts) ([]*chainModel.E
 func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterApprovalForAll(opts, []common.Address{}, []common.Address{})
+	iter, err := _Contract.filter.filterer.FilterApprovalForAll(opts, _Contract.filter.topics.owner, _Contract.filter.topics.operator)
 	if err != nil {
 		return nil, err
 	}
@@ -618,12 +942,11 @@ func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainM
 				Operator: iter.Event.Operator.Hex(),
 				Approved: iter.Event.Approved,
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-			event := _Contract.eventMsgCommonFill(model.EventApprovalForAll, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: ApprovalForAll get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -635,7 +958,7 @@ func (_Contract *Contract) eventApprovalForAll(opts *bind.FilterOpts) ([]*chainM
 func (_Contract *Contract) eventTransfer(opts *bind.FilterOpts) ([]*chainModel.EthereumEventMessage, error) {
 	var events []*chainModel.EthereumEventMessage
 
-	iter, err := _Contract.filter.filterer.FilterTransfer(opts, []common.Address{}, []common.Address{}, []*big.Int{})
+	iter, err := _Contract.filter.filterer.FilterTransfer(opts, _Contract.filter.topics.from, _Contract.filter.topics.to, _Contract.filter.topics.tokenIds)
 	if err != nil {
 		return nil, err
 	}
@@ -650,13 +973,11 @@ func (_Contract *Contract) eventTransfer(opts *bind.FilterOpts) ([]*chainModel.E
 				To:      iter.Event.To.Hex(),
 				TokenId: iter.Event.TokenId.String(),
 			}
-			messageBytes, err := json.Marshal(message)
+			event, err := _Contract.eventMsgCommonFill(iter.Event.Raw, message)
 			if err != nil {
 				return nil, err
 			}
-
-			event := _Contract.eventMsgCommonFill(model.EventTransfer, iter.Event.Raw, string(messageBytes))
-			log.Printf("Filter for %d: TransferSingle get a new event :%+v", _Contract.chainId, event)
+			_Contract.logEvent(event)
 			events = append(events, event)
 		} else {
 			break
@@ -666,18 +987,115 @@ func (_Contract *Contract) eventTransfer(opts *bind.FilterOpts) ([]*chainModel.E
 	return events, nil
 }
 
-func (_Contract *Contract) eventMsgCommonFill(event model.ContractEvent, logs types.Log, msg string) *chainModel.EthereumEventMessage {
+func (_Contract *Contract) eventGeneric(opts *bind.FilterOpts, name string) ([]*chainModel.EthereumEventMessage, error) {
+	var events []*chainModel.EthereumEventMessage
+
+	decoded, err := _Contract.filter.decoder.FilterEvent(opts, name)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range decoded {
+		message := &chainModel.Event4Generic{
+			Name: d.Name,
+			Args: utils.AbiArgs2JSON(d.Args),
+		}
+		event, err := _Contract.eventMsgCommonFill(d.Raw, message)
+		if err != nil {
+			return nil, err
+		}
+		_Contract.logEvent(event)
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// logEvent 以debug级别记录过滤到的事件
+func (_Contract *Contract) logEvent(event *chainModel.EthereumEventMessage) {
+	_Contract.logger.Debug("new event", logger.F("chain_id", event.ChainId), logger.F("event", event.Event), logger.F("contract", event.Contract),
+		logger.F("block", event.BlockNumber), logger.F("tx", event.TxId), logger.F("index", event.BlockIndex))
+}
+
+func (_Contract *Contract) eventMsgCommonFill(logs types.Log, payload chainModel.EventPayload) (*chainModel.EthereumEventMessage, error) {
 	commonMsg := &chainModel.EthereumEventMessage{
 		ChainId:     _Contract.chainId,
-		Contract:    _Contract.contractAddr.Hex(),
+		Contract:    logs.Address.Hex(),
 		BlockNumber: logs.BlockNumber,
 		TxId:        logs.TxHash.String(),
 		BlockIndex:  uint64(logs.Index),
-		Event:       model.SupportEvents[event],
-		Message:     msg,
+		Event:       payload.EventName(),
+		Standard:    model.Standard,
+		Payload:     payload,
 	}
 
-	return commonMsg
+	// json格式化消息内容(按需开启)
+	if _Contract.filter.messageJSON {
+		messageBytes, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		commonMsg.Message = string(messageBytes)
+	}
+
+	return commonMsg, nil
+}
+
+// traceContext 为ctx附加tracer及链id和合约地址属性
+func (_Contract *Contract) traceContext(ctx context.Context) context.Context {
+	return tracing.ContextWithTracer(ctx, _Contract.tracer,
+		tracing.Int64(tracing.AttrChainId, _Contract.chainId), tracing.String(tracing.AttrContract, _Contract.contractAddr.Hex()))
+}
+
+// callOpts converts the optional block tag of a Read* method into the call options, the latest state is read without tag
+func (_Contract *Contract) callOpts(ctx context.Context, blockTag []chainModel.BlockTag) (*bind.CallOpts, error) {
+	opts := &bind.CallOpts{Context: _Contract.traceContext(ctx)}
+	if len(blockTag) == 0 {
+		return opts, nil
+	}
+	if len(blockTag) > 1 {
+		return nil, errors.New("invalid parameter, only one block tag is allowed")
+	}
+
+	switch tag := blockTag[0]; tag {
+	case "", chainModel.BlockLatest:
+	case chainModel.BlockPending:
+		opts.Pending = true
+	case chainModel.BlockSafe, chainModel.BlockFinalized:
+		// ethclient不支持safe/finalized, 先查询对应的区块高度
+		number, err := utils.GetBlockNumByTagWithClient(ctx, _Contract.backend, string(tag))
+		if err != nil {
+			return nil, err
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(number)
+	default:
+		number, ok := tag.Number()
+		if !ok {
+			return nil, fmt.Errorf("invalid block tag:%s", tag)
+		}
+		opts.BlockNumber = number
+	}
+
+	return opts, nil
+}
+
+func parseInterfaceId(interfaceId string) ([4]byte, error) {
+	var idBytes4 [4]byte
+
+	if !strings.HasPrefix(interfaceId, "0x") && len(interfaceId) != 10 {
+		return idBytes4, errors.New("invalid parameter, please check parameter, must with hex prefix")
+	}
+	idBytes, err := hexutil.Decode(interfaceId)
+	if err != nil {
+		return idBytes4, err
+	}
+	if len(idBytes) < 4 {
+		return idBytes4, errors.New("invalid parameter, interface id must be 4 bytes")
+	}
+
+	copy(idBytes4[:], idBytes[:4])
+
+	return idBytes4, nil
 }
 
 func (_Contract *Contract) isTransactorExist(addr string) bool {
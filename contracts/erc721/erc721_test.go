@@ -1,9 +1,21 @@
 package erc721
 
 import (
+	"context"
+	"fmt"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
 	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
 	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/multicall"
 	"github.com/jason-bateman/go-erc-standard-contract/utils"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -30,6 +42,42 @@ func getFilterFuzzyContractInstance() (*Contract, error) {
 	return NewContract(ops)
 }
 
+// mockABI is the mint of testdata/StandardERC721Mock.sol, a StandardERC721 anyone can mint tokens of
+const mockABI = `[{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"uint256","name":"tokenId","type":"uint256"},{"internalType":"string","name":"uri","type":"string"}],"name":"mint","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+// deployMock deploys testdata/StandardERC721Mock.bin, compiled from testdata/StandardERC721Mock.sol with solc 0.8.21,
+// --evm-version london --optimize --optimize-runs 200, and returns the Contract bound to it
+func deployMock(t *testing.T, chain *testchain.Chain) *Contract {
+	bin, err := os.ReadFile("testdata/StandardERC721Mock.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	address := chain.Deploy(t, chain.Accounts[0].Signer, parsed, strings.TrimSpace(string(bin)), "Standard", "STD")
+
+	contract, err := NewContract(&ContractOpts{Backend: chain.Backend, ContractAddr: address.Hex()})
+	if err != nil {
+		t.Fatalf("new contract err:%+v\n", err)
+	}
+	t.Cleanup(contract.ReleaseResource)
+	return contract
+}
+
+// mint mints the token ids to owner, the uri of a token is ipfs://<id>
+func mint(t *testing.T, chain *testchain.Chain, contract *Contract, owner common.Address, tokenIds ...int64) {
+	parsed, _ := abi.JSON(strings.NewReader(mockABI))
+	mock := bind.NewBoundContract(contract.contractAddr, false, parsed, chain.Backend, chain.Backend, chain.Backend)
+	for _, id := range tokenIds {
+		tx, err := mock.Transact(chain.Accounts[0].Signer, "mint", owner, big.NewInt(id), fmt.Sprintf("ipfs://%d", id))
+		if err != nil {
+			t.Fatalf("mint %d err:%+v\n", id, err)
+		}
+		if !chain.Succeeded(t, tx.Hash().Hex()) {
+			t.Fatalf("mint %d reverted\n", id)
+		}
+	}
+}
+
 func TestContract_ReadContract(t *testing.T) {
 	owner := "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"
 
@@ -40,18 +88,18 @@ func TestContract_ReadContract(t *testing.T) {
 	}
 	defer contract.ReleaseResource() // releasing resources
 
-	balance, err := contract.ReadBalanceOf(owner)
+	balance, err := contract.ReadBalanceOf(context.Background(), owner)
 	if err != nil {
 		t.Errorf("ReadBalanceOf err:%+v\n", err)
 		return
 	}
-	t.Logf("balance is:%d,\n", balance)
+	t.Logf("balance is:%s,\n", balance)
 
 	isApprovedForAllInputs := &model.MethodReadIsApprovedForAllInputs{
 		Owner:    "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
 		Operator: "0x604e91519c3f515d93050ae3b909d9ad037085b5",
 	}
-	approved, err := contract.ReadIsApprovedForAll(isApprovedForAllInputs)
+	approved, err := contract.ReadIsApprovedForAll(context.Background(), isApprovedForAllInputs)
 	if err != nil {
 		t.Errorf("ReadBalanceOf err:%+v\n", err)
 		return
@@ -60,35 +108,173 @@ func TestContract_ReadContract(t *testing.T) {
 
 	tokenOfOwnerByIndexInputs := &model.MethodReadTokenOfOwnerByIndexInputs{
 		Owner: "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
-		Index: 1,
+		Index: "1",
 	}
-	tokenId, err := contract.ReadTokenOfOwnerByIndex(tokenOfOwnerByIndexInputs)
+	tokenId, err := contract.ReadTokenOfOwnerByIndex(context.Background(), tokenOfOwnerByIndexInputs)
 	if err != nil {
 		t.Errorf("ReadBalanceOfBatch err:%+v\n", err)
 		return
 	}
 	t.Log("ReadTokenOfOwnerByIndex token Id is:\n", tokenId)
 
-	uri, err := contract.ReadTokenURI(tokenId)
+	uri, err := contract.ReadTokenURI(context.Background(), tokenId)
 	if err != nil {
 		t.Errorf("ReadUri err:%+v\n", err)
 		return
 	}
 	t.Log("uri is:\n", uri)
 
-	isSupport, err := contract.ReadSupportsInterface("0x01ffc9a7")
+	isSupport, err := contract.ReadSupportsInterface(context.Background(), "0x01ffc9a7")
 	if err != nil {
 		t.Errorf("ReadSupportsInterface err:%+v\n", err)
 		return
 	}
 	t.Log("ReadSupportsInterface is:\n", isSupport)
 
-	name, _ := contract.ReadName()
-	symbol, _ := contract.ReadSymbol()
-	totalSuppy, _ := contract.ReadTotalSupply()
+	name, _ := contract.ReadName(context.Background())
+	symbol, _ := contract.ReadSymbol(context.Background())
+	totalSuppy, _ := contract.ReadTotalSupply(context.Background())
+
+	t.Logf("Name: %s \n Symbol:%s \n totalSuppy:%s \n", name, symbol, totalSuppy)
+
+}
+
+func TestContract_ReadBatch(t *testing.T) {
+	chain := testchain.New(t, 2)
+	owner, other := chain.Accounts[0].Address, chain.Accounts[1].Address
+	contract := deployMock(t, chain)
+	mint(t, chain, contract, owner, 10, 11)
+	mint(t, chain, contract, other, 12)
+
+	batch := contract.NewBatch()
+	name := batch.ReadName()
+	balance := batch.ReadBalanceOf(owner.Hex())
+	tokenIds := make([]*multicall.BatchResult, 3)
+	for i := range tokenIds {
+		tokenIds[i] = batch.ReadTokenOfOwnerByIndex(&model.MethodReadTokenOfOwnerByIndexInputs{Owner: owner.Hex(), Index: strconv.Itoa(i)})
+	}
+
+	if err := batch.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	if !name.Success || name.Value != "Standard" {
+		t.Errorf("name:%+v\n", name)
+	}
+	if !balance.Success || balance.Value != "2" {
+		t.Errorf("balance:%+v\n", balance)
+	}
+	// owner只有两个token, 第三个index回滚而不影响其它调用
+	if tokenIds[2].Success || tokenIds[2].Err == nil {
+		t.Errorf("token of index 2 should fail:%+v\n", tokenIds[2])
+	}
+
+	// token uri and owner of every token in a second round trip
+	batch = contract.NewBatch()
+	uris := make([]*multicall.BatchResult, 2)
+	owners := make([]*multicall.BatchResult, 2)
+	for i, tokenId := range tokenIds[:2] {
+		if !tokenId.Success || tokenId.Value != strconv.Itoa(10+i) {
+			t.Fatalf("token of index %d:%+v\n", i, tokenId)
+		}
+		uris[i] = batch.ReadTokenURI(tokenId.Value.(string))
+		owners[i] = batch.ReadOwnerOf(tokenId.Value.(string))
+	}
+
+	if err := batch.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	for i := range uris {
+		if uris[i].Value != fmt.Sprintf("ipfs://%d", 10+i) || owners[i].Value != owner.Hex() {
+			t.Errorf("token %v uri:%v owner:%v\n", tokenIds[i].Value, uris[i].Value, owners[i].Value)
+		}
+	}
+}
+
+func TestContract_ListTokens(t *testing.T) {
+	chain := testchain.New(t, 2)
+	owner, other := chain.Accounts[0].Address, chain.Accounts[1].Address
+	contract := deployMock(t, chain)
+	mint(t, chain, contract, owner, 1, 2, 3, 4, 5)
+	mint(t, chain, contract, other, 6, 7)
+
+	ctx := context.Background()
+	tokens, err := contract.ListTokensOfOwner(ctx, owner.Hex())
+	if err != nil {
+		t.Fatalf("ListTokensOfOwner err:%+v\n", err)
+	}
+	if len(tokens) != 5 {
+		t.Fatalf("tokens of owner:%d want:5\n", len(tokens))
+	}
+	for i, token := range tokens {
+		if token.TokenId != strconv.Itoa(i+1) || token.Owner != owner.Hex() || token.TokenURI != "ipfs://"+token.TokenId {
+			t.Errorf("token:%+v\n", token)
+		}
+	}
+
+	// 分页读取第一页所在的区块
+	head := chain.Sim.Blockchain().CurrentBlock().NumberU64()
+	cursor := ""
+	var ids []string
+	for {
+		page, err := contract.ListAllTokens(ctx, cursor, 3)
+		if err != nil {
+			t.Fatalf("ListAllTokens cursor:%s err:%+v\n", cursor, err)
+		}
+		if page.Total != "7" || page.Block != head {
+			t.Errorf("page total:%s block:%d want:7 %d\n", page.Total, page.Block, head)
+		}
+		for _, token := range page.Tokens {
+			ids = append(ids, token.TokenId)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	if strings.Join(ids, ",") != "1,2,3,4,5,6,7" {
+		t.Errorf("all tokens:%v\n", ids)
+	}
+
+	// 后续页读取游标中的区块, 模拟链只保留最新区块, 出块后旧游标的请求到达节点并失败
+	page, err := contract.ListTokensOfOwnerPage(ctx, owner.Hex(), "", 2)
+	if err != nil || page.Total != "5" || len(page.Tokens) != 2 || page.NextCursor == "" {
+		t.Fatalf("first page:%+v err:%+v\n", page, err)
+	}
+	mint(t, chain, contract, owner, 8)
+	if _, err = contract.ListTokensOfOwnerPage(ctx, owner.Hex(), page.NextCursor, 2); err == nil || !strings.Contains(err.Error(), "latest block") {
+		t.Errorf("next page should read the block of the first page, err:%+v\n", err)
+	}
+	for _, cursor := range []string{"1", "a:0:1", "1:-1:1"} {
+		if _, err = contract.ListAllTokens(ctx, cursor, 2); err == nil {
+			t.Errorf("invalid cursor %s should fail\n", cursor)
+		}
+	}
+}
 
-	t.Logf("Name: %s \n Symbol:%s \n totalSuppy:%d \n", name, symbol, totalSuppy)
+func TestContract_CallOpts(t *testing.T) {
+	contract := &Contract{}
 
+	opts, err := contract.callOpts(context.Background(), nil)
+	if err != nil || opts.Pending || opts.BlockNumber != nil {
+		t.Errorf("unexpected latest opts:%+v err:%+v\n", opts, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	opts, err = contract.callOpts(ctx, []chainModel.BlockTag{chainModel.BlockPending})
+	if err != nil || !opts.Pending || opts.Context != ctx {
+		t.Errorf("unexpected pending opts:%+v err:%+v\n", opts, err)
+	}
+	opts, err = contract.callOpts(context.Background(), []chainModel.BlockTag{chainModel.BlockNumber(10464850)})
+	if err != nil || opts.BlockNumber.Uint64() != 10464850 {
+		t.Errorf("unexpected number opts:%+v err:%+v\n", opts, err)
+	}
+	opts, err = contract.callOpts(context.Background(), []chainModel.BlockTag{"0x10"})
+	if err != nil || opts.BlockNumber.Uint64() != 16 {
+		t.Errorf("unexpected hex number opts:%+v err:%+v\n", opts, err)
+	}
+	if _, err = contract.callOpts(context.Background(), []chainModel.BlockTag{"earliest-ish"}); err == nil {
+		t.Error("invalid block tag should fail")
+	}
 }
 
 func TestContract_WriteTransferFrom(t *testing.T) {
@@ -114,9 +300,9 @@ func TestContract_WriteTransferFrom(t *testing.T) {
 
 	tokenOfOwnerByIndexInputs := &model.MethodReadTokenOfOwnerByIndexInputs{
 		Owner: "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
-		Index: 1,
+		Index: "1",
 	}
-	tokenId, err := contract.ReadTokenOfOwnerByIndex(tokenOfOwnerByIndexInputs)
+	tokenId, err := contract.ReadTokenOfOwnerByIndex(context.Background(), tokenOfOwnerByIndexInputs)
 	if err != nil {
 		t.Errorf("ReadTokenOfOwnerByIndex err:%+v\n", err)
 		return
@@ -130,13 +316,13 @@ func TestContract_WriteTransferFrom(t *testing.T) {
 		Data: []byte(""),
 	}
 
-	txNonce, err := utils.GetAddressTxNonceWithClient(contract.GetCallerClient(), senderAddress)
+	txNonce, err := utils.GetAddressTxNonceWithClient(context.Background(), contract.GetCallerClient(), senderAddress)
 	if err != nil {
 		t.Errorf("GetAddressTxNonceWithClient err:%+v\n", err)
 		return
 	}
 
-	txId, err := contract.WriteSafeTransferFrom(*txNonce, inputs)
+	txId, err := contract.WriteSafeTransferFrom(context.Background(), *txNonce, inputs)
 	if err != nil {
 		t.Errorf("WriteSafeTransferFrom err:%+v\n", err)
 		return
@@ -166,7 +352,7 @@ func TestFilterContractLogs(t *testing.T) {
 
 	deployBlockNum := uint64(10464850)
 
-	nowBlockNum, err := utils.GetLatestBlockNumWithClient(contract.GetCallerClient())
+	nowBlockNum, err := utils.GetLatestBlockNumWithClient(context.Background(), contract.GetCallerClient())
 	if err != nil {
 		t.Errorf("GetLatestBlockNumWithClient err:%+v\n", err)
 		return
@@ -181,7 +367,7 @@ func TestFilterContractLogs(t *testing.T) {
 			break
 		}
 		t.Logf("Filter Start from %d -- %d", start, stop)
-		events, err := contract.FilterEvents(start, &stop)
+		events, err := contract.FilterEvents(context.Background(), start, &stop)
 		if err != nil {
 			t.Errorf("FilterEvents err:%+v\n", err)
 		}
@@ -219,7 +405,7 @@ func TestFilterFuzzyContractLogs(t *testing.T) {
 
 	deployBlockNum := uint64(10464850)
 
-	nowBlockNum, err := utils.GetLatestBlockNumWithClient(contract.GetCallerClient())
+	nowBlockNum, err := utils.GetLatestBlockNumWithClient(context.Background(), contract.GetCallerClient())
 	if err != nil {
 		t.Errorf("GetLatestBlockNumWithClient err:%+v\n", err)
 		return
@@ -233,7 +419,7 @@ func TestFilterFuzzyContractLogs(t *testing.T) {
 			break
 		}
 		t.Logf("Filter Start from %d -- %d", start, stop)
-		events, err := contract.FilterEvents(start, &stop)
+		events, err := contract.FilterEvents(context.Background(), start, &stop)
 		if err != nil {
 			t.Errorf("FilterEvents err:%+v\n", err)
 		}
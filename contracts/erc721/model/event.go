@@ -1,15 +1,22 @@
 package model
 
+import chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+
+// Standard is the standard of the event messages, it selects the payload types when they are decoded from json
+const Standard = chainModel.StandardERC721
+
 type ContractEvent int
 
 //event Transfer(address indexed _from, address indexed _to, uint256 indexed _tokenId);
 //event Approval(address indexed _owner, address indexed _approved, uint256 indexed _tokenId);
 //event ApprovalForAll(address indexed _owner, address indexed _operator, bool _approved);
+//event OwnershipTransferred(address indexed previousOwner, address indexed newOwner); decoded as chainModel.Event4Generic
 
 const (
 	EventTransfer ContractEvent = iota
 	EventApproval
 	EventApprovalForAll
+	EventOwnershipTransferred
 )
 
 // Event4Transfer
@@ -36,8 +43,32 @@ type Event4ApprovalForAll struct {
 	Approved bool   `json:"approved"`
 }
 
+func (e *Event4Transfer) EventName() string { return SupportEvents[EventTransfer] }
+
+func (e *Event4Approval) EventName() string { return SupportEvents[EventApproval] }
+
+func (e *Event4ApprovalForAll) EventName() string { return SupportEvents[EventApprovalForAll] }
+
 var SupportEvents = map[ContractEvent]string{
-	EventTransfer:       "Transfer",
-	EventApproval:       "Approval",
-	EventApprovalForAll: "ApprovalForAll",
+	EventTransfer:             "Transfer",
+	EventApproval:             "Approval",
+	EventApprovalForAll:       "ApprovalForAll",
+	EventOwnershipTransferred: "OwnershipTransferred",
+}
+
+// 注册类型化的Payload, EthereumEventMessage反序列化时使用
+func init() {
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventTransfer], func() chainModel.EventPayload { return &Event4Transfer{} })
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventApproval], func() chainModel.EventPayload { return &Event4Approval{} })
+	chainModel.RegisterEventPayload(Standard, SupportEvents[EventApprovalForAll], func() chainModel.EventPayload { return &Event4ApprovalForAll{} })
+}
+
+// EventFilter narrows the filtered events down by their indexed topics, an empty field matches everything
+type EventFilter struct {
+	From     []string `json:"from"`      // Transfer from
+	To       []string `json:"to"`        // Transfer to
+	Owner    []string `json:"owner"`     // Approval and ApprovalForAll owner
+	Approved []string `json:"approved"`  // Approval approved
+	Operator []string `json:"operator"`  // ApprovalForAll operator
+	TokenIds []string `json:"token_ids"` // Transfer and Approval token id
 }
@@ -1,5 +1,7 @@
 package model
 
+import "math/big"
+
 //function balanceOf(address _owner, uint256 _id) external view returns (uint256);
 //function ownerOf(uint256 _tokenId) external view returns (address);
 //function getApproved(uint256 _tokenId) external view returns (address);
@@ -23,7 +25,24 @@ type MethodReadIsApprovedForAllInputs struct {
 //function (address _owner, uint256 _index) external view returns (uint256);
 type MethodReadTokenOfOwnerByIndexInputs struct {
 	Owner string `json:"owner"`
-	Index int64  `json:"index"`
+	Index string `json:"index"`
+}
+
+// TokenInfo
+// token of the ListTokensOfOwner and ListAllTokens results
+type TokenInfo struct {
+	TokenId  string `json:"token_id"`
+	Owner    string `json:"owner"`
+	TokenURI string `json:"token_uri"` // empty when tokenURI reverts
+}
+
+// TokenPage
+// a page of ListTokensOfOwnerPage or ListAllTokens, pass NextCursor to get the next page
+type TokenPage struct {
+	Tokens     []*TokenInfo `json:"tokens"`
+	Total      string       `json:"total"`       // balance of the owner or totalSupply at Block
+	Block      uint64       `json:"block"`       // block every page of the listing reads
+	NextCursor string       `json:"next_cursor"` // empty on the last page
 }
 
 //function safeTransferFrom(address _from, address _to, uint256 _tokenId, bytes data) external payable;
@@ -35,37 +54,45 @@ type MethodReadTokenOfOwnerByIndexInputs struct {
 // MethodWriteSafeTransferFromInputs
 //function safeTransferFrom(address _from, address _to, uint256 _tokenId, bytes data) external payable;
 type MethodWriteSafeTransferFromInputs struct {
-	PayableValue float64 `json:"payable_value"`
-	From         string  `json:"from"`
-	To           string  `json:"to"`
-	Id           string  `json:"id"`
-	Data         []byte  `json:"data"`
+	PayableValue float64  `json:"payable_value"` // Deprecated: use PayableEther or PayableWei
+	PayableEther string   `json:"payable_ether"` // decimal native currency amount, e.g. "0.1"
+	PayableWei   *big.Int `json:"payable_wei"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Id           string   `json:"id"`
+	Data         []byte   `json:"data"`
 }
 
 // MethodWriteSafeTransferFromWithoutDataInputs
 //function safeTransferFrom(address _from, address _to, uint256 _tokenId) external payable;
 type MethodWriteSafeTransferFromWithoutDataInputs struct {
-	PayableValue float64 `json:"payable_value"`
-	From         string  `json:"from"`
-	To           string  `json:"to"`
-	Id           string  `json:"id"`
+	PayableValue float64  `json:"payable_value"` // Deprecated: use PayableEther or PayableWei
+	PayableEther string   `json:"payable_ether"` // decimal native currency amount, e.g. "0.1"
+	PayableWei   *big.Int `json:"payable_wei"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Id           string   `json:"id"`
 }
 
 // MethodWriteTransferFromInputs
 //function safeTransferFrom(address _from, address _to, uint256 _tokenId) external payable;
 type MethodWriteTransferFromInputs struct {
-	PayableValue float64 `json:"payable_value"`
-	From         string  `json:"from"`
-	To           string  `json:"to"`
-	Id           string  `json:"id"`
+	PayableValue float64  `json:"payable_value"` // Deprecated: use PayableEther or PayableWei
+	PayableEther string   `json:"payable_ether"` // decimal native currency amount, e.g. "0.1"
+	PayableWei   *big.Int `json:"payable_wei"`
+	From         string   `json:"from"`
+	To           string   `json:"to"`
+	Id           string   `json:"id"`
 }
 
 // MethodWriteApproveInputs
 //function approve(address _approved, uint256 _tokenId) external payable;
 type MethodWriteApproveInputs struct {
-	PayableValue    float64 `json:"payable_value"`
-	ApprovedAddress string  `json:"approved_address"`
-	Id              string  `json:"id"`
+	PayableValue    float64  `json:"payable_value"` // Deprecated: use PayableEther or PayableWei
+	PayableEther    string   `json:"payable_ether"` // decimal native currency amount, e.g. "0.1"
+	PayableWei      *big.Int `json:"payable_wei"`
+	ApprovedAddress string   `json:"approved_address"`
+	Id              string   `json:"id"`
 }
 
 // MethodWriteSetApprovalForAllInputs
@@ -74,3 +101,10 @@ type MethodWriteSetApprovalForAllInputs struct {
 	Operator string `json:"operator"`
 	Approved bool   `json:"approved"`
 }
+
+// MethodWriteUpgradeToInputs
+//function upgradeToAndCall(address newImplementation, bytes memory data) external payable;
+type MethodWriteUpgradeToInputs struct {
+	NewImplementation string `json:"new_implementation"`
+	Data              string `json:"data"` // hex encoded call delegated to the new implementation, empty calls upgradeTo
+}
@@ -0,0 +1,141 @@
+package erc721
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+func TestDeployProxy(t *testing.T) {
+	chain := testchain.New(t, 3)
+	signer := chain.Accounts[0].Signer
+	owner, other := chain.Accounts[1], chain.Accounts[2]
+
+	ctx := context.Background()
+	contract, err := DeployProxy(ctx, signer, chain.Backend, "Standard", "STD", &ProxyOpts{
+		Owner:    owner.Address.Hex(),
+		Contract: &ContractOpts{EnableTransactors: true},
+	})
+	if err != nil {
+		t.Fatalf("deploy proxy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	// initialize在proxy的存储中设置name, symbol和owner
+	if name, err := contract.ReadName(ctx); err != nil || name != "Standard" {
+		t.Errorf("read name:%s err:%+v\n", name, err)
+	}
+	if symbol, err := contract.ReadSymbol(ctx); err != nil || symbol != "STD" {
+		t.Errorf("read symbol:%s err:%+v\n", symbol, err)
+	}
+	if got, err := contract.ReadOwner(ctx); err != nil || got != owner.Address.Hex() {
+		t.Errorf("read owner:%s want:%s err:%+v\n", got, owner.Address.Hex(), err)
+	}
+	implementation, err := contract.ReadImplementation(ctx)
+	if err != nil || implementation == "" {
+		t.Fatalf("read implementation:%s err:%+v\n", implementation, err)
+	}
+	if got, err := contract.ReadProxyAdmin(ctx); err != nil || got != "" {
+		t.Errorf("read proxy admin of a UUPS proxy:%s err:%+v\n", got, err)
+	}
+
+	if err = contract.AddTransactors([]string{owner.HexKey(), other.HexKey()}); err != nil {
+		t.Fatalf("add transactors err:%+v\n", err)
+	}
+	parsed, _ := erc721.StandardERC721UpgradeableMetaData.GetAbi()
+	upgraded := chain.Deploy(t, signer, parsed, erc721.StandardERC721UpgradeableMetaData.Bin)
+
+	// 只有owner可以升级
+	inputs := &model.MethodWriteUpgradeToInputs{NewImplementation: upgraded.Hex()}
+	if hash, err := contract.WriteUpgradeTo(ctx, other.Address.Hex(), 0, inputs); err != nil || chain.Succeeded(t, hash) {
+		t.Errorf("upgrade by a non owner should revert, err:%+v\n", err)
+	}
+	if got, _ := contract.ReadImplementation(ctx); got != implementation {
+		t.Errorf("implementation after a reverted upgrade:%s want:%s\n", got, implementation)
+	}
+	if hash, err := contract.WriteUpgradeTo(ctx, owner.Address.Hex(), 0, inputs); err != nil || !chain.Succeeded(t, hash) {
+		t.Fatalf("upgrade to err:%+v\n", err)
+	}
+	if got, err := contract.ReadImplementation(ctx); err != nil || got != upgraded.Hex() {
+		t.Errorf("read upgraded implementation:%s want:%s err:%+v\n", got, upgraded.Hex(), err)
+	}
+	if name, err := contract.ReadName(ctx); err != nil || name != "Standard" {
+		t.Errorf("read name after upgrade:%s err:%+v\n", name, err)
+	}
+
+	// 升级时的调用委托给新的实现合约
+	data, _ := parsed.Pack("setApprovalForAll", other.Address, true)
+	inputs = &model.MethodWriteUpgradeToInputs{NewImplementation: implementation, Data: hexutil.Encode(data)}
+	if hash, err := contract.WriteUpgradeTo(ctx, owner.Address.Hex(), 0, inputs); err != nil || !chain.Succeeded(t, hash) {
+		t.Fatalf("upgrade to and call err:%+v\n", err)
+	}
+	if got, err := contract.ReadImplementation(ctx); err != nil || got != implementation {
+		t.Errorf("read upgraded implementation:%s want:%s err:%+v\n", got, implementation, err)
+	}
+	approved, err := contract.ReadIsApprovedForAll(ctx, &model.MethodReadIsApprovedForAllInputs{Owner: owner.Address.Hex(), Operator: other.Address.Hex()})
+	if err != nil || !approved {
+		t.Errorf("approved by the call of the upgrade:%v err:%+v\n", approved, err)
+	}
+
+	deployed, err := Deploy(ctx, signer, chain.Backend, "Standard", "STD")
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	defer deployed.ReleaseResource()
+	if got, err := deployed.ReadProxyAdmin(ctx); err != nil || got != "" {
+		t.Errorf("read proxy admin of a full contract:%s err:%+v\n", got, err)
+	}
+}
+
+func TestDeployProxy_Transparent(t *testing.T) {
+	chain := testchain.New(t, 2)
+	owner, admin := chain.Accounts[0], chain.Accounts[1]
+
+	ctx := context.Background()
+	if _, err := DeployProxy(ctx, owner.Signer, chain.Backend, "Standard", "STD", &ProxyOpts{Admin: owner.Address.Hex()}); err == nil {
+		t.Errorf("deploy proxy with the owner as admin should fail\n")
+	}
+
+	contract, err := DeployProxy(ctx, owner.Signer, chain.Backend, "Standard", "STD", &ProxyOpts{
+		Admin:    admin.Address.Hex(),
+		Contract: &ContractOpts{EnableTransactors: true},
+	})
+	if err != nil {
+		t.Fatalf("deploy proxy err:%+v\n", err)
+	}
+	defer contract.ReleaseResource()
+
+	if got, err := contract.ReadOwner(ctx); err != nil || got != owner.Address.Hex() {
+		t.Errorf("read owner:%s want:%s err:%+v\n", got, owner.Address.Hex(), err)
+	}
+	if got, err := contract.ReadProxyAdmin(ctx); err != nil || got != admin.Address.Hex() {
+		t.Errorf("read proxy admin:%s want:%s err:%+v\n", got, admin.Address.Hex(), err)
+	}
+
+	implementation, err := DeployImplementation(ctx, owner.Signer, chain.Backend)
+	if err != nil {
+		t.Fatalf("deploy implementation err:%+v\n", err)
+	}
+	if err = contract.AddTransactors([]string{owner.HexKey(), admin.HexKey()}); err != nil {
+		t.Fatalf("add transactors err:%+v\n", err)
+	}
+
+	// 实现合约没有升级函数, 只有admin可以升级
+	inputs := &model.MethodWriteUpgradeToInputs{NewImplementation: implementation}
+	if hash, err := contract.WriteUpgradeTo(ctx, owner.Address.Hex(), 0, inputs); err != nil || chain.Succeeded(t, hash) {
+		t.Errorf("upgrade by the owner of a transparent proxy should revert, err:%+v\n", err)
+	}
+	if hash, err := contract.WriteUpgradeTo(ctx, admin.Address.Hex(), 0, inputs); err != nil || !chain.Succeeded(t, hash) {
+		t.Fatalf("upgrade to err:%+v\n", err)
+	}
+	if got, err := contract.ReadImplementation(ctx); err != nil || got != implementation {
+		t.Errorf("read upgraded implementation:%s want:%s err:%+v\n", got, implementation, err)
+	}
+	if name, err := contract.ReadName(ctx); err != nil || name != "Standard" {
+		t.Errorf("read name after upgrade:%s err:%+v\n", name, err)
+	}
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: MIT
+
+pragma solidity ^0.8;
+
+import "../contract/erc721.sol";
+
+// StandardERC721Mock adds an open mint to StandardERC721, so that tests fill the enumeration on a local chain
+contract StandardERC721Mock is StandardERC721 {
+    constructor(string memory name, string memory symbol) StandardERC721(name, symbol) {
+    }
+
+    function mint(address to, uint256 tokenId, string memory uri) public {
+        _mint(to, tokenId);
+        _setTokenURI(tokenId, uri);
+    }
+}
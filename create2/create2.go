@@ -0,0 +1,285 @@
+// Package create2 deploys contracts at the same address on every chain with CREATE2.
+//
+// The deploys go through Factory, a minimal factory contract which is itself deployed with the keyless
+// deterministic deployment proxy (https://github.com/Arachnid/deterministic-deployment-proxy), so both
+// live at fixed addresses on every EVM chain. The factory mixes the sender into the salt and hands the
+// ownership of the new contract to the sender, the address of a contract only depends on the sender, the
+// salt and the init code, and nobody else can take it first. Only Ownable contracts can be deployed, the
+// deploy reverts when transferOwnership fails.
+package create2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+const (
+	// DeployerAddress is the deterministic deployment proxy, it CREATE2s calldata[32:] with the salt calldata[:32]
+	DeployerAddress = "0x4e59b44847b379578588920ca78fbf26c0b4956c"
+
+	// DeployerSigner is the keyless account of DeployerTx, it needs DeployerCost wei before the tx is sent
+	DeployerSigner = "0x3fab184622dc19b6109349b94811493bf2a45362"
+
+	// DeployerTx is the pre-EIP155 signed deploy tx of the proxy: nonce 0, gas price 100 gwei, gas limit 100000
+	DeployerTx = "0xf8a58085174876e800830186a08080b853604580600e600039806000f350fe7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf31ba02222222222222222222222222222222222222222222222222222222222222222a02222222222222222222222222222222222222222222222222222222222222222"
+
+	// FactoryInitCode is the init code of the factory, the runtime is hand assembled:
+	//
+	//	mstore(0, caller) mstore(32, calldataload(0))        // salt = keccak256(caller, calldata[:32])
+	//	calldatacopy(64, 32, sub(calldatasize, 32))         // init code = calldata[32:]
+	//	addr := create2(callvalue, 64, sub(calldatasize, 32), keccak256(0, 64))
+	//	if iszero(addr) { revert(0, 0) }
+	//	mstore(0, shl(224, 0xf2fde38b)) mstore(4, caller)    // transferOwnership(caller)
+	//	if iszero(call(gas, addr, 0, 0, 36, 0, 0)) { revert(0, 0) }
+	//	mstore(0, addr) return(12, 20)
+	FactoryInitCode = "0x605380600b6000396000f333600052600035602052604060002060203603806020604037604034f58060265760006000fd5b63f2fde38b60e01b6000523360045260006000602460006000855af1604a57600080fd5b6000526014600cf3"
+
+	// FactoryAddress is where DeployerAddress deploys FactoryInitCode with a zero salt
+	FactoryAddress = "0x46054dd495824835269f4f19214854bF9fd711b3"
+)
+
+// DeployerCost is the wei DeployerSigner pays for DeployerTx
+var DeployerCost = new(big.Int).Mul(big.NewInt(100000), big.NewInt(100000000000))
+
+// Backend is the chain access of Factory, it is implemented by *backend.Backend
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+}
+
+// ComputeAddress returns the address a CREATE2 of initCode with salt from deployer creates
+func ComputeAddress(deployer common.Address, salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(deployer, salt, crypto.Keccak256(initCode))
+}
+
+// SenderSalt returns the salt the factory uses for a deploy of sender
+func SenderSalt(sender common.Address, salt common.Hash) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(sender.Bytes(), 32), salt.Bytes())
+}
+
+// Deterministic is a contract DeployDeterministic installs at the same address on every chain, the factory
+// plumbing Factory and the clone factory share
+type Deterministic struct {
+	backend  Backend
+	address  common.Address
+	initCode []byte // init code DeployDeterministic installs at the default address
+	bound    *bind.BoundContract
+}
+
+// NewDeterministic binds the contract of initCode at address, empty is the address DeployDeterministic installs
+// initCode at
+func NewDeterministic(backend Backend, address string, initCode []byte) (*Deterministic, error) {
+	if backend == nil {
+		return nil, errors.New("backend is required")
+	}
+
+	bound := ComputeAddress(common.HexToAddress(DeployerAddress), common.Hash{}, initCode)
+	if address != "" {
+		if !common.IsHexAddress(address) {
+			return nil, errors.New("invalid factory address")
+		}
+		bound = common.HexToAddress(address)
+	}
+
+	return &Deterministic{
+		backend:  backend,
+		address:  bound,
+		initCode: initCode,
+		bound:    bind.NewBoundContract(bound, false, abi.ABI{}, backend, backend, backend),
+	}, nil
+}
+
+func (d *Deterministic) Address() common.Address {
+	return d.address
+}
+
+func (d *Deterministic) Backend() Backend {
+	return d.backend
+}
+
+// Installed reports whether the contract has code on chain
+func (d *Deterministic) Installed(ctx context.Context) (bool, error) {
+	return HasCode(ctx, d.backend, d.address)
+}
+
+// Install deploys the contract with DeployDeterministic if it is missing, only the default address can be installed
+func (d *Deterministic) Install(ctx context.Context, signer *bind.TransactOpts) error {
+	if d.address != ComputeAddress(common.HexToAddress(DeployerAddress), common.Hash{}, d.initCode) {
+		return fmt.Errorf("factory %s is not the default factory and can not be installed", d.address.Hex())
+	}
+	_, err := DeployDeterministic(ctx, signer, d.backend, d.initCode)
+	return err
+}
+
+// Send sends calldata to the installed contract signed by signer and waits for its receipt, a reverted
+// transaction is an error
+func (d *Deterministic) Send(ctx context.Context, signer *bind.TransactOpts, calldata []byte) (*types.Transaction, error) {
+	installed, err := d.Installed(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !installed {
+		return nil, fmt.Errorf("no factory at %s, install it first", d.address.Hex())
+	}
+
+	auth := *signer
+	auth.Context = ctx
+	tx, err := d.bound.RawTransact(&auth, calldata)
+	if err != nil {
+		return nil, err
+	}
+	return tx, waitSuccess(ctx, d.backend, tx)
+}
+
+type FactoryOpts struct {
+	Address string // factory address, default is FactoryAddress
+}
+
+type Factory struct {
+	*Deterministic
+}
+
+// NewFactory binds the factory, Install deploys it when the chain does not have it yet
+func NewFactory(backend Backend, ops *FactoryOpts) (*Factory, error) {
+	if ops == nil {
+		ops = &FactoryOpts{}
+	}
+	deterministic, err := NewDeterministic(backend, ops.Address, hexutil.MustDecode(FactoryInitCode))
+	if err != nil {
+		return nil, err
+	}
+	return &Factory{Deterministic: deterministic}, nil
+}
+
+// ComputeAddress returns the address the factory deploys initCode at when sender sends it with salt
+func (f *Factory) ComputeAddress(sender common.Address, salt common.Hash, initCode []byte) common.Address {
+	return ComputeAddress(f.address, SenderSalt(sender, salt), initCode)
+}
+
+// DeployDeterministic deploys initCode with the deployment proxy and a zero salt, so that the address is the
+// same on every chain, nothing is sent when the address already has code. The proxy is deployed first if it is
+// missing, signer funds DeployerSigner with DeployerCost then and the node must accept pre-EIP155 transactions.
+func DeployDeterministic(ctx context.Context, signer *bind.TransactOpts, backend Backend, initCode []byte) (common.Address, error) {
+	deployerAddress := common.HexToAddress(DeployerAddress)
+	address := ComputeAddress(deployerAddress, common.Hash{}, initCode)
+	deployed, err := HasCode(ctx, backend, address)
+	if err != nil || deployed {
+		return address, err
+	}
+
+	auth := *signer
+	auth.Context = ctx
+
+	if err = installDeployer(ctx, &auth, backend); err != nil {
+		return address, err
+	}
+
+	deployer := bind.NewBoundContract(deployerAddress, false, abi.ABI{}, backend, backend, backend)
+	tx, err := deployer.RawTransact(&auth, append(common.Hash{}.Bytes(), initCode...))
+	if err != nil {
+		return address, err
+	}
+	if err = waitSuccess(ctx, backend, tx); err != nil {
+		return address, err
+	}
+
+	if deployed, err = HasCode(ctx, backend, address); err == nil && !deployed {
+		err = fmt.Errorf("no code at %s after tx %s", address.Hex(), tx.Hash().Hex())
+	}
+	return address, err
+}
+
+// Deploy deploys initCode with salt signed by signer, the ownership of the contract goes to signer.
+// When the address already has code nothing is sent and the returned tx is nil, so that running a deploy
+// again on a chain which has it is a no-op.
+func (f *Factory) Deploy(ctx context.Context, signer *bind.TransactOpts, salt common.Hash, initCode []byte) (common.Address, *types.Transaction, error) {
+	if len(initCode) == 0 {
+		return common.Address{}, nil, errors.New("init code is required")
+	}
+
+	address := f.ComputeAddress(signer.From, salt, initCode)
+	deployed, err := HasCode(ctx, f.backend, address)
+	if err != nil || deployed {
+		return address, nil, err
+	}
+
+	tx, err := f.Send(ctx, signer, append(salt.Bytes(), initCode...))
+	if err != nil {
+		return address, tx, err
+	}
+
+	if deployed, err = HasCode(ctx, f.backend, address); err == nil && !deployed {
+		err = fmt.Errorf("no code at %s after tx %s", address.Hex(), tx.Hash().Hex())
+	}
+	return address, tx, err
+}
+
+// installDeployer funds DeployerSigner and sends DeployerTx if the proxy has no code yet
+func installDeployer(ctx context.Context, auth *bind.TransactOpts, backend Backend) error {
+	installed, err := HasCode(ctx, backend, common.HexToAddress(DeployerAddress))
+	if err != nil || installed {
+		return err
+	}
+
+	deployerSigner := common.HexToAddress(DeployerSigner)
+	balance, err := backend.BalanceAt(ctx, deployerSigner, nil)
+	if err != nil {
+		return err
+	}
+	if balance.Cmp(DeployerCost) < 0 {
+		// 普通转账, 无合约代码时无法估算gas
+		fund := *auth
+		fund.Value = new(big.Int).Sub(DeployerCost, balance)
+		fund.GasLimit = 21000
+		tx, err := bind.NewBoundContract(deployerSigner, false, abi.ABI{}, backend, backend, backend).Transfer(&fund)
+		if err != nil {
+			return err
+		}
+		if err = waitSuccess(ctx, backend, tx); err != nil {
+			return err
+		}
+		if auth.Nonce != nil {
+			auth.Nonce = new(big.Int).Add(auth.Nonce, big.NewInt(1))
+		}
+	}
+
+	tx := new(types.Transaction)
+	if err = tx.UnmarshalBinary(hexutil.MustDecode(DeployerTx)); err != nil {
+		return err
+	}
+	if err = backend.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	return waitSuccess(ctx, backend, tx)
+}
+
+// waitSuccess waits for the receipt of tx, a reverted tx is an error
+func waitSuccess(ctx context.Context, backend bind.DeployBackend, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("tx %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
+
+// HasCode reports whether address has code at the latest block
+func HasCode(ctx context.Context, backend bind.DeployBackend, address common.Address) (bool, error) {
+	code, err := backend.CodeAt(ctx, address, nil)
+	if err != nil {
+		return false, err
+	}
+	return len(code) > 0, nil
+}
@@ -0,0 +1,82 @@
+package create2
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+func TestConstants(t *testing.T) {
+	tx := new(types.Transaction)
+	if err := tx.UnmarshalBinary(hexutil.MustDecode(DeployerTx)); err != nil {
+		t.Fatalf("decode deployer tx err:%+v\n", err)
+	}
+	signer, err := types.Sender(types.HomesteadSigner{}, tx)
+	if err != nil || signer != common.HexToAddress(DeployerSigner) {
+		t.Errorf("deployer signer:%s err:%+v\n", signer.Hex(), err)
+	}
+	if cost := new(big.Int).Mul(tx.GasPrice(), new(big.Int).SetUint64(tx.Gas())); cost.Cmp(DeployerCost) != 0 {
+		t.Errorf("deployer cost:%s want:%s\n", cost, DeployerCost)
+	}
+	if address := crypto.CreateAddress(signer, 0); address != common.HexToAddress(DeployerAddress) {
+		t.Errorf("deployer address:%s\n", address.Hex())
+	}
+	factory := ComputeAddress(common.HexToAddress(DeployerAddress), common.Hash{}, hexutil.MustDecode(FactoryInitCode))
+	if factory != common.HexToAddress(FactoryAddress) {
+		t.Errorf("factory address:%s want:%s\n", factory.Hex(), FactoryAddress)
+	}
+}
+
+func TestFactory_Deploy(t *testing.T) {
+	chain := testchain.New(t, 1)
+	sender, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+
+	factory, err := NewFactory(chain.Backend, nil)
+	if err != nil {
+		t.Fatalf("new factory err:%+v\n", err)
+	}
+
+	ctx := context.Background()
+	initCode := common.FromHex(erc721.StandardERC721MetaData.Bin)
+	salt := common.HexToHash("0x01")
+	if _, _, err = factory.Deploy(ctx, signer, salt, initCode); err == nil {
+		t.Errorf("deploy without factory should fail\n")
+	}
+
+	for i := 0; i < 2; i++ {
+		if err = factory.Install(ctx, signer); err != nil {
+			t.Fatalf("install factory err:%+v\n", err)
+		}
+	}
+	if installed, err := factory.Installed(ctx); err != nil || !installed {
+		t.Fatalf("factory installed:%v err:%+v\n", installed, err)
+	}
+
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	args, _ := parsed.Pack("", "Standard", "STD")
+	initCode = append(initCode, args...)
+
+	expected := factory.ComputeAddress(sender, salt, initCode)
+	address, tx, err := factory.Deploy(ctx, signer, salt, initCode)
+	if err != nil || tx == nil || address != expected {
+		t.Fatalf("deploy address:%s want:%s err:%+v\n", address.Hex(), expected.Hex(), err)
+	}
+
+	instance, _ := erc721.NewStandardERC721Caller(address, chain.Backend)
+	if owner, err := instance.Owner(nil); err != nil || owner != sender {
+		t.Errorf("owner:%s want:%s err:%+v\n", owner.Hex(), sender.Hex(), err)
+	}
+
+	// 已部署时不再发送交易
+	address, tx, err = factory.Deploy(ctx, signer, salt, initCode)
+	if err != nil || tx != nil || address != expected {
+		t.Errorf("redeploy address:%s tx:%v err:%+v\n", address.Hex(), tx, err)
+	}
+}
@@ -0,0 +1,213 @@
+// Package deploy holds the deploy steps the erc721 and erc1155 wrappers share: sending the creation transaction,
+// waiting for the code, deploying through the CREATE2 factory, cloning an implementation, deploying a proxy and
+// handing the ownership over. The wrappers only pack their constructor or initializer arguments and bind the
+// Contract to the address.
+package deploy
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/clone"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	"github.com/jason-bateman/go-erc-standard-contract/create2"
+	"github.com/jason-bateman/go-erc-standard-contract/proxy"
+)
+
+// OwnableABI is the part of the Ownable abi TransferOwnership calls
+const OwnableABI = `[{"inputs":[],"name":"owner","outputs":[{"internalType":"address","name":"","type":"address"}],"stateMutability":"view","type":"function"},{"inputs":[{"internalType":"address","name":"newOwner","type":"address"}],"name":"transferOwnership","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var ownableAbi, _ = abi.JSON(strings.NewReader(OwnableABI))
+
+// ParseOwner parses the optional owner of the deploy options, zero when empty
+func ParseOwner(owner string) (common.Address, error) {
+	if owner == "" {
+		return common.Address{}, nil
+	}
+	if !common.IsHexAddress(owner) {
+		return common.Address{}, errors.New("invalid owner address")
+	}
+	return common.HexToAddress(owner), nil
+}
+
+// Initializer returns the owner passed to an initializer, the signer when owner is empty
+func Initializer(signer *bind.TransactOpts, owner string) (common.Address, error) {
+	if owner == "" {
+		return signer.From, nil
+	}
+	return ParseOwner(owner)
+}
+
+// Deploy sends the creation transaction of send signed by a copy of signer, waits until the code is on chain and
+// transfers the ownership to owner unless it is zero
+func Deploy(ctx context.Context, signer *bind.TransactOpts, backend create2.Backend, owner common.Address, send func(auth *bind.TransactOpts) (*types.Transaction, error)) (common.Address, error) {
+	auth := *signer
+	auth.Context = ctx
+
+	tx, err := send(&auth)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, err := bind.WaitDeployed(ctx, backend, tx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if auth.Nonce != nil {
+		auth.Nonce = new(big.Int).Add(auth.Nonce, big.NewInt(1))
+	}
+
+	return address, TransferOwnership(ctx, &auth, backend, address, owner)
+}
+
+// InitCode returns the bytecode of metadata with the abi encoded constructor params, the init code CREATE2
+// addresses are computed from
+func InitCode(metadata *bind.MetaData, params ...interface{}) ([]byte, error) {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	args, err := parsed.Pack("", params...)
+	if err != nil {
+		return nil, err
+	}
+	return append(common.FromHex(metadata.Bin), args...), nil
+}
+
+// Create2 deploys initCode with salt through the CREATE2 factory, default is create2.FactoryAddress, and transfers
+// the ownership to owner unless it is zero. When the address already has code nothing is deployed, the ownership
+// is still transferred if the signer owns the contract.
+func Create2(ctx context.Context, signer *bind.TransactOpts, backend create2.Backend, factoryAddress string, salt common.Hash, initCode []byte, owner common.Address) (common.Address, error) {
+	factory, err := create2.NewFactory(backend, &create2.FactoryOpts{Address: factoryAddress})
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	auth := *signer
+	auth.Context = ctx
+
+	address, tx, err := factory.Deploy(ctx, &auth, salt, initCode)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if tx != nil && auth.Nonce != nil {
+		auth.Nonce = new(big.Int).Add(auth.Nonce, big.NewInt(1))
+	}
+
+	return address, TransferOwnership(ctx, &auth, backend, address, owner)
+}
+
+// Clone creates an EIP-1167 clone of implementation with salt through the clone factory, default is
+// clone.FactoryAddress, and calls the initializer initialize packs for the owner in the same transaction. The owner
+// is the signer when empty, a zero salt draws a random one.
+func Clone(ctx context.Context, signer *bind.TransactOpts, backend create2.Backend, factoryAddress, implementation, owner string, salt common.Hash, initialize func(owner common.Address) ([]byte, error)) (common.Address, error) {
+	if !common.IsHexAddress(implementation) {
+		return common.Address{}, errors.New("invalid implementation address")
+	}
+	initOwner, err := Initializer(signer, owner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if salt == (common.Hash{}) {
+		if _, err = rand.Read(salt[:]); err != nil {
+			return common.Address{}, err
+		}
+	}
+
+	initData, err := initialize(initOwner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	factory, err := clone.NewFactory(backend, &clone.FactoryOpts{Address: factoryAddress})
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, _, err := factory.Clone(ctx, signer, common.HexToAddress(implementation), salt, initData)
+	return address, err
+}
+
+// Proxy deploys an EIP-1967 proxy of implementation and calls the initializer initialize packs for the owner in the
+// same transaction. Without implementation deployImplementation deploys one first, transparent tells it whether the
+// proxy has an admin. A zero admin deploys a UUPS proxy, the owner is the signer when empty and can not be the admin.
+func Proxy(ctx context.Context, signer *bind.TransactOpts, backend proxy.Backend, implementation, admin, owner string, deployImplementation func(auth *bind.TransactOpts, transparent bool) (*types.Transaction, error), initialize func(owner common.Address) ([]byte, error)) (common.Address, error) {
+	initOwner, err := Initializer(signer, owner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var proxyAdmin common.Address
+	if admin != "" {
+		if !common.IsHexAddress(admin) {
+			return common.Address{}, errors.New("invalid admin address")
+		}
+		proxyAdmin = common.HexToAddress(admin)
+		// transparent proxy不会把admin的调用委托给实现合约
+		if proxyAdmin == initOwner {
+			return common.Address{}, errors.New("the admin of a transparent proxy can not be the owner")
+		}
+	}
+
+	auth := *signer
+	auth.Context = ctx
+
+	var logic common.Address
+	if implementation != "" {
+		if !common.IsHexAddress(implementation) {
+			return common.Address{}, errors.New("invalid implementation address")
+		}
+		logic = common.HexToAddress(implementation)
+	} else {
+		tx, err := deployImplementation(&auth, proxyAdmin != (common.Address{}))
+		if err != nil {
+			return common.Address{}, err
+		}
+		if logic, err = bind.WaitDeployed(ctx, backend, tx); err != nil {
+			return common.Address{}, err
+		}
+		if auth.Nonce != nil {
+			auth.Nonce = new(big.Int).Add(auth.Nonce, big.NewInt(1))
+		}
+	}
+
+	initData, err := initialize(initOwner)
+	if err != nil {
+		return common.Address{}, err
+	}
+	address, _, err := proxy.Deploy(ctx, &auth, backend, logic, proxyAdmin, initData)
+	return address, err
+}
+
+// TransferOwnership sends transferOwnership of the Ownable contract at address unless owner is zero or already
+// owns it, a reverted transfer is an error
+func TransferOwnership(ctx context.Context, auth *bind.TransactOpts, backend create2.Backend, address, owner common.Address) error {
+	if owner == (common.Address{}) {
+		return nil
+	}
+
+	ownable := bind.NewBoundContract(address, false, ownableAbi, backend, backend, backend)
+	var out []interface{}
+	if err := ownable.Call(&bind.CallOpts{Context: ctx}, &out, "owner"); err != nil {
+		return err
+	}
+	if *abi.ConvertType(out[0], new(common.Address)).(*common.Address) == owner {
+		return nil
+	}
+
+	tx, err := ownable.Transact(auth, "transferOwnership", owner)
+	if err != nil {
+		return err
+	}
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transfer ownership of %s failed, tx %s", address.Hex(), tx.Hash().Hex())
+	}
+	return nil
+}
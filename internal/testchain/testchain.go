@@ -0,0 +1,101 @@
+// Package testchain is the simulated chain fixture shared by the deploy, clone and proxy tests: a chain with funded
+// accounts, their signers and helpers that deploy bytecode and check receipts. Failures end the test with t.Fatalf.
+package testchain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/jason-bateman/go-erc-standard-contract/backend/simulated"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+// Balance is the genesis balance of every account
+var Balance = big.NewInt(1e18)
+
+// Account is a funded account of the chain
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+	Signer  *bind.TransactOpts // signs with Key for the chain id of the simulated chain
+}
+
+// HexKey returns the private key in the format of AddTransactors, hex without 0x
+func (a *Account) HexKey() string {
+	return hexutil.Encode(crypto.FromECDSA(a.Key))[2:]
+}
+
+type Chain struct {
+	*simulated.Chain
+	Accounts []*Account
+}
+
+// New starts a simulated chain with the given number of funded accounts, the chain is closed when the test ends
+func New(t testing.TB, accounts int) *Chain {
+	t.Helper()
+
+	chain := &Chain{}
+	alloc := core.GenesisAlloc{}
+	for i := 0; i < accounts; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatalf("generate key err:%+v\n", err)
+		}
+		signer, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulated.ChainID))
+		if err != nil {
+			t.Fatalf("new transactor err:%+v\n", err)
+		}
+		account := &Account{Key: key, Address: signer.From, Signer: signer}
+		chain.Accounts = append(chain.Accounts, account)
+		alloc[account.Address] = core.GenesisAccount{Balance: Balance}
+	}
+
+	var err error
+	if chain.Chain, err = simulated.NewChain(alloc); err != nil {
+		t.Fatalf("new chain err:%+v\n", err)
+	}
+	t.Cleanup(chain.Close)
+	return chain
+}
+
+// Deploy sends the creation code with the abi encoded constructor params and waits for the deployed address
+func (c *Chain) Deploy(t testing.TB, signer *bind.TransactOpts, parsed *abi.ABI, code string, params ...interface{}) common.Address {
+	t.Helper()
+
+	_, tx, _, err := bind.DeployContract(signer, *parsed, common.FromHex(code), c.Backend, params...)
+	if err != nil {
+		t.Fatalf("deploy err:%+v\n", err)
+	}
+	return c.WaitDeployed(t, tx)
+}
+
+// WaitDeployed waits for the contract created by tx and returns its address
+func (c *Chain) WaitDeployed(t testing.TB, tx *types.Transaction) common.Address {
+	t.Helper()
+
+	address, err := bind.WaitDeployed(context.Background(), c.Backend, tx)
+	if err != nil {
+		t.Fatalf("wait deployed err:%+v\n", err)
+	}
+	return address
+}
+
+// Succeeded reports whether the transaction of hash was mined without reverting. Wrappers send their writes with a
+// fixed gas limit, so a reverted write only shows in its receipt.
+func (c *Chain) Succeeded(t testing.TB, hash string) bool {
+	t.Helper()
+
+	receipt, err := c.Backend.TransactionReceipt(context.Background(), common.HexToHash(hash))
+	if err != nil {
+		t.Fatalf("transaction receipt of %s err:%+v\n", hash, err)
+	}
+	return receipt.Status == types.ReceiptStatusSuccessful
+}
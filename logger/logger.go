@@ -0,0 +1,117 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	}
+	return "level(" + strconv.Itoa(int(l)) + ")"
+}
+
+// Field is a key value pair of a structured log entry
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+// Logger receives the log entries of the contract wrappers, zap, zerolog or slog can be adapted
+// by forwarding the fields. Implementations must be safe for concurrent use.
+type Logger interface {
+	Debug(msg string, fields ...Field)
+	Info(msg string, fields ...Field)
+	Warn(msg string, fields ...Field)
+	Error(msg string, fields ...Field)
+}
+
+// Nop discards every entry, it is the default of the options accepting a Logger
+type Nop struct{}
+
+func (Nop) Debug(msg string, fields ...Field) {}
+func (Nop) Info(msg string, fields ...Field)  {}
+func (Nop) Warn(msg string, fields ...Field)  {}
+func (Nop) Error(msg string, fields ...Field) {}
+
+// OrNop returns l, or Nop when l is nil
+func OrNop(l Logger) Logger {
+	if l == nil {
+		return Nop{}
+	}
+	return l
+}
+
+// StdLogger writes the entries at or above its level as logfmt lines
+type StdLogger struct {
+	mu    sync.Mutex
+	w     io.Writer
+	level Level
+}
+
+func NewStdLogger(w io.Writer, level Level) *StdLogger {
+	return &StdLogger{w: w, level: level}
+}
+
+func (l *StdLogger) Debug(msg string, fields ...Field) { l.write(LevelDebug, msg, fields) }
+func (l *StdLogger) Info(msg string, fields ...Field)  { l.write(LevelInfo, msg, fields) }
+func (l *StdLogger) Warn(msg string, fields ...Field)  { l.write(LevelWarn, msg, fields) }
+func (l *StdLogger) Error(msg string, fields ...Field) { l.write(LevelError, msg, fields) }
+
+func (l *StdLogger) write(level Level, msg string, fields []Field) {
+	if level < l.level {
+		return
+	}
+
+	var b strings.Builder
+	b.WriteString("time=")
+	b.WriteString(time.Now().UTC().Format(time.RFC3339))
+	b.WriteString(" level=")
+	b.WriteString(level.String())
+	b.WriteString(" msg=")
+	b.WriteString(quote(msg))
+	for _, f := range fields {
+		b.WriteByte(' ')
+		b.WriteString(f.Key)
+		b.WriteByte('=')
+		b.WriteString(quote(fmt.Sprint(f.Value)))
+	}
+	b.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = io.WriteString(l.w, b.String())
+}
+
+// quote 含空格、引号或等号的值加引号
+func quote(s string) string {
+	if s == "" || strings.ContainsAny(s, " \t\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
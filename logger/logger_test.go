@@ -0,0 +1,26 @@
+package logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStdLogger(t *testing.T) {
+	var b strings.Builder
+	l := NewStdLogger(&b, LevelInfo)
+
+	l.Debug("hidden", F("k", 1))
+	l.Info("filter events", F("chain_id", 97), F("event", "Transfer"), F("range", "1 -- 2"))
+	l.Error("failed")
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expect 2 lines, got %q", b.String())
+	}
+	if !strings.Contains(lines[0], ` level=info msg="filter events" chain_id=97 event=Transfer range="1 -- 2"`) {
+		t.Errorf("unexpected line %s", lines[0])
+	}
+	if !strings.HasSuffix(lines[1], " level=error msg=failed") {
+		t.Errorf("unexpected line %s", lines[1])
+	}
+}
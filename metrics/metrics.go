@@ -0,0 +1,36 @@
+package metrics
+
+import "time"
+
+// Metrics receives the measurements of the backend, the contract wrappers and the scanner.
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// ObserveRPC records the duration and the result of a json rpc request to an endpoint,
+	// the endpoint is labelled by the scheme, host and index of its url
+	ObserveRPC(endpoint, method string, duration time.Duration, err error)
+	// TxSent records a transaction accepted by the node
+	TxSent(contract, method string)
+	// TxConfirmed records a transaction mined with a successful receipt
+	TxConfirmed(contract string)
+	// TxFailed records a transaction rejected on send or mined with a failed receipt
+	TxFailed(contract string)
+	// ScannerLag records how many blocks a scanner is behind the head
+	ScannerLag(scanner string, blocks uint64)
+}
+
+// Nop discards every measurement, it is the default of the options accepting Metrics
+type Nop struct{}
+
+func (Nop) ObserveRPC(endpoint, method string, duration time.Duration, err error) {}
+func (Nop) TxSent(contract, method string)                                        {}
+func (Nop) TxConfirmed(contract string)                                           {}
+func (Nop) TxFailed(contract string)                                              {}
+func (Nop) ScannerLag(scanner string, blocks uint64)                              {}
+
+// OrNop returns m, or Nop when m is nil
+func OrNop(m Metrics) Metrics {
+	if m == nil {
+		return Nop{}
+	}
+	return m
+}
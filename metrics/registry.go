@@ -0,0 +1,203 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// DefaultBuckets are the upper bounds in seconds of the rpc latency histogram
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+)
+
+type family struct {
+	name   string
+	help   string
+	kind   string
+	labels []string
+	series map[string]*series // by joined label values
+}
+
+type series struct {
+	values  []string
+	value   float64  // counter or gauge value, histogram sum
+	count   uint64   // histogram observations
+	buckets []uint64 // histogram cumulative counts
+}
+
+// Registry is an in-process Metrics implementation exposing the Prometheus text format, serve it on
+// the metrics path of an http server or write it with WriteTo.
+type Registry struct {
+	mu       sync.Mutex
+	buckets  []float64
+	families map[string]*family
+}
+
+func NewRegistry() *Registry {
+	r := &Registry{
+		buckets:  DefaultBuckets,
+		families: make(map[string]*family),
+	}
+
+	r.register("erc_rpc_request_duration_seconds", "Duration of the json rpc requests.", typeHistogram, "endpoint", "method")
+	r.register("erc_rpc_errors_total", "Failed json rpc requests.", typeCounter, "endpoint", "method")
+	r.register("erc_tx_sent_total", "Transactions accepted by the node.", typeCounter, "contract", "method")
+	r.register("erc_tx_confirmed_total", "Transactions mined with a successful receipt.", typeCounter, "contract")
+	r.register("erc_tx_failed_total", "Transactions rejected on send or mined with a failed receipt.", typeCounter, "contract")
+	r.register("erc_scanner_lag_blocks", "Blocks between the chain head and the last block scanned.", typeGauge, "scanner")
+
+	return r
+}
+
+func (r *Registry) ObserveRPC(endpoint, method string, duration time.Duration, err error) {
+	r.observe("erc_rpc_request_duration_seconds", duration.Seconds(), endpoint, method)
+	if err != nil {
+		r.add("erc_rpc_errors_total", 1, endpoint, method)
+	}
+}
+
+func (r *Registry) TxSent(contract, method string) {
+	r.add("erc_tx_sent_total", 1, contract, method)
+}
+
+func (r *Registry) TxConfirmed(contract string) {
+	r.add("erc_tx_confirmed_total", 1, contract)
+}
+
+func (r *Registry) TxFailed(contract string) {
+	r.add("erc_tx_failed_total", 1, contract)
+}
+
+func (r *Registry) ScannerLag(scanner string, blocks uint64) {
+	r.set("erc_scanner_lag_blocks", float64(blocks), scanner)
+}
+
+// WriteTo writes every metric in the Prometheus text exposition format
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	bw := bufio.NewWriter(w)
+	var written int64
+	printf := func(format string, args ...interface{}) {
+		n, _ := fmt.Fprintf(bw, format, args...)
+		written += int64(n)
+	}
+
+	names := make([]string, 0, len(r.families))
+	for name := range r.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		f := r.families[name]
+		if len(f.series) == 0 {
+			continue
+		}
+		printf("# HELP %s %s\n# TYPE %s %s\n", f.name, f.help, f.name, f.kind)
+
+		keys := make([]string, 0, len(f.series))
+		for key := range f.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			s := f.series[key]
+			if f.kind != typeHistogram {
+				printf("%s%s %s\n", f.name, formatLabels(f.labels, s.values, "", ""), formatFloat(s.value))
+				continue
+			}
+			for i, bound := range r.buckets {
+				printf("%s_bucket%s %d\n", f.name, formatLabels(f.labels, s.values, "le", formatFloat(bound)), s.buckets[i])
+			}
+			printf("%s_bucket%s %d\n", f.name, formatLabels(f.labels, s.values, "le", "+Inf"), s.count)
+			printf("%s_sum%s %s\n", f.name, formatLabels(f.labels, s.values, "", ""), formatFloat(s.value))
+			printf("%s_count%s %d\n", f.name, formatLabels(f.labels, s.values, "", ""), s.count)
+		}
+	}
+
+	return written, bw.Flush()
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = r.WriteTo(w)
+}
+
+func (r *Registry) register(name, help, kind string, labels ...string) {
+	r.families[name] = &family{name: name, help: help, kind: kind, labels: labels, series: make(map[string]*series)}
+}
+
+// get returns the series of the label values, the registry lock must be held
+func (r *Registry) get(name string, values []string) *series {
+	f := r.families[name]
+	key := strings.Join(values, "\xff")
+	s, ok := f.series[key]
+	if !ok {
+		s = &series{values: values}
+		if f.kind == typeHistogram {
+			s.buckets = make([]uint64, len(r.buckets))
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+func (r *Registry) add(name string, delta float64, values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.get(name, values).value += delta
+}
+
+func (r *Registry) set(name string, value float64, values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.get(name, values).value = value
+}
+
+func (r *Registry) observe(name string, value float64, values ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := r.get(name, values)
+	s.value += value
+	s.count++
+	for i, bound := range r.buckets {
+		if value <= bound {
+			s.buckets[i]++
+		}
+	}
+}
+
+func formatLabels(names, values []string, extraName, extraValue string) string {
+	var pairs []string
+	for i, name := range names {
+		pairs = append(pairs, name+`="`+labelEscaper.Replace(values[i])+`"`)
+	}
+	if extraName != "" {
+		pairs = append(pairs, extraName+`="`+labelEscaper.Replace(extraValue)+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	r := NewRegistry()
+	r.ObserveRPC("http://node", "eth_call", 20*time.Millisecond, nil)
+	r.ObserveRPC("http://node", "eth_call", 2*time.Second, errors.New("timeout"))
+	r.TxSent("0xabc", "transferFrom")
+	r.TxSent("0xabc", "transferFrom")
+	r.TxConfirmed("0xabc")
+	r.TxFailed("0xabc")
+	r.ScannerLag("transfers", 12)
+	r.ScannerLag("transfers", 3)
+
+	var b strings.Builder
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	out := b.String()
+
+	for _, line := range []string{
+		"# TYPE erc_rpc_request_duration_seconds histogram",
+		`erc_rpc_request_duration_seconds_bucket{endpoint="http://node",method="eth_call",le="0.025"} 1`,
+		`erc_rpc_request_duration_seconds_bucket{endpoint="http://node",method="eth_call",le="+Inf"} 2`,
+		`erc_rpc_request_duration_seconds_count{endpoint="http://node",method="eth_call"} 2`,
+		`erc_rpc_errors_total{endpoint="http://node",method="eth_call"} 1`,
+		`erc_tx_sent_total{contract="0xabc",method="transferFrom"} 2`,
+		`erc_tx_confirmed_total{contract="0xabc"} 1`,
+		`erc_tx_failed_total{contract="0xabc"} 1`,
+		`erc_scanner_lag_blocks{scanner="transfers"} 3`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("missing line %q in:\n%s", line, out)
+		}
+	}
+}
+
+func TestRegistry_ServeHTTP(t *testing.T) {
+	r := NewRegistry()
+	r.ScannerLag(`a"b`, 1)
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("unexpected content type %s", rec.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(rec.Body.String(), `erc_scanner_lag_blocks{scanner="a\"b"} 1`) {
+		t.Errorf("label should be escaped, got:\n%s", rec.Body.String())
+	}
+	if strings.Contains(rec.Body.String(), "erc_tx_sent_total") {
+		t.Errorf("empty families should not be written")
+	}
+}
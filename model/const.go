@@ -5,3 +5,6 @@ const EVENT_FILTER_STEP_NUM = 100
 
 // TRANSCATION_MAX_GAS_LIMINT
 const TRANSCATION_MAX_GAS_LIMINT = 400000
+
+// MULTICALL_BATCH_SIZE
+const MULTICALL_BATCH_SIZE = 100
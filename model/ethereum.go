@@ -1,11 +1,138 @@
 package model
 
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"sync"
+)
+
+// EventPayload is implemented by the typed event structs of every supported standard,
+// e.g. the erc721 model.Event4Transfer or the erc1155 model.Event4TransferSingle.
+// Consumers recover the concrete type with a type switch on EthereumEventMessage.Payload.
+type EventPayload interface {
+	EventName() string
+}
+
+// the standards of the event payloads
+const (
+	StandardERC721  = "erc721"
+	StandardERC1155 = "erc1155"
+)
+
+var (
+	payloadMu    sync.RWMutex
+	payloadTypes = make(map[string]map[string]func() EventPayload) // event -> standard -> payload
+)
+
+// RegisterEventPayload registers the payload type an event of standard is decoded into by
+// EthereumEventMessage.UnmarshalJSON, the model packages of the standards register their events when imported
+func RegisterEventPayload(standard, event string, newPayload func() EventPayload) {
+	payloadMu.Lock()
+	defer payloadMu.Unlock()
+
+	if payloadTypes[event] == nil {
+		payloadTypes[event] = make(map[string]func() EventPayload)
+	}
+	payloadTypes[event][standard] = newPayload
+}
+
+// newEventPayload returns the registered payload of the event, a message without standard takes the payload
+// only if one standard has the event. Unknown events are decoded as Event4Generic.
+func newEventPayload(standard, event string) EventPayload {
+	payloadMu.RLock()
+	defer payloadMu.RUnlock()
+
+	types := payloadTypes[event]
+	if newPayload, ok := types[standard]; ok {
+		return newPayload()
+	}
+	if standard == "" && len(types) == 1 {
+		for _, newPayload := range types {
+			return newPayload()
+		}
+	}
+	return &Event4Generic{}
+}
+
 type EthereumEventMessage struct {
-	Event       string `json:"event"`        // 消息名称
-	BlockNumber uint64 `json:"block_number"` // 交易ID
-	TxId        string `json:"tx_id"`        // 交易hash
-	ChainId     int64  `json:"chain_id"`     // 链ID
-	Contract    string `json:"contract"`     // 合约地址
-	BlockIndex  uint64 `json:"block_index"`  // 所在交易的Index
-	Message     string `json:"message"`      // json格式化后的消息内容
+	Event       string       `json:"event"`             // 消息名称
+	Standard    string       `json:"standard"`          // 合约标准, 反序列化时据此选择Payload类型
+	BlockNumber uint64       `json:"block_number"`      // 交易ID
+	TxId        string       `json:"tx_id"`             // 交易hash
+	ChainId     int64        `json:"chain_id"`          // 链ID
+	Contract    string       `json:"contract"`          // 合约地址
+	BlockIndex  uint64       `json:"block_index"`       // 所在交易的Index
+	Message     string       `json:"message,omitempty"` // json格式化后的消息内容, only filled when enabled by the contract options
+	Payload     EventPayload `json:"payload"`           // typed message content
+}
+
+// UnmarshalJSON decodes the payload into the type registered for the standard and event, see RegisterEventPayload
+func (m *EthereumEventMessage) UnmarshalJSON(data []byte) error {
+	type message EthereumEventMessage
+	var raw struct {
+		message
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*m = EthereumEventMessage(raw.message)
+	m.Payload = nil
+	if len(raw.Payload) == 0 || string(raw.Payload) == "null" {
+		return nil
+	}
+
+	payload := newEventPayload(m.Standard, m.Event)
+	if err := json.Unmarshal(raw.Payload, payload); err != nil {
+		return fmt.Errorf("decode payload of %s: %w", m.Event, err)
+	}
+	m.Payload = payload
+	return nil
+}
+
+// MessageJSON returns the json encoded payload, reusing Message when it has already been filled.
+func (m *EthereumEventMessage) MessageJSON() (string, error) {
+	if m.Message != "" || m.Payload == nil {
+		return m.Message, nil
+	}
+	messageBytes, err := json.Marshal(m.Payload)
+	if err != nil {
+		return "", err
+	}
+	return string(messageBytes), nil
+}
+
+// Event4Generic is the payload of an event decoded from the contract ABI without a typed struct
+type Event4Generic struct {
+	Name string                 `json:"name"`
+	Args map[string]interface{} `json:"args"`
+}
+
+func (e *Event4Generic) EventName() string { return e.Name }
+
+// BlockTag selects the chain state of a read, a block number or one of the named tags
+type BlockTag string
+
+const (
+	BlockLatest    BlockTag = "latest"
+	BlockPending   BlockTag = "pending"
+	BlockSafe      BlockTag = "safe"
+	BlockFinalized BlockTag = "finalized"
+)
+
+// BlockNumber returns the tag of a block number
+func BlockNumber(number uint64) BlockTag {
+	return BlockTag(strconv.FormatUint(number, 10))
+}
+
+// Number returns the block number of a decimal or 0x prefixed hex tag
+func (t BlockTag) Number() (*big.Int, bool) {
+	number, ok := new(big.Int).SetString(string(t), 0)
+	if !ok || number.Sign() < 0 {
+		return nil, false
+	}
+	return number, true
 }
@@ -0,0 +1,111 @@
+package model_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	erc1155Model "github.com/jason-bateman/go-erc-standard-contract/contracts/erc1155/model"
+	erc721Model "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/model"
+	"github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/utils"
+)
+
+func TestEthereumEventMessage_UnmarshalJSON(t *testing.T) {
+	messages := []*model.EthereumEventMessage{
+		{
+			Event:    "Transfer",
+			Standard: model.StandardERC721,
+			TxId:     "0x01",
+			Payload:  &erc721Model.Event4Transfer{From: "0x0a", To: "0x0b", TokenId: "1"},
+		},
+		{
+			Event:    "ApprovalForAll",
+			Standard: model.StandardERC721,
+			Payload:  &erc721Model.Event4ApprovalForAll{Account: "0x0a", Operator: "0x0b", Approved: true},
+		},
+		{
+			Event:    "ApprovalForAll",
+			Standard: model.StandardERC1155,
+			Payload:  &erc1155Model.Event4ApprovalForAll{Account: "0x0a", Operator: "0x0b", Approved: true},
+		},
+		{
+			Event:    "TransferBatch",
+			Standard: model.StandardERC1155,
+			Payload:  &erc1155Model.Event4TransferBatch{Operator: "0x0a", Ids: []string{"1", "2"}, Values: []string{"3", "4"}},
+		},
+		{
+			Event:    "OwnershipTransferred",
+			Standard: model.StandardERC721,
+			Payload:  &model.Event4Generic{Name: "OwnershipTransferred", Args: map[string]interface{}{"newOwner": "0x0b"}},
+		},
+		{Event: "Transfer", Standard: model.StandardERC721},
+	}
+
+	for _, message := range messages {
+		data, err := json.Marshal(message)
+		if err != nil {
+			t.Fatalf("marshal err:%+v\n", err)
+		}
+		var decoded model.EthereumEventMessage
+		if err = json.Unmarshal(data, &decoded); err != nil {
+			t.Fatalf("unmarshal %s err:%+v\n", data, err)
+		}
+		if !reflect.DeepEqual(&decoded, message) {
+			t.Errorf("round trip of %s: %+v want:%+v\n", data, decoded.Payload, message.Payload)
+		}
+	}
+
+	// 没有standard时, 只有一个标准的事件仍可识别
+	var decoded model.EthereumEventMessage
+	if err := json.Unmarshal([]byte(`{"event":"URI","payload":{"value":"ipfs://","id":"1"}}`), &decoded); err != nil {
+		t.Fatalf("unmarshal err:%+v\n", err)
+	}
+	if payload, ok := decoded.Payload.(*erc1155Model.Event4URI); !ok || payload.Id != "1" {
+		t.Errorf("payload without standard:%#v\n", decoded.Payload)
+	}
+	if err := json.Unmarshal([]byte(`{"event":"Transfer","standard":"erc721","payload":"0x"}`), &decoded); err == nil {
+		t.Errorf("invalid payload should fail\n")
+	}
+}
+
+func TestEvent4Generic_RoundTrip(t *testing.T) {
+	// 超过2^53的uint256以十进制字符串保存, json往返不丢失精度
+	value, _ := new(big.Int).SetString("115792089237316195423570985008687907853269984665640564039457584007913129639935", 10)
+	args := utils.AbiArgs2JSON(map[string]interface{}{
+		"value":    value,
+		"small":    new(big.Int).Add(big.NewInt(1<<53), big.NewInt(1)),
+		"newOwner": common.HexToAddress("0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"),
+		"data":     []byte{0x01, 0x02},
+	})
+	message := &model.EthereumEventMessage{
+		Event:    "Custom",
+		Standard: model.StandardERC721,
+		Payload:  &model.Event4Generic{Name: "Custom", Args: args},
+	}
+
+	data, err := json.Marshal(message)
+	if err != nil {
+		t.Fatalf("marshal err:%+v\n", err)
+	}
+	var decoded model.EthereumEventMessage
+	if err = json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal %s err:%+v\n", data, err)
+	}
+	payload, ok := decoded.Payload.(*model.Event4Generic)
+	if !ok {
+		t.Fatalf("payload:%#v\n", decoded.Payload)
+	}
+	want := map[string]interface{}{
+		"value":    value.String(),
+		"small":    "9007199254740993",
+		"newOwner": "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F",
+		"data":     "0x0102",
+	}
+	if !reflect.DeepEqual(payload.Args, want) {
+		t.Errorf("round trip args:%v want:%v\n", payload.Args, want)
+	}
+}
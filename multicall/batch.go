@@ -0,0 +1,80 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+)
+
+// Decoder converts the return data of a successful call into the value of a BatchResult
+type Decoder func(data []byte) (interface{}, error)
+
+// BatchResult is the outcome of a call queued on a Batch, it is filled by Batch.Execute
+type BatchResult struct {
+	Success bool        // the call succeeded and its return data was decoded
+	Value   interface{} // decoded value, the same type as the matching Read* method returns
+	Err     error       // revert, rpc or decoding error when Success is false
+}
+
+// Failed returns a result for a call which could not be queued, e.g. because of invalid inputs
+func Failed(err error) *BatchResult {
+	return &BatchResult{Err: err}
+}
+
+// Batch queues calls of any contracts and sends them together on Execute
+type Batch struct {
+	multicall *Multicall
+	calls     []Call
+	decoders  []Decoder
+	results   []*BatchResult
+}
+
+func (m *Multicall) NewBatch() *Batch {
+	return &Batch{multicall: m}
+}
+
+// Add queues a call, the returned result is filled once the batch is executed
+func (b *Batch) Add(call Call, decoder Decoder) *BatchResult {
+	result := &BatchResult{Err: errors.New("batch not executed")}
+
+	b.calls = append(b.calls, call)
+	b.decoders = append(b.decoders, decoder)
+	b.results = append(b.results, result)
+
+	return result
+}
+
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Execute sends the queued calls and fills their results, the error only reports a failed request
+func (b *Batch) Execute(ctx context.Context) error {
+	return b.ExecuteAt(&bind.CallOpts{Context: ctx})
+}
+
+// ExecuteAt sends the queued calls like Execute on the state selected by opts
+func (b *Batch) ExecuteAt(opts *bind.CallOpts) error {
+	if len(b.calls) == 0 {
+		return nil
+	}
+
+	results, err := b.multicall.AggregateAt(opts, b.calls)
+	if err != nil {
+		return err
+	}
+
+	for i, r := range results {
+		result := b.results[i]
+		result.Success, result.Value, result.Err = false, nil, r.Err
+		if !r.Success {
+			continue
+		}
+		if result.Value, result.Err = b.decoders[i](r.ReturnData); result.Err == nil {
+			result.Success = true
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+// Multicall3ABI is the abi of the Multicall3 aggregate3 method
+const Multicall3ABI = `[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bool","name":"allowFailure","type":"bool"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall3.Call3[]","name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"internalType":"bool","name":"success","type":"bool"},{"internalType":"bytes","name":"returnData","type":"bytes"}],"internalType":"struct Multicall3.Result[]","name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3Abi, _ = abi.JSON(strings.NewReader(Multicall3ABI))
+
+// Call is a single eth_call of a batch, the Multicall3 Call3 struct
+type Call struct {
+	Target       common.Address
+	AllowFailure bool // the other calls still succeed when this one reverts
+	CallData     []byte
+}
+
+// Result is the outcome of a single call, the Multicall3 Result struct plus the decoded failure
+type Result struct {
+	Success    bool
+	ReturnData []byte
+	Err        error // revert reason or rpc error when Success is false
+}
+
+// BatchCaller performs json rpc batch requests, it is implemented by *rpc.Client and *backend.Backend
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// HeaderReader resolves the head block, so that the requests of a batch read the same block. It is implemented
+// by *backend.Backend and *ethclient.Client
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+type MulticallOpts struct {
+	Address   string // Multicall3 contract address, empty falls back to json rpc batch requests
+	BatchSize int    // max calls of one request, default is 100
+}
+
+type Multicall struct {
+	caller    bind.ContractCaller // aggregate3 caller
+	rpc       BatchCaller         // json rpc batch client
+	address   *common.Address     // Multicall3 contract address
+	batchSize int                 // max calls of one request
+}
+
+// NewMulticall creates a batch caller, rpcClient is only required to send json rpc batch requests
+// when no Multicall3 address is configured.
+func NewMulticall(caller bind.ContractCaller, rpcClient BatchCaller, ops *MulticallOpts) (*Multicall, error) {
+	if caller == nil {
+		return nil, errors.New("caller is required")
+	}
+	if ops == nil {
+		ops = &MulticallOpts{}
+	}
+
+	m := &Multicall{
+		caller:    caller,
+		batchSize: ops.BatchSize,
+	}
+	if m.batchSize <= 0 {
+		m.batchSize = chainModel.MULTICALL_BATCH_SIZE
+	}
+
+	if ops.Address != "" {
+		if !common.IsHexAddress(ops.Address) {
+			return nil, errors.New("invalid multicall address")
+		}
+		address := common.HexToAddress(ops.Address)
+		m.address = &address
+		return m, nil
+	}
+
+	if rpcClient == nil {
+		return nil, errors.New("json rpc batch requires a rpc client when no multicall address is configured")
+	}
+	m.rpc = rpcClient
+
+	return m, nil
+}
+
+// Aggregate executes the calls in as few requests as the batch size allows, the results are in call order.
+// An error is returned when a request fails or a call not allowed to fail reverts.
+func (m *Multicall) Aggregate(ctx context.Context, calls []Call) ([]Result, error) {
+	return m.AggregateAt(&bind.CallOpts{Context: ctx}, calls)
+}
+
+// AggregateAt executes the calls like Aggregate on the state selected by opts. When opts has neither BlockNumber
+// nor Pending the head block is resolved once and every request reads it, so that all calls see the same block.
+func (m *Multicall) AggregateAt(opts *bind.CallOpts, calls []Call) ([]Result, error) {
+	if opts == nil {
+		opts = &bind.CallOpts{}
+	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	results := make([]Result, 0, len(calls))
+
+	// a single aggregate3 request reads one block by itself, the chunks and the json rpc batch elements do not
+	if !opts.Pending && opts.BlockNumber == nil && (m.address == nil || len(calls) > m.batchSize) {
+		head, err := m.head(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pinned := *opts
+		pinned.BlockNumber = head
+		opts = &pinned
+	}
+
+	for start := 0; start < len(calls); start += m.batchSize {
+		stop := start + m.batchSize
+		if stop > len(calls) {
+			stop = len(calls)
+		}
+
+		var chunk []Result
+		var err error
+		if m.address != nil {
+			chunk, err = m.aggregate3(ctx, opts, calls[start:stop])
+		} else {
+			chunk, err = m.batchCall(ctx, opts, calls[start:stop])
+		}
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, chunk...)
+	}
+
+	return results, nil
+}
+
+// head returns the number of the head block, from the caller when it reads headers or with eth_blockNumber
+func (m *Multicall) head(ctx context.Context) (*big.Int, error) {
+	if reader, ok := m.caller.(HeaderReader); ok {
+		header, err := reader.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		return header.Number, nil
+	}
+	if m.rpc == nil {
+		return nil, errors.New("caller can not resolve the head block")
+	}
+
+	number := new(hexutil.Big)
+	elems := []rpc.BatchElem{{Method: "eth_blockNumber", Result: number}}
+	if err := m.rpc.BatchCallContext(ctx, elems); err != nil {
+		return nil, err
+	}
+	if elems[0].Error != nil {
+		return nil, elems[0].Error
+	}
+	return number.ToInt(), nil
+}
+
+func (m *Multicall) aggregate3(ctx context.Context, opts *bind.CallOpts, calls []Call) ([]Result, error) {
+	input, err := multicall3Abi.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := ethereum.CallMsg{From: opts.From, To: m.address, Data: input}
+	var output []byte
+	if opts.Pending {
+		caller, ok := m.caller.(bind.PendingContractCaller)
+		if !ok {
+			return nil, bind.ErrNoPendingState
+		}
+		output, err = caller.PendingCallContract(ctx, msg)
+	} else {
+		output, err = m.caller.CallContract(ctx, msg, opts.BlockNumber)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(output) == 0 {
+		return nil, fmt.Errorf("no multicall contract code at %s", m.address.Hex())
+	}
+
+	out, err := multicall3Abi.Unpack("aggregate3", output)
+	if err != nil {
+		return nil, err
+	}
+	returnData := *abi.ConvertType(out[0], new([]struct {
+		Success    bool
+		ReturnData []byte
+	})).(*[]struct {
+		Success    bool
+		ReturnData []byte
+	})
+	if len(returnData) != len(calls) {
+		return nil, fmt.Errorf("multicall returned %d results for %d calls", len(returnData), len(calls))
+	}
+
+	results := make([]Result, len(calls))
+	for i, r := range returnData {
+		results[i] = Result{Success: r.Success, ReturnData: r.ReturnData}
+		if !r.Success {
+			results[i].Err = revertError(r.ReturnData)
+		}
+	}
+
+	return results, nil
+}
+
+func (m *Multicall) batchCall(ctx context.Context, opts *bind.CallOpts, calls []Call) ([]Result, error) {
+	block := "latest"
+	if opts.Pending {
+		block = "pending"
+	} else if opts.BlockNumber != nil {
+		block = hexutil.EncodeBig(opts.BlockNumber)
+	}
+
+	elems := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		elems[i] = rpc.BatchElem{
+			Method: "eth_call",
+			Args: []interface{}{
+				map[string]interface{}{"to": call.Target, "data": hexutil.Bytes(call.CallData)},
+				block,
+			},
+			Result: new(hexutil.Bytes),
+		}
+	}
+
+	if err := m.rpc.BatchCallContext(ctx, elems); err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, len(calls))
+	for i, elem := range elems {
+		if elem.Error != nil {
+			if !calls[i].AllowFailure {
+				return nil, elem.Error
+			}
+			results[i] = Result{Err: elem.Error}
+			continue
+		}
+		results[i] = Result{Success: true, ReturnData: *elem.Result.(*hexutil.Bytes)}
+	}
+
+	return results, nil
+}
+
+func revertError(data []byte) error {
+	reason, err := abi.UnpackRevert(data)
+	if err != nil {
+		return errors.New("execution reverted")
+	}
+	return fmt.Errorf("execution reverted: %s", reason)
+}
@@ -0,0 +1,210 @@
+package multicall
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+// testdata/Multicall3.bin is compiled from testdata/Multicall3.sol with solc 0.8.21,
+// --evm-version london --optimize --optimize-runs 10000000
+func deployMulticall3(t *testing.T, chain *testchain.Chain) common.Address {
+	bin, err := os.ReadFile("testdata/Multicall3.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return chain.Deploy(t, chain.Accounts[0].Signer, &abi.ABI{}, strings.TrimSpace(string(bin)))
+}
+
+// deployERC721 deploys a StandardERC721 and a Multicall3 in two blocks after the genesis
+func deployERC721(t *testing.T) (*testchain.Chain, common.Address, common.Address) {
+	chain := testchain.New(t, 1)
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	address := chain.Deploy(t, chain.Accounts[0].Signer, parsed, erc721.StandardERC721MetaData.Bin, "Standard", "STD")
+
+	return chain, address, deployMulticall3(t, chain)
+}
+
+// blockRecorder records the block numbers of the calls sent to the chain
+type blockRecorder struct {
+	*testchain.Chain
+	blocks []*big.Int
+}
+
+func (r *blockRecorder) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	r.blocks = append(r.blocks, blockNumber)
+	return r.Backend.CallContract(ctx, call, blockNumber)
+}
+
+func (r *blockRecorder) CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error) {
+	return r.Backend.CodeAt(ctx, account, blockNumber)
+}
+
+func (r *blockRecorder) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return r.Backend.HeaderByNumber(ctx, number)
+}
+
+func queueCalls(t *testing.T, batch *Batch, address common.Address) []*BatchResult {
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+
+	decoder := func(method string) Decoder {
+		return func(data []byte) (interface{}, error) {
+			out, err := parsed.Unpack(method, data)
+			if err != nil {
+				return nil, err
+			}
+			return out[0], nil
+		}
+	}
+
+	var results []*BatchResult
+	for _, method := range []string{"name", "symbol", "totalSupply"} {
+		data, _ := parsed.Pack(method)
+		results = append(results, batch.Add(Call{Target: address, AllowFailure: true, CallData: data}, decoder(method)))
+	}
+	// token 1 is not minted, ownerOf reverts
+	data, _ := parsed.Pack("ownerOf", big.NewInt(1))
+	results = append(results, batch.Add(Call{Target: address, AllowFailure: true, CallData: data}, decoder("ownerOf")))
+
+	return results
+}
+
+func checkResults(t *testing.T, results []*BatchResult) {
+	if !results[0].Success || results[0].Value != "Standard" {
+		t.Errorf("unexpected name result %+v", results[0])
+	}
+	if !results[1].Success || results[1].Value != "STD" {
+		t.Errorf("unexpected symbol result %+v", results[1])
+	}
+	if !results[2].Success || results[2].Value.(*big.Int).Sign() != 0 {
+		t.Errorf("unexpected totalSupply result %+v", results[2])
+	}
+	if results[3].Success || results[3].Err == nil {
+		t.Errorf("ownerOf of a missing token should fail, got %+v", results[3])
+	}
+}
+
+func TestMulticall_Aggregate3(t *testing.T) {
+	chain, address, multicallAddr := deployERC721(t)
+
+	m, err := NewMulticall(chain.Backend, nil, &MulticallOpts{Address: multicallAddr.Hex(), BatchSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	batch := m.NewBatch()
+	results := queueCalls(t, batch, address)
+	if err = batch.Execute(ctx); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	checkResults(t, results)
+
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, Pending: true}); err != nil {
+		t.Fatalf("ExecuteAt pending err:%+v\n", err)
+	}
+	checkResults(t, results)
+
+	// the simulated chain only serves the latest block, a past block proves the number reaches the node
+	latest := chain.Sim.Blockchain().CurrentBlock().Number()
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, BlockNumber: latest}); err != nil {
+		t.Fatalf("ExecuteAt latest err:%+v\n", err)
+	}
+	checkResults(t, results)
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(1)}); err == nil {
+		t.Error("ExecuteAt a past block should reach the simulated chain and fail")
+	}
+
+	// a revert of a call not allowed to fail fails the whole request
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	data, _ := parsed.Pack("ownerOf", big.NewInt(1))
+	if _, err = m.Aggregate(ctx, []Call{{Target: address, CallData: data}}); err == nil || !strings.Contains(err.Error(), "Multicall3: call failed") {
+		t.Errorf("expected aggregate error, got %+v", err)
+	}
+}
+
+func TestMulticall_AggregateHead(t *testing.T) {
+	chain, address, multicallAddr := deployERC721(t)
+	recorder := &blockRecorder{Chain: chain}
+
+	m, err := NewMulticall(recorder, nil, &MulticallOpts{Address: multicallAddr.Hex(), BatchSize: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the four calls need two requests, both read the head resolved before the first one
+	batch := m.NewBatch()
+	results := queueCalls(t, batch, address)
+	if err = batch.Execute(context.Background()); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	checkResults(t, results)
+
+	latest := chain.Sim.Blockchain().CurrentBlock().Number()
+	if len(recorder.blocks) != 2 {
+		t.Fatalf("requests:%d want:2\n", len(recorder.blocks))
+	}
+	for _, block := range recorder.blocks {
+		if block == nil || block.Cmp(latest) != 0 {
+			t.Errorf("request block:%v want:%v\n", block, latest)
+		}
+	}
+
+	// a single request reads one block by itself
+	recorder.blocks = nil
+	if _, err = m.Aggregate(context.Background(), []Call{{Target: address, AllowFailure: true}}); err != nil {
+		t.Fatalf("Aggregate err:%+v\n", err)
+	}
+	if len(recorder.blocks) != 1 || recorder.blocks[0] != nil {
+		t.Errorf("single request blocks:%v\n", recorder.blocks)
+	}
+}
+
+func TestMulticall_RPCBatch(t *testing.T) {
+	chain, address, _ := deployERC721(t)
+
+	if _, err := NewMulticall(chain.Backend, nil, nil); err == nil {
+		t.Error("json rpc batch without rpc client should fail")
+	}
+
+	m, err := NewMulticall(chain.Backend, chain.Backend, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	batch := m.NewBatch()
+	results := queueCalls(t, batch, address)
+	if err = batch.Execute(ctx); err != nil {
+		t.Fatalf("Execute err:%+v\n", err)
+	}
+	checkResults(t, results)
+
+	latest := chain.Sim.Blockchain().CurrentBlock().Number()
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, BlockNumber: latest}); err != nil {
+		t.Fatalf("ExecuteAt latest err:%+v\n", err)
+	}
+	checkResults(t, results)
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, Pending: true}); err != nil {
+		t.Fatalf("ExecuteAt pending err:%+v\n", err)
+	}
+	checkResults(t, results)
+
+	// the simulated chain only serves the latest block
+	if err = batch.ExecuteAt(&bind.CallOpts{Context: ctx, BlockNumber: big.NewInt(0)}); err != nil {
+		t.Fatalf("ExecuteAt err:%+v\n", err)
+	}
+	if results[0].Success || results[0].Err == nil || !strings.Contains(results[0].Err.Error(), "latest block") {
+		t.Errorf("name of a past block should fail, got %+v", results[0])
+	}
+}
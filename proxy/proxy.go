@@ -0,0 +1,236 @@
+// Package proxy deploys EIP-1967 proxies in front of an initializable implementation, reads their
+// implementation and admin slots and sends their upgrades.
+//
+// The proxies are the OpenZeppelin v4.3.0 contracts of contract/proxy.sol. With an admin the proxy is a
+// TransparentUpgradeableProxy: calls of the admin are handled by the proxy itself (upgradeTo, upgradeToAndCall,
+// changeAdmin, admin and implementation), every other call is delegated to the implementation, so the admin can
+// never reach the implementation through the proxy. Without an admin it is an ERC1967Proxy for UUPS
+// implementations, which upgrade themselves with their own upgradeTo.
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/jason-bateman/go-erc-standard-contract/clone"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	proxy "github.com/jason-bateman/go-erc-standard-contract/proxy/contract"
+)
+
+const (
+	// ImplementationSlot is bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1)
+	ImplementationSlot = "0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc"
+
+	// AdminSlot is bytes32(uint256(keccak256("eip1967.proxy.admin")) - 1)
+	AdminSlot = "0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103"
+)
+
+// StorageReader reads a storage slot, it is implemented by *backend.Backend
+type StorageReader interface {
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, blockNumber *big.Int) ([]byte, error)
+}
+
+// StateReader reads the code and the storage of an account, it is implemented by *backend.Backend
+type StateReader interface {
+	StorageReader
+	CodeAt(ctx context.Context, account common.Address, blockNumber *big.Int) ([]byte, error)
+}
+
+// PendingStateReader reads the pending code and storage of an account, the reads of opts.Pending require it.
+// It is implemented by *backend.Backend
+type PendingStateReader interface {
+	PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error)
+	PendingStorageAt(ctx context.Context, account common.Address, key common.Hash) ([]byte, error)
+}
+
+// Backend is the chain access of Deploy and Admin
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	StorageReader
+}
+
+// Deploy deploys a proxy of implementation and calls it with data in the same transaction, a reverted call
+// reverts the deploy. A zero admin deploys an ERC1967Proxy which only the UUPS implementation can upgrade,
+// otherwise a TransparentUpgradeableProxy which the admin upgrades.
+func Deploy(ctx context.Context, signer *bind.TransactOpts, backend Backend, implementation, admin common.Address, data []byte) (common.Address, *types.Transaction, error) {
+	code, err := backend.CodeAt(ctx, implementation, nil)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if len(code) == 0 {
+		return common.Address{}, nil, fmt.Errorf("no implementation code at %s", implementation.Hex())
+	}
+
+	auth := *signer
+	auth.Context = ctx
+	var tx *types.Transaction
+	if admin == (common.Address{}) {
+		_, tx, _, err = proxy.DeployERC1967Proxy(&auth, backend, implementation, data)
+	} else {
+		_, tx, _, err = proxy.DeployTransparentUpgradeableProxy(&auth, backend, implementation, admin, data)
+	}
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	address, err := bind.WaitDeployed(ctx, backend, tx)
+	if err != nil {
+		return common.Address{}, tx, fmt.Errorf("deploy proxy of %s failed, tx %s err:%w", implementation.Hex(), tx.Hash().Hex(), err)
+	}
+	return address, tx, nil
+}
+
+// ReadImplementation returns the implementation in the EIP-1967 slot of proxy, zero when it is no proxy
+func ReadImplementation(ctx context.Context, backend StorageReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return readSlot(ctx, backend, proxy, common.HexToHash(ImplementationSlot), blockNumber)
+}
+
+// ReadAdmin returns the admin in the EIP-1967 slot of proxy, zero when the proxy has no admin
+func ReadAdmin(ctx context.Context, backend StorageReader, proxy common.Address, blockNumber *big.Int) (common.Address, error) {
+	return readSlot(ctx, backend, proxy, common.HexToHash(AdminSlot), blockNumber)
+}
+
+// ReadSlot returns the address in an EIP-1967 slot of account on the state selected by opts
+func ReadSlot(opts *bind.CallOpts, backend StorageReader, account common.Address, slot string) (common.Address, error) {
+	if !opts.Pending {
+		return readSlot(opts.Context, backend, account, common.HexToHash(slot), opts.BlockNumber)
+	}
+
+	reader, ok := backend.(PendingStateReader)
+	if !ok {
+		return common.Address{}, bind.ErrNoPendingState
+	}
+	value, err := reader.PendingStorageAt(opts.Context, account, common.HexToHash(slot))
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(value), nil
+}
+
+// ResolveImplementation returns the implementation account delegates to on the state selected by opts when it is an
+// EIP-1167 clone or an EIP-1967 proxy, zero when it runs its own code
+func ResolveImplementation(opts *bind.CallOpts, backend StateReader, account common.Address) (common.Address, error) {
+	var code []byte
+	var err error
+	if opts.Pending {
+		reader, ok := backend.(PendingStateReader)
+		if !ok {
+			return common.Address{}, bind.ErrNoPendingState
+		}
+		code, err = reader.PendingCodeAt(opts.Context, account)
+	} else {
+		code, err = backend.CodeAt(opts.Context, account, opts.BlockNumber)
+	}
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	if implementation, ok := clone.Implementation(code); ok {
+		return implementation, nil
+	}
+	return ReadSlot(opts, backend, account, ImplementationSlot)
+}
+
+// UpgradeMethod is the method Upgrade sends for data
+func UpgradeMethod(data []byte) string {
+	if len(data) > 0 {
+		return "upgradeToAndCall"
+	}
+	return "upgradeTo"
+}
+
+// Upgrade sends upgradeTo, or upgradeToAndCall with data, to account without waiting for the receipt. A UUPS
+// implementation and a transparent proxy take the same calls, signed by the owner or the admin respectively.
+func Upgrade(opts *bind.TransactOpts, transactor bind.ContractTransactor, account, implementation common.Address, data []byte) (*types.Transaction, error) {
+	upgradeable, err := proxy.NewTransparentUpgradeableProxyTransactor(account, transactor)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		return upgradeable.UpgradeToAndCall(opts, implementation, data)
+	}
+	return upgradeable.UpgradeTo(opts, implementation)
+}
+
+type AdminOpts struct {
+	Address string // proxy address
+}
+
+// Admin upgrades a proxy deployed with an admin, its calls have to be signed by the admin
+type Admin struct {
+	backend    Backend
+	address    common.Address
+	transactor *proxy.TransparentUpgradeableProxyTransactor
+}
+
+func NewAdmin(backend Backend, ops *AdminOpts) (*Admin, error) {
+	if backend == nil {
+		return nil, errors.New("backend is required")
+	}
+	if ops == nil || !common.IsHexAddress(ops.Address) {
+		return nil, errors.New("invalid proxy address")
+	}
+
+	address := common.HexToAddress(ops.Address)
+	transactor, err := proxy.NewTransparentUpgradeableProxyTransactor(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Admin{
+		backend:    backend,
+		address:    address,
+		transactor: transactor,
+	}, nil
+}
+
+func (a *Admin) Address() common.Address {
+	return a.address
+}
+
+// UpgradeTo points the proxy to implementation, with data it also delegates data to the new implementation,
+// usually a reinitializer or migration
+func (a *Admin) UpgradeTo(ctx context.Context, signer *bind.TransactOpts, implementation common.Address, data []byte) (*types.Transaction, error) {
+	return a.transact(ctx, signer, UpgradeMethod(data), func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return Upgrade(auth, a.backend, a.address, implementation, data)
+	})
+}
+
+// ChangeAdmin hands the proxy to admin, the proxy can not be left without admin
+func (a *Admin) ChangeAdmin(ctx context.Context, signer *bind.TransactOpts, admin common.Address) (*types.Transaction, error) {
+	if admin == (common.Address{}) {
+		return nil, errors.New("admin is required")
+	}
+	return a.transact(ctx, signer, "changeAdmin", func(auth *bind.TransactOpts) (*types.Transaction, error) {
+		return a.transactor.ChangeAdmin(auth, admin)
+	})
+}
+
+// transact sends the call of method and waits until it is mined
+func (a *Admin) transact(ctx context.Context, signer *bind.TransactOpts, method string, send func(auth *bind.TransactOpts) (*types.Transaction, error)) (*types.Transaction, error) {
+	auth := *signer
+	auth.Context = ctx
+	tx, err := send(&auth)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := bind.WaitMined(ctx, a.backend, tx)
+	if err != nil {
+		return tx, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return tx, fmt.Errorf("%s of proxy %s failed, tx %s", method, a.address.Hex(), tx.Hash().Hex())
+	}
+	return tx, nil
+}
+
+func readSlot(ctx context.Context, backend StorageReader, account common.Address, slot common.Hash, blockNumber *big.Int) (common.Address, error) {
+	value, err := backend.StorageAt(ctx, account, slot, blockNumber)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(value), nil
+}
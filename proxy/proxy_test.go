@@ -0,0 +1,161 @@
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jason-bateman/go-erc-standard-contract/clone"
+	"github.com/jason-bateman/go-erc-standard-contract/contracts/common/bind"
+	erc721 "github.com/jason-bateman/go-erc-standard-contract/contracts/erc721/contract"
+	"github.com/jason-bateman/go-erc-standard-contract/internal/testchain"
+)
+
+func TestDeploy(t *testing.T) {
+	chain := testchain.New(t, 2)
+	admin, adminSigner := chain.Accounts[0].Address, chain.Accounts[0].Signer
+	user, userSigner := chain.Accounts[1].Address, chain.Accounts[1].Signer
+
+	ctx := context.Background()
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	deployImplementation := func() common.Address {
+		return chain.Deploy(t, userSigner, parsed, erc721.StandardERC721MetaData.Bin, "Standard", "STD")
+	}
+	implementation := deployImplementation()
+
+	initData, _ := parsed.Pack("name")
+	address, _, err := Deploy(ctx, userSigner, chain.Backend, implementation, admin, initData)
+	if err != nil {
+		t.Fatalf("deploy proxy err:%+v\n", err)
+	}
+	if got, err := ReadImplementation(ctx, chain.Backend, address, nil); err != nil || got != implementation {
+		t.Errorf("implementation:%s err:%+v\n", got.Hex(), err)
+	}
+	if got, err := ReadAdmin(ctx, chain.Backend, address, nil); err != nil || got != admin {
+		t.Errorf("admin:%s err:%+v\n", got.Hex(), err)
+	}
+
+	// 非admin的调用委托给实现合约, 存储在proxy中
+	instance, _ := erc721.NewStandardERC721Caller(address, chain.Backend)
+	if name, err := instance.Name(&bind.CallOpts{From: user}); err != nil || name != "" {
+		t.Errorf("proxy name:%s err:%+v\n", name, err)
+	}
+	// admin的调用不会到达实现合约
+	if _, err = instance.Name(&bind.CallOpts{From: admin}); err == nil {
+		t.Errorf("call of the admin should not be delegated\n")
+	}
+
+	proxyAdmin, err := NewAdmin(chain.Backend, &AdminOpts{Address: address.Hex()})
+	if err != nil {
+		t.Fatalf("new admin err:%+v\n", err)
+	}
+	if _, err = proxyAdmin.UpgradeTo(ctx, userSigner, deployImplementation(), nil); err == nil {
+		t.Errorf("upgrade by a non admin should fail\n")
+	}
+
+	upgraded := deployImplementation()
+	if _, err = proxyAdmin.UpgradeTo(ctx, adminSigner, upgraded, nil); err != nil {
+		t.Fatalf("upgrade err:%+v\n", err)
+	}
+	if got, _ := ReadImplementation(ctx, chain.Backend, address, nil); got != upgraded {
+		t.Errorf("upgraded implementation:%s want:%s\n", got.Hex(), upgraded.Hex())
+	}
+
+	// 升级时的调用回滚则升级回滚
+	revertData, _ := parsed.Pack("transferOwnership", user)
+	if _, err = proxyAdmin.UpgradeTo(ctx, adminSigner, implementation, revertData); err == nil {
+		t.Errorf("upgrade with a reverted call should fail\n")
+	}
+	if _, err = proxyAdmin.UpgradeTo(ctx, adminSigner, implementation, initData); err != nil {
+		t.Fatalf("upgrade and call err:%+v\n", err)
+	}
+	if got, _ := ReadImplementation(ctx, chain.Backend, address, nil); got != implementation {
+		t.Errorf("upgraded implementation:%s want:%s\n", got.Hex(), implementation.Hex())
+	}
+	if _, err = proxyAdmin.UpgradeTo(ctx, adminSigner, user, nil); err == nil {
+		t.Errorf("upgrade to an account without code should fail\n")
+	}
+
+	if _, err = proxyAdmin.ChangeAdmin(ctx, adminSigner, user); err != nil {
+		t.Fatalf("change admin err:%+v\n", err)
+	}
+	if got, _ := ReadAdmin(ctx, chain.Backend, address, nil); got != user {
+		t.Errorf("changed admin:%s want:%s\n", got.Hex(), user.Hex())
+	}
+	if name, err := instance.Name(&bind.CallOpts{From: admin}); err != nil || name != "" {
+		t.Errorf("name of the former admin:%s err:%+v\n", name, err)
+	}
+}
+
+func TestDeploy_WithoutAdmin(t *testing.T) {
+	chain := testchain.New(t, 1)
+	sender, signer := chain.Accounts[0].Address, chain.Accounts[0].Signer
+
+	ctx := context.Background()
+	if _, _, err := Deploy(ctx, signer, chain.Backend, sender, common.Address{}, nil); err == nil {
+		t.Errorf("deploy of an implementation without code should fail\n")
+	}
+
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	implementation := chain.Deploy(t, signer, parsed, erc721.StandardERC721MetaData.Bin, "Standard", "STD")
+
+	revertData, _ := parsed.Pack("transferOwnership", sender)
+	if _, _, err := Deploy(ctx, signer, chain.Backend, implementation, common.Address{}, revertData); err == nil {
+		t.Errorf("deploy with a reverted initializer should fail\n")
+	}
+
+	address, _, err := Deploy(ctx, signer, chain.Backend, implementation, common.Address{}, nil)
+	if err != nil {
+		t.Fatalf("deploy proxy err:%+v\n", err)
+	}
+	if got, _ := ReadAdmin(ctx, chain.Backend, address, nil); got != (common.Address{}) {
+		t.Errorf("admin:%s\n", got.Hex())
+	}
+
+	// 没有admin时所有调用都委托给实现合约
+	instance, _ := erc721.NewStandardERC721Caller(address, chain.Backend)
+	if owner, err := instance.Owner(&bind.CallOpts{From: sender}); err != nil || owner != (common.Address{}) {
+		t.Errorf("proxy owner:%s err:%+v\n", owner.Hex(), err)
+	}
+	if got, _ := ReadImplementation(ctx, chain.Backend, implementation, nil); got != (common.Address{}) {
+		t.Errorf("implementation of no proxy:%s\n", got.Hex())
+	}
+}
+
+func TestResolveImplementation(t *testing.T) {
+	chain := testchain.New(t, 1)
+	signer := chain.Accounts[0].Signer
+
+	parsed, _ := erc721.StandardERC721MetaData.GetAbi()
+	implementation := chain.Deploy(t, signer, parsed, erc721.StandardERC721MetaData.Bin, "Standard", "STD")
+	address, _, err := Deploy(context.Background(), signer, chain.Backend, implementation, common.Address{}, nil)
+	if err != nil {
+		t.Fatalf("deploy proxy err:%+v\n", err)
+	}
+	factory, _ := clone.NewFactory(chain.Backend, nil)
+	if err = factory.Install(context.Background(), signer); err != nil {
+		t.Fatalf("install clone factory err:%+v\n", err)
+	}
+	cloned, _, err := factory.Clone(context.Background(), signer, implementation, common.HexToHash("0x01"), nil)
+	if err != nil {
+		t.Fatalf("clone err:%+v\n", err)
+	}
+
+	for _, opts := range []*bind.CallOpts{{Context: context.Background()}, {Context: context.Background(), Pending: true}} {
+		if got, err := ResolveImplementation(opts, chain.Backend, address); err != nil || got != implementation {
+			t.Errorf("implementation of the proxy:%s pending:%v err:%+v\n", got.Hex(), opts.Pending, err)
+		}
+		if got, err := ResolveImplementation(opts, chain.Backend, cloned); err != nil || got != implementation {
+			t.Errorf("implementation of the clone:%s pending:%v err:%+v\n", got.Hex(), opts.Pending, err)
+		}
+		if got, err := ResolveImplementation(opts, chain.Backend, implementation); err != nil || got != (common.Address{}) {
+			t.Errorf("implementation of no proxy:%s pending:%v err:%+v\n", got.Hex(), opts.Pending, err)
+		}
+	}
+
+	// 不支持pending状态的backend
+	pending := &bind.CallOpts{Context: context.Background(), Pending: true}
+	if _, err = ReadSlot(pending, chain.Sim, address, ImplementationSlot); err != bind.ErrNoPendingState {
+		t.Errorf("pending slot without pending reader err:%+v\n", err)
+	}
+}
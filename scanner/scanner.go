@@ -0,0 +1,138 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/sink"
+)
+
+// EventFilterer is implemented by the erc721 and erc1155 Contract
+type EventFilterer interface {
+	FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error)
+}
+
+// HeadReader returns the latest block number, *backend.Backend implements it
+type HeadReader interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+type ScannerOpts struct {
+	Step    uint64          // block interval of each FilterEvents call, must not exceed the contract filter step
+	Sinks   []sink.Sink     // every batch is sent to all of the sinks
+	Name    string          // scanner label of the lag metric
+	Metrics metrics.Metrics // optional, reports the lag behind head after each window
+	Head    HeadReader      // optional, skips the query when the head is behind, without it the lag is measured against stopBlockNum
+}
+
+type Scanner struct {
+	filterer EventFilterer
+	step     uint64
+	sink     sink.Sink
+	name     string
+	metrics  metrics.Metrics
+	head     HeadReader
+}
+
+func NewScanner(filterer EventFilterer, ops *ScannerOpts) (*Scanner, error) {
+	if filterer == nil {
+		return nil, errors.New("filterer is required")
+	}
+	if ops == nil || len(ops.Sinks) == 0 {
+		return nil, errors.New("at least one sink is required")
+	}
+
+	s := &Scanner{
+		filterer: filterer,
+		step:     ops.Step,
+		sink:     sink.NewMultiSink(ops.Sinks...),
+		name:     ops.Name,
+		metrics:  metrics.OrNop(ops.Metrics),
+		head:     ops.Head,
+	}
+	if s.step == 0 {
+		s.step = chainModel.EVENT_FILTER_STEP_NUM
+	}
+	return s, nil
+}
+
+// Scan filters the events from startBlockNum to stopBlockNum and sends them to the sinks window by window.
+// It returns the next block to scan, which is lower than stopBlockNum+1 when the chain head is reached.
+func (s *Scanner) Scan(ctx context.Context, startBlockNum, stopBlockNum uint64) (uint64, error) {
+	next := startBlockNum
+
+	for next <= stopBlockNum {
+		if err := ctx.Err(); err != nil {
+			return next, err
+		}
+
+		// 链头落后于next时不查询, from > to的eth_getLogs会被节点拒绝
+		if s.head != nil {
+			head, err := s.head.BlockNumber(ctx)
+			if err != nil {
+				return next, err
+			}
+			if head < next {
+				s.metrics.ScannerLag(s.name, 0)
+				return next, nil
+			}
+		}
+
+		want := next + s.step
+		if want > stopBlockNum {
+			want = stopBlockNum
+		}
+		stop := want
+
+		// FilterEvents caps stop at the latest block
+		events, err := s.filterer.FilterEvents(ctx, next, &stop)
+		if err != nil {
+			return next, err
+		}
+		if stop < next {
+			s.metrics.ScannerLag(s.name, 0)
+			return next, nil
+		}
+		if len(events) != 0 {
+			if err = s.sink.Send(ctx, events); err != nil {
+				return next, err
+			}
+		}
+
+		next = stop + 1
+		s.reportLag(ctx, stop, stop < want, stopBlockNum)
+		if stop < want {
+			break
+		}
+	}
+
+	return next, nil
+}
+
+// reportLag 上报已扫描区块落后于链头的区块数
+func (s *Scanner) reportLag(ctx context.Context, scanned uint64, atHead bool, stopBlockNum uint64) {
+	target := stopBlockNum
+	if s.head != nil {
+		head, err := s.head.BlockNumber(ctx)
+		if err != nil {
+			return
+		}
+		target = head
+	} else if atHead {
+		// FilterEvents capped the window, scanned is the latest block
+		target = scanned
+	}
+
+	var lag uint64
+	if target > scanned {
+		lag = target - scanned
+	}
+	s.metrics.ScannerLag(s.name, lag)
+}
+
+// Close closes all of the sinks
+func (s *Scanner) Close() error {
+	return s.sink.Close()
+}
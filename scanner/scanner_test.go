@@ -0,0 +1,140 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jason-bateman/go-erc-standard-contract/metrics"
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+	"github.com/jason-bateman/go-erc-standard-contract/sink"
+)
+
+// fakeFilterer emits one event per block and caps the range at latest
+type fakeFilterer struct {
+	latest uint64
+	calls  int
+}
+
+func (f *fakeFilterer) FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
+	f.calls++
+	if *stopBlockNum > f.latest {
+		*stopBlockNum = f.latest
+	}
+	var events []*chainModel.EthereumEventMessage
+	for b := startBlockNum; b <= *stopBlockNum; b++ {
+		events = append(events, &chainModel.EthereumEventMessage{Event: "Transfer", BlockNumber: b})
+	}
+	return events, nil
+}
+
+func TestScanner_Scan(t *testing.T) {
+	filterer := &fakeFilterer{latest: 25}
+	first, second := sink.NewMemoryPublisher(), sink.NewMemoryPublisher()
+	firstSink, _ := sink.NewPublisherSink(first, "events")
+	secondSink, _ := sink.NewPublisherSink(second, "events")
+
+	s, err := NewScanner(filterer, &ScannerOpts{Step: 9, Sinks: []sink.Sink{firstSink, secondSink}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	next, err := s.Scan(context.Background(), 1, 100)
+	if err != nil {
+		t.Fatalf("Scan err:%+v\n", err)
+	}
+	if next != 26 {
+		t.Errorf("next block should be 26, got %d", next)
+	}
+	if filterer.calls != 3 {
+		t.Errorf("expect 3 FilterEvents calls, got %d", filterer.calls)
+	}
+	if len(first.Messages("events")) != 25 || len(second.Messages("events")) != 25 {
+		t.Errorf("every sink should receive 25 events")
+	}
+
+	// nothing new until the head moves
+	next, err = s.Scan(context.Background(), next, 100)
+	if err != nil || next != 26 {
+		t.Errorf("scan at head should return 26, got %d %+v", next, err)
+	}
+}
+
+type lagRecorder struct {
+	metrics.Nop
+	lags []uint64
+}
+
+func (r *lagRecorder) ScannerLag(scanner string, blocks uint64) {
+	r.lags = append(r.lags, blocks)
+}
+
+type fixedHead uint64
+
+func (h fixedHead) BlockNumber(ctx context.Context) (uint64, error) {
+	return uint64(h), nil
+}
+
+func TestScanner_Lag(t *testing.T) {
+	filterer := &fakeFilterer{latest: 25}
+	publisherSink, _ := sink.NewPublisherSink(sink.NewMemoryPublisher(), "events")
+	recorder := &lagRecorder{}
+
+	s, err := NewScanner(filterer, &ScannerOpts{Step: 9, Sinks: []sink.Sink{publisherSink}, Name: "transfers", Metrics: recorder, Head: fixedHead(25)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if _, err = s.Scan(context.Background(), 1, 100); err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{15, 5, 0}
+	if len(recorder.lags) != len(want) {
+		t.Fatalf("expect lags %v, got %v", want, recorder.lags)
+	}
+	for i := range want {
+		if recorder.lags[i] != want[i] {
+			t.Errorf("expect lags %v, got %v", want, recorder.lags)
+			break
+		}
+	}
+}
+
+// strictFilterer forwards every window like a node, a range beyond the head is rejected
+type strictFilterer struct {
+	fakeFilterer
+}
+
+func (f *strictFilterer) FilterEvents(ctx context.Context, startBlockNum uint64, stopBlockNum *uint64) ([]*chainModel.EthereumEventMessage, error) {
+	if startBlockNum > f.latest {
+		return nil, errors.New("invalid block range params")
+	}
+	return f.fakeFilterer.FilterEvents(ctx, startBlockNum, stopBlockNum)
+}
+
+func TestScanner_ScanAtHead(t *testing.T) {
+	filterer := &strictFilterer{fakeFilterer{latest: 25}}
+	publisherSink, _ := sink.NewPublisherSink(sink.NewMemoryPublisher(), "events")
+
+	s, err := NewScanner(filterer, &ScannerOpts{Step: 9, Sinks: []sink.Sink{publisherSink}, Head: fixedHead(25)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	next, err := s.Scan(context.Background(), 1, 100)
+	if err != nil || next != 26 {
+		t.Fatalf("scan should return 26, got %d %+v", next, err)
+	}
+	calls := filterer.calls
+
+	// 链头未前进时不应查询
+	if next, err = s.Scan(context.Background(), next, 100); err != nil || next != 26 {
+		t.Errorf("scan at head should return 26, got %d %+v", next, err)
+	}
+	if filterer.calls != calls {
+		t.Errorf("scan at head should not filter, got %d calls", filterer.calls-calls)
+	}
+}
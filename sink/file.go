@@ -0,0 +1,141 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+type FileOpts struct {
+	Path       string // path of the active json-lines file
+	MaxBytes   int64  // rotate once the active file grows beyond this size, 0 never rotates
+	MaxBackups int    // number of rotated files to keep, 0 keeps all of them
+}
+
+// openFile opens the active file, tests replace it to fail the reopen of a rotation
+var openFile = os.OpenFile
+
+type fileSink struct {
+	mu   sync.Mutex
+	opts FileOpts
+	file *os.File
+	size int64
+}
+
+// NewFileSink appends every event message as one json line to a rotating file
+func NewFileSink(opts *FileOpts) (Sink, error) {
+	if opts == nil || opts.Path == "" {
+		return nil, errors.New("file path is required")
+	}
+
+	f := &fileSink{opts: *opts}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *fileSink) open() error {
+	file, err := openFile(f.opts.Path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *fileSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return errors.New("file sink is closed")
+	}
+
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		line = append(line, '\n')
+
+		if f.opts.MaxBytes > 0 && f.size > 0 && f.size+int64(len(line)) > f.opts.MaxBytes {
+			if err = f.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := f.file.Write(line)
+		f.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return f.file.Sync()
+}
+
+// rotate renames the active file with a timestamp suffix and opens a new one
+func (f *fileSink) rotate() error {
+	backup := f.opts.Path + "." + time.Now().UTC().Format("20060102T150405.000000000")
+	if err := os.Rename(f.opts.Path, backup); err != nil {
+		return err
+	}
+
+	// 新文件打开成功后才替换, 失败时改回原名继续写入
+	old := f.file
+	if err := f.open(); err != nil {
+		_ = os.Rename(backup, f.opts.Path)
+		return err
+	}
+	if err := old.Close(); err != nil {
+		return err
+	}
+	return f.prune()
+}
+
+// prune removes the oldest rotated files beyond MaxBackups
+func (f *fileSink) prune() error {
+	if f.opts.MaxBackups <= 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(f.opts.Path + ".*")
+	if err != nil {
+		return err
+	}
+	if len(backups) <= f.opts.MaxBackups {
+		return nil
+	}
+	sort.Strings(backups)
+	for _, b := range backups[:len(backups)-f.opts.MaxBackups] {
+		if err = os.Remove(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fileSink) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
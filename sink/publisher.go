@@ -0,0 +1,98 @@
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+// Publisher abstracts a message queue producer such as Kafka or NATS
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key, value []byte) error
+	Close() error
+}
+
+type publisherSink struct {
+	publisher Publisher
+	topic     string
+}
+
+// NewPublisherSink publishes every event message to the topic, keyed by "tx_id:block_index"
+func NewPublisherSink(publisher Publisher, topic string) (Sink, error) {
+	if publisher == nil {
+		return nil, errors.New("publisher is required")
+	}
+	return &publisherSink{publisher: publisher, topic: topic}, nil
+}
+
+func (p *publisherSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	for _, e := range events {
+		value, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s:%d", e.TxId, e.BlockIndex)
+		if err = p.publisher.Publish(ctx, p.topic, []byte(key), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *publisherSink) Close() error {
+	return p.publisher.Close()
+}
+
+type PublishedMessage struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// MemoryPublisher keeps the published messages in memory, it stands in for a real queue in tests
+type MemoryPublisher struct {
+	mu       sync.Mutex
+	messages []PublishedMessage
+	closed   bool
+}
+
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+func (m *MemoryPublisher) Publish(ctx context.Context, topic string, key, value []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return errors.New("publisher is closed")
+	}
+	m.messages = append(m.messages, PublishedMessage{Topic: topic, Key: key, Value: value})
+	return nil
+}
+
+// Messages returns the messages published to the topic so far
+func (m *MemoryPublisher) Messages(topic string) []PublishedMessage {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var result []PublishedMessage
+	for _, msg := range m.messages {
+		if msg.Topic == topic {
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+func (m *MemoryPublisher) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.closed = true
+	return nil
+}
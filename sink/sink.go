@@ -0,0 +1,81 @@
+package sink
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+// Sink forwards the filtered event messages to a downstream consumer
+type Sink interface {
+	// Send delivers a batch of event messages, the batch is in block order
+	Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error
+	// Close releases the resources held by the sink
+	Close() error
+}
+
+type multiSink struct {
+	mu        sync.Mutex
+	sinks     []Sink
+	batch     string       // key of the last batch which was not delivered to all sinks
+	delivered map[int]bool // sinks which already took the batch
+}
+
+// NewMultiSink fans every batch out to all of the given sinks. When some sinks fail, resending the
+// same batch only goes to the failed sinks, the others do not receive it twice.
+func NewMultiSink(sinks ...Sink) Sink {
+	return &multiSink{sinks: sinks}
+}
+
+// batchKey identifies a batch by its first and last event, keyed like the publisher messages
+func batchKey(events []*chainModel.EthereumEventMessage) string {
+	if len(events) == 0 {
+		return ""
+	}
+	first, last := events[0], events[len(events)-1]
+	return fmt.Sprintf("%d:%s:%d-%s:%d", len(events), first.TxId, first.BlockIndex, last.TxId, last.BlockIndex)
+}
+
+func (m *multiSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// 新的批次重新投递到所有sink
+	if key := batchKey(events); key != m.batch || m.delivered == nil {
+		m.batch = key
+		m.delivered = make(map[int]bool, len(m.sinks))
+	}
+
+	var errs []string
+	for i, s := range m.sinks {
+		if m.delivered[i] {
+			continue
+		}
+		if err := s.Send(ctx, events); err != nil {
+			errs = append(errs, fmt.Sprintf("sink %d: %v", i, err))
+			continue
+		}
+		m.delivered[i] = true
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("send events failed: %s", strings.Join(errs, "; "))
+	}
+	m.delivered = nil
+	return nil
+}
+
+func (m *multiSink) Close() error {
+	var errs []string
+	for i, s := range m.sinks {
+		if err := s.Close(); err != nil {
+			errs = append(errs, fmt.Sprintf("sink %d: %v", i, err))
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("close sinks failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
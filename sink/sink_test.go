@@ -0,0 +1,231 @@
+package sink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+func testEvents() []*chainModel.EthereumEventMessage {
+	return []*chainModel.EthereumEventMessage{
+		{Event: "Transfer", BlockNumber: 1, TxId: "0x01", ChainId: 97, BlockIndex: 0},
+		{Event: "Approval", BlockNumber: 2, TxId: "0x02", ChainId: 97, BlockIndex: 3},
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(WEBHOOK_SIGNATURE_HEADER) != Sign("secret", body) {
+			t.Errorf("invalid signature %s", r.Header.Get(WEBHOOK_SIGNATURE_HEADER))
+		}
+		// fail the first attempt to exercise the retry
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var events []*chainModel.EthereumEventMessage
+		if err := json.Unmarshal(body, &events); err != nil || len(events) != 2 {
+			t.Errorf("unexpected body %s", body)
+		}
+	}))
+	defer server.Close()
+
+	s, err := NewWebhookSink(&WebhookOpts{Url: server.URL, Secret: "secret", RetryInterval: time.Millisecond})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	if err = s.Send(context.Background(), testEvents()); err != nil {
+		t.Errorf("Send err:%+v\n", err)
+	}
+	if calls != 2 {
+		t.Errorf("webhook should be called twice, got %d", calls)
+	}
+}
+
+func TestWebhookSinkClientError(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	s, _ := NewWebhookSink(&WebhookOpts{Url: server.URL, RetryInterval: time.Millisecond})
+	if err := s.Send(context.Background(), testEvents()); err == nil {
+		t.Error("Send should fail on 400")
+	}
+	if calls != 1 {
+		t.Errorf("4xx should not be retried, got %d calls", calls)
+	}
+}
+
+func TestWebhookSinkWithoutRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	s, _ := NewWebhookSink(&WebhookOpts{Url: server.URL, MaxRetries: -1, RetryInterval: time.Millisecond})
+	if err := s.Send(context.Background(), testEvents()); err == nil {
+		t.Error("Send should fail on 503")
+	}
+	if calls != 1 {
+		t.Errorf("negative MaxRetries should not retry, got %d calls", calls)
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	s, err := NewFileSink(&FileOpts{Path: path, MaxBytes: 150, MaxBackups: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err = s.Send(context.Background(), testEvents()); err != nil {
+			t.Fatalf("Send err:%+v\n", err)
+		}
+	}
+	if err = s.Close(); err != nil {
+		t.Error(err)
+	}
+
+	backups, _ := filepath.Glob(path + ".*")
+	if len(backups) != 1 {
+		t.Errorf("expect 1 backup, got %d", len(backups))
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var event chainModel.EthereumEventMessage
+		if err = json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Errorf("invalid json line %s", scanner.Text())
+		}
+	}
+}
+
+func TestFileSinkRotateFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	s, err := NewFileSink(&FileOpts{Path: path, MaxBytes: 300})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	ctx := context.Background()
+	if err = s.Send(ctx, testEvents()); err != nil {
+		t.Fatalf("Send err:%+v\n", err)
+	}
+
+	// the new file can not be opened, the sink keeps the active file
+	openFile = func(name string, flag int, perm os.FileMode) (*os.File, error) {
+		return nil, errors.New("too many open files")
+	}
+	err = s.Send(ctx, testEvents())
+	openFile = os.OpenFile
+	if err == nil {
+		t.Error("Send should fail when the rotated file can not be opened")
+	}
+	if backups, _ := filepath.Glob(path + ".*"); len(backups) != 0 {
+		t.Errorf("the failed rotation should keep the active file, got %d backups", len(backups))
+	}
+
+	if err = s.Send(ctx, testEvents()); err != nil {
+		t.Errorf("Send after the failed rotation err:%+v\n", err)
+	}
+	if backups, _ := filepath.Glob(path + ".*"); len(backups) != 1 {
+		t.Errorf("expect 1 backup, got %d", len(backups))
+	}
+}
+
+// failingSink fails the first failures sends
+type failingSink struct {
+	failures int
+	sends    int
+}
+
+func (f *failingSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	f.sends++
+	if f.sends <= f.failures {
+		return errors.New("unavailable")
+	}
+	return nil
+}
+
+func (f *failingSink) Close() error {
+	return nil
+}
+
+func TestMultiSinkResend(t *testing.T) {
+	delivered, failing := &failingSink{}, &failingSink{failures: 1}
+	s := NewMultiSink(delivered, failing)
+
+	ctx := context.Background()
+	if err := s.Send(ctx, testEvents()); err == nil {
+		t.Fatal("Send should fail when a sink fails")
+	}
+	if err := s.Send(ctx, testEvents()); err != nil {
+		t.Fatalf("resend err:%+v\n", err)
+	}
+	if delivered.sends != 1 || failing.sends != 2 {
+		t.Errorf("resend should only reach the failed sink, sends:%d %d", delivered.sends, failing.sends)
+	}
+
+	// a new batch goes to all sinks again
+	if err := s.Send(ctx, testEvents()[:1]); err != nil {
+		t.Fatalf("Send err:%+v\n", err)
+	}
+	if delivered.sends != 2 || failing.sends != 3 {
+		t.Errorf("new batch should reach all sinks, sends:%d %d", delivered.sends, failing.sends)
+	}
+}
+
+func TestMultiSink(t *testing.T) {
+	first, second := NewMemoryPublisher(), NewMemoryPublisher()
+	firstSink, _ := NewPublisherSink(first, "events")
+	secondSink, _ := NewPublisherSink(second, "events")
+
+	s := NewMultiSink(firstSink, secondSink)
+	if err := s.Send(context.Background(), testEvents()); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []*MemoryPublisher{first, second} {
+		messages := p.Messages("events")
+		if len(messages) != 2 {
+			t.Fatalf("expect 2 messages, got %d", len(messages))
+		}
+		if string(messages[1].Key) != "0x02:3" {
+			t.Errorf("unexpected key %s", messages[1].Key)
+		}
+	}
+
+	if err := s.Close(); err != nil {
+		t.Error(err)
+	}
+	if err := s.Send(context.Background(), testEvents()); err == nil {
+		t.Error("Send should fail after Close")
+	}
+}
@@ -0,0 +1,127 @@
+package sink
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
+)
+
+// WEBHOOK_SIGNATURE_HEADER carries the hex encoded HMAC-SHA256 of the request body
+const WEBHOOK_SIGNATURE_HEADER = "X-Signature-256"
+
+type WebhookOpts struct {
+	Url           string        // webhook url
+	Secret        string        // HMAC secret, the body is not signed when empty
+	MaxRetries    int           // retries after the first attempt, 0 is the default 3, a negative value disables retries
+	RetryInterval time.Duration // first retry interval, doubled on every retry, default is 1s
+	Timeout       time.Duration // timeout of a single request, default is 10s
+	Client        *http.Client  // optional http client
+}
+
+type webhookSink struct {
+	opts   WebhookOpts
+	client *http.Client
+}
+
+// NewWebhookSink posts every batch as a json array to an HTTP endpoint
+func NewWebhookSink(opts *WebhookOpts) (Sink, error) {
+	if opts == nil || opts.Url == "" {
+		return nil, errors.New("webhook url is required")
+	}
+
+	w := &webhookSink{opts: *opts}
+	if w.opts.MaxRetries == 0 {
+		w.opts.MaxRetries = 3
+	}
+	if w.opts.RetryInterval == 0 {
+		w.opts.RetryInterval = time.Second
+	}
+	if w.opts.Timeout == 0 {
+		w.opts.Timeout = 10 * time.Second
+	}
+	w.client = opts.Client
+	if w.client == nil {
+		w.client = &http.Client{}
+	}
+
+	return w, nil
+}
+
+// Sign returns the signature sent in WEBHOOK_SIGNATURE_HEADER, receivers use it to verify the body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func (w *webhookSink) Send(ctx context.Context, events []*chainModel.EthereumEventMessage) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	interval := w.opts.RetryInterval
+	for attempt := 0; ; attempt++ {
+		retry, err := w.post(ctx, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= w.opts.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
+
+// post sends the body once, reporting whether a failure is worth retrying
+func (w *webhookSink) post(ctx context.Context, body []byte) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.opts.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.opts.Url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if w.opts.Secret != "" {
+		req.Header.Set(WEBHOOK_SIGNATURE_HEADER, Sign(w.opts.Secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retry, fmt.Errorf("webhook %s responded with status %d", w.opts.Url, resp.StatusCode)
+}
+
+func (w *webhookSink) Close() error {
+	w.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+)
+
+// attribute keys of the spans
+const (
+	AttrChainId   = "chain.id"
+	AttrContract  = "contract.address"
+	AttrMethod    = "contract.method"
+	AttrFrom      = "tx.from"
+	AttrTxHash    = "tx.hash"
+	AttrNonce     = "tx.nonce"
+	AttrGasPrice  = "tx.gas_price"
+	AttrEvent     = "event.name"
+	AttrFromBlock = "block.from"
+	AttrToBlock   = "block.to"
+	AttrLogs      = "event.logs"
+)
+
+// Attribute is a key value pair attached to a span
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: strconv.FormatInt(value, 10)}
+}
+
+func Uint64(key string, value uint64) Attribute {
+	return Attribute{Key: key, Value: strconv.FormatUint(value, 10)}
+}
+
+// Span is a timed operation started by a Tracer
+type Span interface {
+	// SetAttributes adds attributes known after the span started, e.g. the tx hash
+	SetAttributes(attrs ...Attribute)
+	// End finishes the span, err is nil when the operation succeeded
+	End(err error)
+}
+
+// Tracer starts spans, an OpenTelemetry trace.Tracer can be adapted in a few lines:
+// Start calls tracer.Start with the attributes converted to attribute.String, End records
+// a non-nil err and sets the error status before calling span.End.
+type Tracer interface {
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Nop starts spans that record nothing, it is the default when no tracer is set
+type Nop struct{}
+
+func (Nop) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	return ctx, nopSpan{}
+}
+
+type nopSpan struct{}
+
+func (nopSpan) SetAttributes(attrs ...Attribute) {}
+func (nopSpan) End(err error)                    {}
+
+type contextKey struct{}
+
+type tracerContext struct {
+	tracer Tracer
+	attrs  []Attribute
+}
+
+// ContextWithTracer returns a copy of ctx carrying the tracer, every span started from it gets
+// the attributes first. The contract wrappers pass the tracer to the bind package this way.
+func ContextWithTracer(ctx context.Context, tracer Tracer, attrs ...Attribute) context.Context {
+	if tracer == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, &tracerContext{tracer: tracer, attrs: attrs})
+}
+
+// Start starts a span with the tracer of ctx, or a no-op span when ctx has none
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if ctx == nil {
+		return ctx, nopSpan{}
+	}
+	tc, ok := ctx.Value(contextKey{}).(*tracerContext)
+	if !ok {
+		return ctx, nopSpan{}
+	}
+	all := make([]Attribute, 0, len(tc.attrs)+len(attrs))
+	all = append(append(all, tc.attrs...), attrs...)
+	return tc.tracer.Start(ctx, name, all...)
+}
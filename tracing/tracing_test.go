@@ -0,0 +1,35 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+)
+
+type attrTracer struct {
+	attrs []Attribute
+}
+
+func (t *attrTracer) Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	t.attrs = attrs
+	return ctx, nopSpan{}
+}
+
+func TestStart(t *testing.T) {
+	// no tracer in the context
+	ctx, span := Start(context.Background(), "noop")
+	if ctx == nil || span == nil {
+		t.Fatal("Start without a tracer should return a no-op span")
+	}
+	span.End(nil)
+
+	if ContextWithTracer(ctx, nil) != ctx {
+		t.Errorf("a nil tracer should keep the context")
+	}
+
+	tracer := &attrTracer{}
+	ctx = ContextWithTracer(context.Background(), tracer, Int64(AttrChainId, 97))
+	Start(ctx, "call", String(AttrMethod, "name"))
+	if len(tracer.attrs) != 2 || tracer.attrs[0] != Int64(AttrChainId, 97) || tracer.attrs[1] != String(AttrMethod, "name") {
+		t.Errorf("unexpected attributes %v", tracer.attrs)
+	}
+}
@@ -2,13 +2,15 @@ package utils
 
 import (
 	"context"
+	"errors"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/ethclient"
 	chainModel "github.com/jason-bateman/go-erc-standard-contract/model"
 )
 
-func GetLatestBlockNumWithClient(client *ethclient.Client) (uint64, error) {
-	blockNumber, err := client.BlockNumber(context.Background())
+func GetLatestBlockNumWithClient(ctx context.Context, client *ethclient.Client) (uint64, error) {
+	blockNumber, err := client.BlockNumber(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -16,17 +18,38 @@ func GetLatestBlockNumWithClient(client *ethclient.Client) (uint64, error) {
 	return blockNumber, nil
 }
 
-func GetLatestBlockNumWithRPC(rpc string) (uint64, error) {
-	client, err := ethclient.Dial(rpc)
+// RPCCaller performs raw json rpc requests, it is implemented by *rpc.Client and *backend.Backend
+type RPCCaller interface {
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// GetBlockNumByTagWithClient resolves a named block tag such as "safe" or "finalized" through eth_getBlockByNumber
+func GetBlockNumByTagWithClient(ctx context.Context, client RPCCaller, tag string) (uint64, error) {
+	var head *struct {
+		Number hexutil.Uint64 `json:"number"`
+	}
+	err := client.CallContext(ctx, &head, "eth_getBlockByNumber", tag, false)
+	if err != nil {
+		return 0, err
+	}
+	if head == nil {
+		return 0, errors.New("block of tag " + tag + " not found")
+	}
+
+	return uint64(head.Number), nil
+}
+
+func GetLatestBlockNumWithRPC(ctx context.Context, rpc string) (uint64, error) {
+	client, err := ethclient.DialContext(ctx, rpc)
 	if err != nil {
 		return 0, err
 	}
 	defer client.Close()
-	return GetLatestBlockNumWithClient(client)
+	return GetLatestBlockNumWithClient(ctx, client)
 }
 
-func GetAddressTxNonceWithClient(client *ethclient.Client, userAddress string) (*uint64, error) {
-	txNonce, err := client.PendingNonceAt(context.Background(), common.HexToAddress(userAddress))
+func GetAddressTxNonceWithClient(ctx context.Context, client *ethclient.Client, userAddress string) (*uint64, error) {
+	txNonce, err := client.PendingNonceAt(ctx, common.HexToAddress(userAddress))
 	if err != nil {
 		return nil, err
 	}
@@ -34,36 +57,36 @@ func GetAddressTxNonceWithClient(client *ethclient.Client, userAddress string) (
 	return &txNonce, nil
 }
 
-func GetAddressTxNonceWithRPC(rpc string, userAddress string) (*uint64, error) {
-	client, err := ethclient.Dial(rpc)
+func GetAddressTxNonceWithRPC(ctx context.Context, rpc string, userAddress string) (*uint64, error) {
+	client, err := ethclient.DialContext(ctx, rpc)
 	if err != nil {
 		return nil, err
 	}
 	defer client.Close()
-	return GetAddressTxNonceWithClient(client, userAddress)
+	return GetAddressTxNonceWithClient(ctx, client, userAddress)
 }
 
-func GetChainIdWithClient(client *ethclient.Client) (uint64, error) {
-	chainId, err := client.ChainID(context.Background())
+func GetChainIdWithClient(ctx context.Context, client *ethclient.Client) (uint64, error) {
+	chainId, err := client.ChainID(ctx)
 	if err != nil {
 		return 0, err
 	}
 	return chainId.Uint64(), nil
 }
 
-func GetChainIdWithRPC(rpc string) (uint64, error) {
-	client, err := ethclient.Dial(rpc)
+func GetChainIdWithRPC(ctx context.Context, rpc string) (uint64, error) {
+	client, err := ethclient.DialContext(ctx, rpc)
 	if err != nil {
 		return 0, err
 	}
 	defer client.Close()
-	return GetChainIdWithClient(client)
+	return GetChainIdWithClient(ctx, client)
 }
 
-func GetNativeBalanceWithClient(client *ethclient.Client, address string) (float64, error) {
+func GetNativeBalanceWithClient(ctx context.Context, client *ethclient.Client, address string) (float64, error) {
 
 	operator := common.HexToAddress(address)
-	balance, err := client.BalanceAt(context.Background(), operator, nil)
+	balance, err := client.BalanceAt(ctx, operator, nil)
 	if err != nil {
 		return 0, err
 	}
@@ -71,13 +94,13 @@ func GetNativeBalanceWithClient(client *ethclient.Client, address string) (float
 	return value, nil
 }
 
-func GetNativeBalanceWithRPC(rpc string, address string) (float64, error) {
-	client, err := ethclient.Dial(rpc)
+func GetNativeBalanceWithRPC(ctx context.Context, rpc string, address string) (float64, error) {
+	client, err := ethclient.DialContext(ctx, rpc)
 	if err != nil {
 		return 0, err
 	}
 	defer client.Close()
-	return GetNativeBalanceWithClient(client, address)
+	return GetNativeBalanceWithClient(ctx, client, address)
 }
 
 func MergeEventMessage(src, dest []*chainModel.EthereumEventMessage) []*chainModel.EthereumEventMessage {
@@ -1,12 +1,45 @@
 package utils
 
-import "testing"
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
 
 func TestGetNativeBalanceWithRPC(t *testing.T) {
-	balance, err := GetNativeBalanceWithRPC("https://data-seed-prebsc-2-s1.binance.org:8545", "0x35552c16704d214347f29Fa77f77DA6d75d7C752")
+	balance, err := GetNativeBalanceWithRPC(context.Background(), "https://data-seed-prebsc-2-s1.binance.org:8545", "0x35552c16704d214347f29Fa77f77DA6d75d7C752")
 	if err != nil {
 		t.Errorf("GetNativeBalanceWithRPC err:%+v\n", err)
 		return
 	}
 	t.Logf("balance is:%f,\n", balance)
 }
+
+func TestGetChainIdWithRPC_DialTimeout(t *testing.T) {
+	// the websocket handshake never completes, only the context ends the dial
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err = GetChainIdWithRPC(ctx, "ws://"+listener.Addr().String()); err == nil {
+		t.Error("GetChainIdWithRPC should fail")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the dial should end with the context, took %s", elapsed)
+	}
+}
@@ -1,16 +1,182 @@
 package utils
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
+	"reflect"
+	"strconv"
 	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
+// MaxUint256 is the largest value of a solidity uint256
+var MaxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// String2BigInt returns 0 for an invalid input
+//
+// Deprecated: Use ParseUint256 instead, which reports invalid input as an error.
 func String2BigInt(src string) *big.Int {
 	value := new(big.Int)
-	if strings.Contains(src, "0x") {
-		value.SetString(strings.TrimLeft(src, "0x"), 16)
+	if strings.HasPrefix(src, "0x") {
+		value.SetString(strings.TrimPrefix(src, "0x"), 16)
 	} else {
 		value.SetString(src, 0)
 	}
 	return value
 }
+
+// ParseUint256 parses a decimal or 0x prefixed hex string into a uint256 value
+func ParseUint256(src string) (*big.Int, error) {
+	var ok bool
+	value := new(big.Int)
+
+	if strings.HasPrefix(src, "0x") || strings.HasPrefix(src, "0X") {
+		_, ok = value.SetString(src[2:], 16)
+	} else {
+		_, ok = value.SetString(src, 10)
+	}
+	if !ok {
+		return nil, fmt.Errorf("invalid uint256 value:%q", src)
+	}
+	if value.Sign() < 0 || value.Cmp(MaxUint256) > 0 {
+		return nil, fmt.Errorf("uint256 value out of range:%s", src)
+	}
+	return value, nil
+}
+
+// ParseUint256s parses every element with ParseUint256
+func ParseUint256s(src []string) ([]*big.Int, error) {
+	values := make([]*big.Int, len(src))
+	for i, v := range src {
+		value, err := ParseUint256(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// BigInt2Uint64 converts value to uint64, returning an error instead of truncating
+func BigInt2Uint64(value *big.Int) (uint64, error) {
+	if value == nil {
+		return 0, errors.New("nil value")
+	}
+	if !value.IsUint64() {
+		return 0, fmt.Errorf("value %s overflows uint64", value.String())
+	}
+	return value.Uint64(), nil
+}
+
+// String2Uint64 converts a decimal string to uint64, returning an error instead of truncating
+func String2Uint64(src string) (uint64, error) {
+	value, err := ParseUint256(src)
+	if err != nil {
+		return 0, err
+	}
+	return BigInt2Uint64(value)
+}
+
+// BigInts2Strings formats every element as a decimal string
+func BigInts2Strings(values []*big.Int) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = v.String()
+	}
+	return result
+}
+
+// AbiArgs2JSON converts the decoded abi arguments of an event into json friendly values: integers wider than 32 bits
+// become decimal strings, so that they survive a json round trip, addresses, hashes and bytes become hex strings
+func AbiArgs2JSON(args map[string]interface{}) map[string]interface{} {
+	if args == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(args))
+	for name, arg := range args {
+		result[name] = abiValue2JSON(arg)
+	}
+	return result
+}
+
+func abiValue2JSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return v.String()
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case string, bool:
+		return v
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		// bytes1..bytes32
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			data := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(data), rv)
+			return hexutil.Encode(data)
+		}
+		fallthrough
+	case reflect.Slice:
+		result := make([]interface{}, rv.Len())
+		for i := range result {
+			result[i] = abiValue2JSON(rv.Index(i).Interface())
+		}
+		return result
+	}
+	return value
+}
+
+// MinInt256 and MaxInt256 are the bounds of a solidity int256
+var (
+	MinInt256 = new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	MaxInt256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+)
+
+// ParseInt256 parses an optionally signed decimal or 0x prefixed hex string into an int256 value
+func ParseInt256(src string) (*big.Int, error) {
+	digits, negative := src, false
+	if strings.HasPrefix(digits, "-") {
+		digits, negative = digits[1:], true
+	}
+	value, err := ParseUint256(digits)
+	if err != nil {
+		return nil, fmt.Errorf("invalid int256 value:%q", src)
+	}
+	if negative {
+		value.Neg(value)
+	}
+	if value.Cmp(MinInt256) < 0 || value.Cmp(MaxInt256) > 0 {
+		return nil, fmt.Errorf("int256 value out of range:%s", src)
+	}
+	return value, nil
+}
+
+// ParseInt256s parses every element with ParseInt256
+func ParseInt256s(src []string) ([]*big.Int, error) {
+	values := make([]*big.Int, len(src))
+	for i, v := range src {
+		value, err := ParseInt256(v)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -1,6 +1,98 @@
 package utils
 
-import "testing"
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 func Test(t *testing.T) {
 }
+
+func TestParseUint256(t *testing.T) {
+	cases := map[string]string{
+		"0":    "0",
+		"255":  "255",
+		"0xff": "255",
+		"0x0f": "15",
+		"0123": "123",
+		"110801524474586856940016194582843495297783442968439414267952739331789173737983": "110801524474586856940016194582843495297783442968439414267952739331789173737983",
+	}
+	for src, expect := range cases {
+		value, err := ParseUint256(src)
+		if err != nil {
+			t.Errorf("ParseUint256(%s) err:%+v", src, err)
+			continue
+		}
+		if value.String() != expect {
+			t.Errorf("ParseUint256(%s) should be %s, got %s", src, expect, value.String())
+		}
+	}
+
+	for _, src := range []string{"", "abc", "-1", "1.5", "0x", "0xzz", new(big.Int).Add(MaxUint256, big.NewInt(1)).String()} {
+		if _, err := ParseUint256(src); err == nil {
+			t.Errorf("ParseUint256(%q) should fail", src)
+		}
+	}
+}
+
+func TestBigInt2Uint64(t *testing.T) {
+	if v, err := BigInt2Uint64(big.NewInt(42)); err != nil || v != 42 {
+		t.Errorf("BigInt2Uint64(42) got %d %+v", v, err)
+	}
+	overflow, _ := new(big.Int).SetString("1000000000000000000000", 10)
+	if _, err := BigInt2Uint64(overflow); err == nil {
+		t.Error("BigInt2Uint64 should detect overflow")
+	}
+	if _, err := String2Uint64("18446744073709551616"); err == nil {
+		t.Error("String2Uint64 should detect overflow")
+	}
+}
+
+func TestAbiArgs2JSON(t *testing.T) {
+	args := AbiArgs2JSON(map[string]interface{}{
+		"id":      big.NewInt(7),
+		"ids":     []*big.Int{big.NewInt(1), big.NewInt(2)},
+		"owner":   common.HexToAddress("0x0a"),
+		"hash":    [4]byte{0xde, 0xad, 0xbe, 0xef},
+		"nonce":   uint64(1 << 60),
+		"flag":    true,
+		"decimal": uint8(18),
+	})
+	want := map[string]interface{}{
+		"id":      "7",
+		"ids":     []interface{}{"1", "2"},
+		"owner":   "0x000000000000000000000000000000000000000A",
+		"hash":    "0xdeadbeef",
+		"nonce":   "1152921504606846976",
+		"flag":    true,
+		"decimal": uint8(18),
+	}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("args:%v want:%v", args, want)
+	}
+}
+
+func TestParseInt256(t *testing.T) {
+	cases := map[string]string{
+		"0":                "0",
+		"-1":               "-1",
+		"-0xff":            "-255",
+		"42":               "42",
+		MinInt256.String(): MinInt256.String(),
+	}
+	for src, expect := range cases {
+		value, err := ParseInt256(src)
+		if err != nil || value.String() != expect {
+			t.Errorf("ParseInt256(%s) should be %s, got %v %+v", src, expect, value, err)
+		}
+	}
+
+	for _, src := range []string{"", "--1", "1.5", new(big.Int).Add(MaxInt256, big.NewInt(1)).String()} {
+		if _, err := ParseInt256(src); err == nil {
+			t.Errorf("ParseInt256(%q) should fail", src)
+		}
+	}
+}
@@ -3,9 +3,10 @@ package utils
 import (
 	"fmt"
 	"github.com/ethereum/go-ethereum/common"
-	"math"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"math/big"
 	"reflect"
+	"strconv"
 	"strings"
 )
 
@@ -13,6 +14,46 @@ func IsHexAddressValid(address string) bool {
 	return common.IsHexAddress(address)
 }
 
+func HexToAddresses(addresses []string) ([]common.Address, error) {
+	result := make([]common.Address, len(addresses))
+	for i, address := range addresses {
+		if !IsHexAddressValid(address) {
+			return nil, fmt.Errorf("address %s is not a hex encode address", address)
+		}
+		result[i] = common.HexToAddress(address)
+	}
+	return result, nil
+}
+
+// ParseAddress is common.HexToAddress rejecting the invalid input
+func ParseAddress(address string) (common.Address, error) {
+	if !IsHexAddressValid(address) {
+		return common.Address{}, fmt.Errorf("address %s is not a hex encode address", address)
+	}
+	return common.HexToAddress(address), nil
+}
+
+// Addresses2Hex formats every address with the EIP55 checksum
+func Addresses2Hex(addresses []common.Address) []string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = address.Hex()
+	}
+	return result
+}
+
+// ParseFixedBytes decodes a 0x prefixed hex string of at most size bytes, it is right padded like a solidity bytesN
+func ParseFixedBytes(src string, size int) ([]byte, error) {
+	value, err := hexutil.Decode(src)
+	if err != nil {
+		return nil, fmt.Errorf("invalid bytes%d value %q: %v", size, src, err)
+	}
+	if len(value) > size {
+		return nil, fmt.Errorf("bytes%d value too long:%s", size, src)
+	}
+	return common.RightPadBytes(value, size), nil
+}
+
 func AddressFormatByEIP55(address string) (string, error) {
 	if !IsHexAddressValid(address) {
 		return "", fmt.Errorf("address %s is not a hex encode address", address)
@@ -38,50 +79,52 @@ func AddressEqual(address1, address2 string) (bool, error) {
 	return strings.EqualFold(address1, address2), nil
 }
 
+// ParseUnits2String converts a value into base units, the digits beyond decimals are truncated.
+// Floats are converted through their shortest decimal representation, use ParseUnits for exact strings.
 func ParseUnits2String(iValue interface{}, decimals uint8) (string, error) {
-	var (
-		succeed bool
-		value   *big.Float
-	)
-	value = new(big.Float)
-	decimal := big.NewFloat(math.Pow(10, float64(decimals)))
+	var value string
+
 	switch v := iValue.(type) {
 	case string:
-		value, succeed = value.SetString(v)
-		if !succeed {
-			return "", fmt.Errorf("wrong string content:%s", v)
-		}
+		value = v
 	case float64:
-		value = value.SetFloat64(v)
+		value = strconv.FormatFloat(v, 'f', -1, 64)
 	case *float64:
-		value = value.SetFloat64(*v)
+		value = strconv.FormatFloat(*v, 'f', -1, 64)
 	case int64:
-		value = value.SetInt64(v)
+		value = strconv.FormatInt(v, 10)
 	case *int64:
-		value = value.SetInt64(*v)
+		value = strconv.FormatInt(*v, 10)
 	case int:
-		value = value.SetInt64(int64(v))
+		value = strconv.Itoa(v)
 	case *int:
-		value = value.SetInt64(int64(*v))
+		value = strconv.Itoa(*v)
 	case big.Int:
-		value = value.SetInt(&v)
+		value = v.String()
 	case *big.Int:
-		value = value.SetInt(v)
+		value = v.String()
 	default:
 		return "", fmt.Errorf("unsupported type:%+v", reflect.TypeOf(iValue))
 	}
-	res := new(big.Int)
-	new(big.Float).Mul(value, decimal).Int(res)
-	return res.String(), nil
 
+	res, err := ParseUnitsWithRounding(value, decimals, RoundDown)
+	if err != nil {
+		return "", fmt.Errorf("wrong string content:%s", value)
+	}
+	return res.String(), nil
 }
 
+// FormatUnits2Float64 converts base units into the nearest float64, use FormatUnits for an exact string.
 func FormatUnits2Float64(iWei interface{}, decimals uint8) (float64, error) {
 	var wei *big.Int
+	var err error
 
 	switch v := iWei.(type) {
 	case string:
-		wei = String2BigInt(v)
+		wei, err = ParseUint256(v)
+		if err != nil {
+			return 0.0, err
+		}
 	case int64:
 		wei = big.NewInt(v)
 	case big.Int:
@@ -92,9 +135,7 @@ func FormatUnits2Float64(iWei interface{}, decimals uint8) (float64, error) {
 		return 0.0, fmt.Errorf("unsupported type:%+v", reflect.TypeOf(iWei))
 	}
 
-	decimal := big.NewFloat(math.Pow(10, float64(decimals)))
-	weiFloat := new(big.Float).SetInt(wei)
-	value, _ := new(big.Float).Quo(weiFloat, decimal).Float64()
+	value, _ := new(big.Rat).SetFrac(wei, pow10(decimals)).Float64()
 
 	return value, nil
 }
@@ -81,3 +81,34 @@ func TestAddress(t *testing.T) {
 
 	t.Log("Test Address module is OK")
 }
+
+func TestHexToAddresses(t *testing.T) {
+	addresses, err := HexToAddresses([]string{"0x67497baefe2bdf028bb7fed35c7f211ce10469f6", "0xf4f770C0dDE6E24b4c65A85F744fEC0Bd3D89b1F"})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(addresses) != 2 || addresses[0].Hex() != "0x67497bAEfe2BDF028bb7FEd35C7F211cE10469F6" {
+		t.Errorf("unexpected addresses %+v", addresses)
+	}
+
+	_, err = HexToAddresses([]string{"0xxxxxx"})
+	if err == nil {
+		t.Error("0xxxxxx should not be a address")
+	}
+}
+
+func TestParseFixedBytes(t *testing.T) {
+	value, err := ParseFixedBytes("0x0102", 4)
+	if err != nil || len(value) != 4 || value[0] != 1 || value[1] != 2 || value[3] != 0 {
+		t.Errorf("unexpected value %x %+v", value, err)
+	}
+	for _, src := range []string{"0102", "0x010203", "0xzz"} {
+		if _, err = ParseFixedBytes(src, 2); err == nil {
+			t.Errorf("ParseFixedBytes(%q, 2) should fail", src)
+		}
+	}
+
+	if _, err = ParseAddress("0x123"); err == nil {
+		t.Error("0x123 should not be a address")
+	}
+}
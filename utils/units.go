@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// NATIVE_DECIMALS is the number of decimals of the native currency (ether, bnb, ...)
+const NATIVE_DECIMALS = 18
+
+// decimalPattern is the plain decimal notation accepted for amounts, exponents and underscores are rejected
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// RoundingMode decides how a value is rounded when it has more decimals than the target precision
+type RoundingMode int
+
+const (
+	RoundDown     RoundingMode = iota // toward zero
+	RoundUp                           // away from zero
+	RoundHalfUp                       // to nearest, ties away from zero
+	RoundHalfEven                     // to nearest, ties to even
+)
+
+// ParseUnits converts a decimal string such as "0.1" into base units exactly,
+// it fails when the value has more fractional digits than decimals.
+func ParseUnits(value string, decimals uint8) (*big.Int, error) {
+	rat, err := parseDecimal(value)
+	if err != nil {
+		return nil, err
+	}
+	num, den := scaleRat(rat, decimals)
+	if new(big.Int).Rem(num, den).Sign() != 0 {
+		return nil, fmt.Errorf("value %s has more than %d decimals", value, decimals)
+	}
+	return num.Quo(num, den), nil
+}
+
+// ParseUnitsWithRounding converts a decimal string into base units, rounding the digits beyond decimals by mode.
+func ParseUnitsWithRounding(value string, decimals uint8, mode RoundingMode) (*big.Int, error) {
+	rat, err := parseDecimal(value)
+	if err != nil {
+		return nil, err
+	}
+	num, den := scaleRat(rat, decimals)
+	return roundQuo(num, den, mode)
+}
+
+// FormatUnits converts base units into an exact decimal string without trailing zeros, e.g. 1e17 wei is "0.1".
+func FormatUnits(value *big.Int, decimals uint8) string {
+	if value == nil {
+		return "0"
+	}
+
+	abs := new(big.Int).Abs(value)
+	intPart, fracPart := new(big.Int).QuoRem(abs, pow10(decimals), new(big.Int))
+
+	result := intPart.String()
+	if fracPart.Sign() != 0 {
+		frac := fracPart.String()
+		frac = strings.Repeat("0", int(decimals)-len(frac)) + frac
+		result += "." + strings.TrimRight(frac, "0")
+	}
+	if value.Sign() < 0 {
+		result = "-" + result
+	}
+	return result
+}
+
+// FormatUnitsWithRounding converts base units into a decimal string with at most places fractional digits.
+func FormatUnitsWithRounding(value *big.Int, decimals uint8, places uint8, mode RoundingMode) (string, error) {
+	if value == nil || places >= decimals {
+		return FormatUnits(value, decimals), nil
+	}
+	rounded, err := roundQuo(new(big.Int).Set(value), pow10(decimals-places), mode)
+	if err != nil {
+		return "", err
+	}
+	return FormatUnits(rounded, places), nil
+}
+
+// ResolvePayableValue returns the native currency amount in wei attached to a transaction,
+// taken from the first of wei, the decimal string ether and the legacy float64 value that is set.
+func ResolvePayableValue(wei *big.Int, ether string, legacy float64) (*big.Int, error) {
+	switch {
+	case wei != nil:
+		if wei.Sign() < 0 {
+			return nil, errors.New("negative payable value")
+		}
+		return wei, nil
+	case ether != "":
+		value, err := ParseUnits(ether, NATIVE_DECIMALS)
+		if err != nil {
+			return nil, err
+		}
+		if value.Sign() < 0 {
+			return nil, errors.New("negative payable value")
+		}
+		return value, nil
+	case legacy < 0:
+		return nil, errors.New("negative payable value")
+	case legacy > 0:
+		// the shortest representation keeps 0.1 as 0.1 instead of its binary approximation
+		return ParseUnitsWithRounding(strconv.FormatFloat(legacy, 'f', -1, 64), NATIVE_DECIMALS, RoundDown)
+	default:
+		return nil, nil
+	}
+}
+
+func parseDecimal(value string) (*big.Rat, error) {
+	value = strings.TrimSpace(value)
+	if !decimalPattern.MatchString(value) {
+		return nil, fmt.Errorf("invalid decimal value:%q", value)
+	}
+	rat, ok := new(big.Rat).SetString(value)
+	if !ok {
+		return nil, fmt.Errorf("invalid decimal value:%q", value)
+	}
+	return rat, nil
+}
+
+// scaleRat returns the numerator and positive denominator of rat * 10^decimals
+func scaleRat(rat *big.Rat, decimals uint8) (*big.Int, *big.Int) {
+	num := new(big.Int).Mul(rat.Num(), pow10(decimals))
+	den := new(big.Int).Set(rat.Denom())
+	return num, den
+}
+
+// roundQuo divides num by the positive den, rounding the quotient by mode
+func roundQuo(num, den *big.Int, mode RoundingMode) (*big.Int, error) {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo, nil
+	}
+
+	var away bool
+	switch mode {
+	case RoundDown:
+		away = false
+	case RoundUp:
+		away = true
+	case RoundHalfUp, RoundHalfEven:
+		cmp := new(big.Int).Lsh(new(big.Int).Abs(rem), 1).Cmp(den)
+		away = cmp > 0 || (cmp == 0 && (mode == RoundHalfUp || quo.Bit(0) == 1))
+	default:
+		return nil, fmt.Errorf("unsupported rounding mode:%d", mode)
+	}
+
+	if away {
+		if num.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return quo, nil
+}
+
+func pow10(n uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParseUnits(t *testing.T) {
+	cases := []struct {
+		value    string
+		decimals uint8
+		expect   string
+	}{
+		{"0.1", 18, "100000000000000000"},
+		{"1", 18, "1000000000000000000"},
+		{"123456789.123456789123456789", 18, "123456789123456789123456789"},
+		{"9007199254740993", 0, "9007199254740993"},
+		{"0.00039334", 18, "393340000000000"},
+		{"-1.5", 1, "-15"},
+	}
+	for _, c := range cases {
+		value, err := ParseUnits(c.value, c.decimals)
+		if err != nil {
+			t.Errorf("ParseUnits(%s) err:%+v", c.value, err)
+			continue
+		}
+		if value.String() != c.expect {
+			t.Errorf("ParseUnits(%s, %d) should be %s, got %s", c.value, c.decimals, c.expect, value.String())
+		}
+	}
+
+	for _, value := range []string{"", "abc", "1/3", "0.1234567", "1e3", "1E-3", "1_000", "0x10", "+1", ".5", "1.", "- 1", "1..2"} {
+		if _, err := ParseUnits(value, 6); err == nil {
+			t.Errorf("ParseUnits(%q, 6) should fail", value)
+		}
+	}
+}
+
+func TestParseUnitsWithRounding(t *testing.T) {
+	cases := []struct {
+		value  string
+		mode   RoundingMode
+		expect string
+	}{
+		{"1.25", RoundDown, "12"},
+		{"1.25", RoundUp, "13"},
+		{"1.25", RoundHalfUp, "13"},
+		{"1.25", RoundHalfEven, "12"},
+		{"1.35", RoundHalfEven, "14"},
+		{"1.26", RoundHalfEven, "13"},
+		{"-1.25", RoundDown, "-12"},
+		{"-1.25", RoundUp, "-13"},
+		{"-1.25", RoundHalfUp, "-13"},
+	}
+	for _, c := range cases {
+		value, err := ParseUnitsWithRounding(c.value, 1, c.mode)
+		if err != nil {
+			t.Errorf("ParseUnitsWithRounding(%s) err:%+v", c.value, err)
+			continue
+		}
+		if value.String() != c.expect {
+			t.Errorf("ParseUnitsWithRounding(%s, %d) should be %s, got %s", c.value, c.mode, c.expect, value.String())
+		}
+	}
+}
+
+func TestFormatUnits(t *testing.T) {
+	wei, _ := new(big.Int).SetString("123456789123456789123456789", 10)
+	if res := FormatUnits(wei, 18); res != "123456789.123456789123456789" {
+		t.Errorf("unexpected FormatUnits result %s", res)
+	}
+	if res := FormatUnits(big.NewInt(100000000000000000), 18); res != "0.1" {
+		t.Errorf("unexpected FormatUnits result %s", res)
+	}
+	if res := FormatUnits(big.NewInt(-1500), 3); res != "-1.5" {
+		t.Errorf("unexpected FormatUnits result %s", res)
+	}
+
+	res, err := FormatUnitsWithRounding(wei, 18, 4, RoundHalfUp)
+	if err != nil || res != "123456789.1235" {
+		t.Errorf("unexpected FormatUnitsWithRounding result %s %+v", res, err)
+	}
+}
+
+func TestResolvePayableValue(t *testing.T) {
+	value, err := ResolvePayableValue(nil, "0.1", 0)
+	if err != nil || value.String() != "100000000000000000" {
+		t.Errorf("unexpected ether payable value %v %+v", value, err)
+	}
+	value, err = ResolvePayableValue(nil, "", 0.1)
+	if err != nil || value.String() != "100000000000000000" {
+		t.Errorf("unexpected legacy payable value %v %+v", value, err)
+	}
+	value, err = ResolvePayableValue(big.NewInt(7), "0.1", 0.1)
+	if err != nil || value.String() != "7" {
+		t.Errorf("wei should take precedence, got %v %+v", value, err)
+	}
+	value, err = ResolvePayableValue(nil, "", 0)
+	if err != nil || value != nil {
+		t.Errorf("empty payable value should be nil, got %v %+v", value, err)
+	}
+	if _, err = ResolvePayableValue(nil, "-1", 0); err == nil {
+		t.Error("negative payable value should fail")
+	}
+}